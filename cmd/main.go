@@ -3,6 +3,10 @@ package main
 import (
 	"context"
 	"log/slog"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
 
 	"pr-manager-service/internal/app"
 
@@ -25,7 +29,7 @@ func main() {
 		return
 	}
 
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
 	defer cancel()
 
 	application, err := app.NewApp(ctx)
@@ -38,6 +42,16 @@ func main() {
 		return
 	}
 
+	// NOTE: воркеры останавливаются вместе с http-сервером до закрытия соединений с базой
+	var workers sync.WaitGroup
+	workers.Go(func() { application.RunEscalationWorker(ctx) })
+	workers.Go(func() { application.RunOutboxRelay(ctx) })
+	workers.Go(func() { application.RunWebhookDispatcher(ctx) })
+	defer func() {
+		cancel()
+		workers.Wait()
+	}()
+
 	if err = application.RunHttpServer(ctx); err != nil {
 		return
 	}
@@ -5,10 +5,14 @@ import (
 	"fmt"
 	"log/slog"
 	"net/http"
+	"pr-manager-service/internal/auth"
+	"pr-manager-service/internal/clock"
 	"pr-manager-service/internal/generated/api"
 	"pr-manager-service/internal/http_server"
+	"pr-manager-service/internal/outbox"
 	"pr-manager-service/internal/storage"
 	"pr-manager-service/internal/usecases"
+	"pr-manager-service/internal/webhook"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -16,37 +20,86 @@ import (
 )
 
 type App struct {
-	Cfg          *Config
-	PostgresConn *pgxpool.Pool
-	Storage      *storage.Storage
-	Usecases     *usecases.Usecases
-	HttpServer   *http_server.HttpServer
-	closeFuncs   []func()
+	Cfg           *Config
+	Clock         clock.Clock
+	PostgresConn  *pgxpool.Pool
+	Storage       *storage.Storage
+	Usecases      *usecases.Usecases
+	HttpServer    *http_server.HttpServer
+	Authenticator *auth.Authenticator
+	closeFuncs    []func()
 }
 
-func NewApp(ctx context.Context) (*App, error) {
+type options struct {
+	clock      clock.Clock
+	eventSinks []usecases.EventSink
+}
+
+type Option func(o *options)
+
+// WithClock подменяет системные часы, например фиктивными в тестах.
+func WithClock(clock clock.Clock) Option {
+	return func(o *options) {
+		o.clock = clock
+	}
+}
+
+// WithEventSinks добавляет получателей событий outbox к вебхукам и логу, например outbox.MemorySink в тестах.
+func WithEventSinks(sinks ...usecases.EventSink) Option {
+	return func(o *options) {
+		o.eventSinks = append(o.eventSinks, sinks...)
+	}
+}
+
+func NewApp(ctx context.Context, opts ...Option) (*App, error) {
 	SetupLogger()
 
+	o := options{clock: clock.New()}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
 	closeFuncs := make([]func(), 0, 1)
 
 	cfg := InitConfig()
+	if !cfg.ReviewerStrategy.IsValid() {
+		return nil, fmt.Errorf("unknown REVIEWER_STRATEGY %q", cfg.ReviewerStrategy)
+	}
+
+	if err := cfg.validateAuth(); err != nil {
+		return nil, err
+	}
+
 	pgConn, err := InitPostgres(ctx, cfg)
 	if err != nil {
 		return nil, fmt.Errorf("InitPostgres: %w", err)
 	}
 	closeFuncs = append(closeFuncs, pgConn.Close)
 
-	storage := storage.NewStorage(pgConn)
-	usecases := usecases.NewUsecases(storage)
-	httpServer := http_server.NewHttpServer(usecases)
+	storage := storage.NewStorage(pgConn, o.clock)
+	usecases := usecases.NewUsecases(
+		storage,
+		usecases.WithClock(o.clock),
+		usecases.WithDefaultReviewerStrategy(cfg.ReviewerStrategy),
+		usecases.WithMaxOpenReviewsPerUser(cfg.MaxOpenReviewsPerUser),
+		usecases.WithWebhookSender(webhook.NewSender(cfg.WebhookTimeout)),
+		usecases.WithEventSinks(outbox.NewWebhookSink(storage), outbox.NewLogSink(slog.Default())),
+		usecases.WithEventSinks(o.eventSinks...),
+	)
+	httpServer := http_server.NewHttpServer(
+		usecases,
+		http_server.WithEventStreamIntervals(cfg.EventStreamPollInterval, cfg.EventStreamHeartbeatInterval),
+	)
 
 	return &App{
-		Storage:      storage,
-		Usecases:     usecases,
-		HttpServer:   httpServer,
-		Cfg:          cfg,
-		PostgresConn: pgConn,
-		closeFuncs:   closeFuncs,
+		Storage:       storage,
+		Usecases:      usecases,
+		HttpServer:    httpServer,
+		Authenticator: auth.NewAuthenticator(cfg.AuthAdminToken, cfg.AuthJWTSecret, o.clock),
+		Cfg:           cfg,
+		Clock:         o.clock,
+		PostgresConn:  pgConn,
+		closeFuncs:    closeFuncs,
 	}, nil
 }
 
@@ -58,13 +111,17 @@ func (a *App) Cleanup() {
 
 func (a *App) RunHttpServer(ctx context.Context) error {
 	router := gin.New()
-	api.RegisterHandlers(router, a.HttpServer)
+	router.Use(http_server.RequestContext())
+	api.RegisterHandlersWithOptions(router, a.HttpServer, api.GinServerOptions{
+		Middlewares: []api.MiddlewareFunc{http_server.Authenticate(a.Authenticator)},
+	})
 
 	srv := &http.Server{
 		Addr:              a.Cfg.Addr(),
 		Handler:           router,
 		ReadHeaderTimeout: time.Millisecond * 500,
 	}
+	srv.RegisterOnShutdown(a.HttpServer.CloseStreams)
 
 	go func() {
 		<-ctx.Done()
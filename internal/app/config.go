@@ -1,8 +1,15 @@
 package app
 
 import (
+	"errors"
 	"fmt"
+	"log/slog"
 	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"pr-manager-service/internal/domain"
 )
 
 type Config struct {
@@ -14,6 +21,31 @@ type Config struct {
 	DBName     string
 	DBUser     string
 	DBPassword string
+
+	ReviewerStrategy      domain.ReviewerStrategy
+	MaxOpenReviewsPerUser int
+
+	// EscalationInterval - период запуска воркера эскалации просроченных ревью; 0 отключает воркер
+	EscalationInterval time.Duration
+
+	// OutboxRelayInterval - период передачи событий outbox получателям; 0 отключает релей
+	OutboxRelayInterval time.Duration
+
+	// WebhookDispatchInterval - период отправки ожидающих доставок вебхуков; 0 отключает отправку
+	WebhookDispatchInterval time.Duration
+	// WebhookTimeout - таймаут одного запроса к получателю вебхука
+	WebhookTimeout time.Duration
+
+	// EventStreamPollInterval - как часто поток событий /events/stream проверяет новые события
+	EventStreamPollInterval time.Duration
+	// EventStreamHeartbeatInterval - период heartbeat в потоке событий
+	EventStreamHeartbeatInterval time.Duration
+
+	// NOTE: секреты не попадают в лог конфигурации при старте
+	// AuthAdminToken - bearer-токен администратора
+	AuthAdminToken string `json:"-"`
+	// AuthJWTSecret - ключ HS256 для JWT пользователей; пусто - токены пользователей не принимаются
+	AuthJWTSecret string `json:"-"`
 }
 
 func InitConfig() *Config {
@@ -25,7 +57,94 @@ func InitConfig() *Config {
 		DBName:     os.Getenv("DB_NAME"),
 		DBUser:     os.Getenv("DB_USER"),
 		DBPassword: os.Getenv("DB_PASSWORD"),
+
+		ReviewerStrategy:      domain.ReviewerStrategy(getEnv("REVIEWER_STRATEGY", string(domain.ReviewerStrategyRandom))),
+		MaxOpenReviewsPerUser: getEnvInt("MAX_OPEN_REVIEWS_PER_USER", 0),
+
+		EscalationInterval: getEnvDuration("ESCALATION_INTERVAL", time.Minute),
+
+		OutboxRelayInterval: getEnvDuration("OUTBOX_RELAY_INTERVAL", time.Second),
+
+		WebhookDispatchInterval: getEnvDuration("WEBHOOK_DISPATCH_INTERVAL", 5*time.Second),
+		WebhookTimeout:          getEnvDuration("WEBHOOK_TIMEOUT", 10*time.Second),
+
+		EventStreamPollInterval:      getEnvDuration("EVENT_STREAM_POLL_INTERVAL", time.Second),
+		EventStreamHeartbeatInterval: getEnvDuration("EVENT_STREAM_HEARTBEAT_INTERVAL", 15*time.Second),
+
+		AuthAdminToken: os.Getenv("AUTH_ADMIN_TOKEN"),
+		AuthJWTSecret:  os.Getenv("AUTH_JWT_SECRET"),
+	}
+}
+
+const (
+	// minJWTSecretLength - минимальная длина ключа HS256 в байтах
+	minJWTSecretLength = 32
+	// placeholderSecretPrefix - начало значений-заглушек, которые нельзя оставлять в рабочей конфигурации
+	placeholderSecretPrefix = "change-me"
+)
+
+// validateAuth проверяет секреты аутентификации: токен администратора обязателен, заглушки не принимаются,
+// ключ JWT, если задан, не короче minJWTSecretLength байт.
+func (c *Config) validateAuth() error {
+	if c.AuthAdminToken == "" {
+		return errors.New("AUTH_ADMIN_TOKEN is not set")
+	}
+
+	if strings.HasPrefix(c.AuthAdminToken, placeholderSecretPrefix) {
+		return errors.New("AUTH_ADMIN_TOKEN is a placeholder")
+	}
+
+	if c.AuthJWTSecret == "" {
+		return nil
 	}
+
+	if strings.HasPrefix(c.AuthJWTSecret, placeholderSecretPrefix) {
+		return errors.New("AUTH_JWT_SECRET is a placeholder")
+	}
+
+	if len(c.AuthJWTSecret) < minJWTSecretLength {
+		return fmt.Errorf("AUTH_JWT_SECRET must be at least %d bytes", minJWTSecretLength)
+	}
+
+	return nil
+}
+
+func getEnv(key, defaultValue string) string {
+	if value, ok := os.LookupEnv(key); ok && value != "" {
+		return value
+	}
+
+	return defaultValue
+}
+
+func getEnvInt(key string, defaultValue int) int {
+	value, ok := os.LookupEnv(key)
+	if !ok || value == "" {
+		return defaultValue
+	}
+
+	parsed, err := strconv.Atoi(value)
+	if err != nil {
+		slog.Warn("invalid int env, using default", slog.String("key", key), slog.Int("default", defaultValue))
+		return defaultValue
+	}
+
+	return parsed
+}
+
+func getEnvDuration(key string, defaultValue time.Duration) time.Duration {
+	value, ok := os.LookupEnv(key)
+	if !ok || value == "" {
+		return defaultValue
+	}
+
+	parsed, err := time.ParseDuration(value)
+	if err != nil || parsed < 0 {
+		slog.Warn("invalid duration env, using default", slog.String("key", key), slog.Duration("default", defaultValue))
+		return defaultValue
+	}
+
+	return parsed
 }
 
 func (c *Config) DSN() string {
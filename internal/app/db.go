@@ -35,6 +35,9 @@ func InitPostgres(ctx context.Context, cfg *Config) (*pgxpool.Pool, error) {
 	config.MaxConnLifetime = time.Hour
 	config.MaxConnIdleTime = 30 * time.Minute
 
+	// NOTE: now() в значениях по умолчанию колонок timestamp должен давать UTC, как и clock.Now()
+	config.ConnConfig.RuntimeParams["timezone"] = "UTC"
+
 	var pool *pgxpool.Pool
 
 	for range pgConnRetryCount {
@@ -0,0 +1,31 @@
+package app
+
+import (
+	"context"
+	"log/slog"
+)
+
+// RunEscalationWorker раз в Cfg.EscalationInterval переназначает ревью, просроченные по SLA команды,
+// пока не будет отменён ctx. Ошибка прохода логируется, следующий проход выполняется по расписанию.
+func (a *App) RunEscalationWorker(ctx context.Context) {
+	runPeriodically(ctx, "escalation", a.Cfg.EscalationInterval, a.escalateOverdueReviews)
+}
+
+func (a *App) escalateOverdueReviews(ctx context.Context) {
+	result, err := a.Usecases.EscalateOverdueReviews(ctx)
+	if err != nil && ctx.Err() == nil {
+		slog.Error("escalate overdue reviews", slog.Any("error", err))
+	}
+
+	if len(result.Reassigned)+len(result.Escalated)+len(result.Unresolved)+len(result.Failed) == 0 {
+		return
+	}
+
+	slog.Info(
+		"overdue reviews escalated",
+		slog.Any("reassigned", result.Reassigned),
+		slog.Any("escalated", result.Escalated),
+		slog.Any("unresolved", result.Unresolved),
+		slog.Any("failed", result.Failed),
+	)
+}
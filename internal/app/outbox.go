@@ -0,0 +1,28 @@
+package app
+
+import (
+	"context"
+	"log/slog"
+)
+
+// RunOutboxRelay раз в Cfg.OutboxRelayInterval передаёт события outbox получателям, пока не будет отменён ctx.
+// За один тик outbox разбирается до конца, а не одной порцией.
+func (a *App) RunOutboxRelay(ctx context.Context) {
+	runPeriodically(ctx, "outbox_relay", a.Cfg.OutboxRelayInterval, a.relayOutboxEvents)
+}
+
+func (a *App) relayOutboxEvents(ctx context.Context) {
+	for ctx.Err() == nil {
+		events, err := a.Usecases.RelayOutboxEvents(ctx)
+		if err != nil {
+			if ctx.Err() == nil {
+				slog.Error("relay outbox events", slog.Any("error", err))
+			}
+			return
+		}
+
+		if len(events) == 0 {
+			return
+		}
+	}
+}
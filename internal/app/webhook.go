@@ -0,0 +1,36 @@
+package app
+
+import (
+	"context"
+	"log/slog"
+
+	"pr-manager-service/internal/domain"
+)
+
+// RunWebhookDispatcher раз в Cfg.WebhookDispatchInterval отправляет ожидающие доставки вебхуков,
+// пока не будет отменён ctx.
+func (a *App) RunWebhookDispatcher(ctx context.Context) {
+	runPeriodically(ctx, "webhook_dispatcher", a.Cfg.WebhookDispatchInterval, a.deliverWebhooks)
+}
+
+func (a *App) deliverWebhooks(ctx context.Context) {
+	deliveries, err := a.Usecases.DeliverWebhooks(ctx)
+	if err != nil && ctx.Err() == nil {
+		slog.Error("deliver webhooks", slog.Any("error", err))
+	}
+
+	for _, delivery := range deliveries {
+		if delivery.Status == domain.WebhookDeliveryDelivered {
+			continue
+		}
+
+		slog.Warn(
+			"webhook delivery attempt failed",
+			slog.Int64("delivery_id", delivery.ID),
+			slog.Int64("subscription_id", delivery.SubscriptionID),
+			slog.Int("attempts", delivery.Attempts),
+			slog.String("status", string(delivery.Status)),
+			slog.String("error", delivery.LastError),
+		)
+	}
+}
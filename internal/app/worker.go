@@ -0,0 +1,30 @@
+package app
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// runPeriodically вызывает tick раз в interval, пока не будет отменён ctx. Нулевой интервал отключает воркер.
+func runPeriodically(ctx context.Context, name string, interval time.Duration, tick func(ctx context.Context)) {
+	if interval <= 0 {
+		slog.Info("worker disabled", slog.String("worker", name))
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	slog.Info("worker started", slog.String("worker", name), slog.Duration("interval", interval))
+
+	for {
+		select {
+		case <-ctx.Done():
+			slog.Info("worker stopped gracefully", slog.String("worker", name))
+			return
+		case <-ticker.C:
+			tick(ctx)
+		}
+	}
+}
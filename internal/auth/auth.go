@@ -0,0 +1,42 @@
+package auth
+
+import (
+	"crypto/subtle"
+
+	"pr-manager-service/internal/clock"
+	"pr-manager-service/internal/domain"
+)
+
+// Authenticator определяет вызывающего по bearer-токену: токену администратора или JWT пользователя.
+type Authenticator struct {
+	adminToken []byte
+	jwtKey     []byte
+	clock      clock.Clock
+}
+
+// NewAuthenticator создаёт проверку токенов. Пустой jwtKey отключает токены пользователей.
+func NewAuthenticator(adminToken, jwtKey string, clock clock.Clock) *Authenticator {
+	return &Authenticator{
+		adminToken: []byte(adminToken),
+		jwtKey:     []byte(jwtKey),
+		clock:      clock,
+	}
+}
+
+// Authenticate возвращает вызывающего или ErrInvalidToken и ErrTokenExpired.
+func (a *Authenticator) Authenticate(token string) (domain.Caller, error) {
+	if len(a.adminToken) > 0 && subtle.ConstantTimeCompare([]byte(token), a.adminToken) == 1 {
+		return domain.Caller{Admin: true}, nil
+	}
+
+	if len(a.jwtKey) == 0 {
+		return domain.Caller{}, ErrInvalidToken
+	}
+
+	claims, err := ParseJWT(a.jwtKey, token, a.clock.Now())
+	if err != nil {
+		return domain.Caller{}, err
+	}
+
+	return domain.Caller{UserID: claims.Subject}, nil
+}
@@ -0,0 +1,87 @@
+package auth
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"pr-manager-service/internal/clock"
+	"pr-manager-service/internal/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthenticator_Authenticate(t *testing.T) {
+	const (
+		adminToken = "admin-token"
+		jwtKey     = "0123456789abcdef0123456789abcdef"
+	)
+
+	now := time.Date(2025, time.November, 3, 10, 0, 0, 0, time.UTC)
+	authenticator := NewAuthenticator(adminToken, jwtKey, clock.NewFake(now))
+
+	userToken := func(t *testing.T, key string, claims Claims) string {
+		token, err := SignJWT([]byte(key), claims)
+		require.NoError(t, err)
+		return token
+	}
+
+	validClaims := Claims{Subject: "u1", ExpiresAt: now.Add(time.Hour).Unix()}
+
+	t.Run("admin", func(t *testing.T) {
+		caller, err := authenticator.Authenticate(adminToken)
+		require.NoError(t, err)
+		assert.Equal(t, domain.Caller{Admin: true}, caller)
+	})
+
+	t.Run("user", func(t *testing.T) {
+		caller, err := authenticator.Authenticate(userToken(t, jwtKey, validClaims))
+		require.NoError(t, err)
+		assert.Equal(t, domain.Caller{UserID: "u1"}, caller)
+	})
+
+	t.Run("wrong_key", func(t *testing.T) {
+		_, err := authenticator.Authenticate(userToken(t, "another key", validClaims))
+		assert.ErrorIs(t, err, ErrInvalidToken)
+	})
+
+	t.Run("tampered_claims", func(t *testing.T) {
+		token := userToken(t, jwtKey, validClaims)
+		forged := userToken(t, jwtKey, Claims{Subject: "u2", ExpiresAt: validClaims.ExpiresAt})
+
+		parts, forgedParts := strings.Split(token, "."), strings.Split(forged, ".")
+		_, err := authenticator.Authenticate(parts[0] + "." + forgedParts[1] + "." + parts[2])
+		assert.ErrorIs(t, err, ErrInvalidToken)
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		_, err := authenticator.Authenticate(userToken(t, jwtKey, Claims{Subject: "u1", ExpiresAt: now.Unix()}))
+		assert.ErrorIs(t, err, ErrTokenExpired)
+	})
+
+	t.Run("not_yet_valid", func(t *testing.T) {
+		_, err := authenticator.Authenticate(userToken(t, jwtKey, Claims{
+			Subject:   "u1",
+			ExpiresAt: now.Add(2 * time.Hour).Unix(),
+			NotBefore: now.Add(time.Hour).Unix(),
+		}))
+		assert.ErrorIs(t, err, ErrTokenExpired)
+	})
+
+	t.Run("without_expiration", func(t *testing.T) {
+		_, err := authenticator.Authenticate(userToken(t, jwtKey, Claims{Subject: "u1"}))
+		assert.ErrorIs(t, err, ErrInvalidToken)
+	})
+
+	t.Run("garbage", func(t *testing.T) {
+		_, err := authenticator.Authenticate("not a token")
+		assert.ErrorIs(t, err, ErrInvalidToken)
+	})
+
+	t.Run("user_tokens_disabled", func(t *testing.T) {
+		authenticator := NewAuthenticator(adminToken, "", clock.NewFake(now))
+		_, err := authenticator.Authenticate(userToken(t, "", validClaims))
+		assert.ErrorIs(t, err, ErrInvalidToken)
+	})
+}
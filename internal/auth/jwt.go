@@ -0,0 +1,102 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// jwtHeader - заголовок токена; поддерживается только HS256.
+const jwtHeader = `{"alg":"HS256","typ":"JWT"}`
+
+var (
+	ErrInvalidToken = errors.New("invalid token")
+	ErrTokenExpired = errors.New("token expired")
+)
+
+// Claims - поля JWT пользователя.
+type Claims struct {
+	// Subject - user_id пользователя
+	Subject string `json:"sub"`
+	// ExpiresAt - срок действия, unix-время в секундах; обязателен
+	ExpiresAt int64 `json:"exp"`
+	// NotBefore - токен действует не раньше этого момента; 0 - сразу
+	NotBefore int64 `json:"nbf,omitempty"`
+}
+
+// SignJWT подписывает claims ключом key по HS256.
+func SignJWT(key []byte, claims Claims) (string, error) {
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", fmt.Errorf("json.Marshal: %w", err)
+	}
+
+	signingInput := encodeSegment([]byte(jwtHeader)) + "." + encodeSegment(payload)
+
+	return signingInput + "." + encodeSegment(signJWT(key, signingInput)), nil
+}
+
+// ParseJWT проверяет подпись и срок действия токена в момент now и возвращает его claims.
+func ParseJWT(key []byte, token string, now time.Time) (Claims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return Claims{}, ErrInvalidToken
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return Claims{}, ErrInvalidToken
+	}
+
+	// NOTE: подпись проверяется до разбора заголовка и claims
+	if !hmac.Equal(signature, signJWT(key, parts[0]+"."+parts[1])) {
+		return Claims{}, ErrInvalidToken
+	}
+
+	var header struct {
+		Alg string `json:"alg"`
+	}
+	if err := decodeSegment(parts[0], &header); err != nil || header.Alg != "HS256" {
+		return Claims{}, ErrInvalidToken
+	}
+
+	var claims Claims
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return Claims{}, ErrInvalidToken
+	}
+
+	if claims.Subject == "" || claims.ExpiresAt == 0 {
+		return Claims{}, ErrInvalidToken
+	}
+
+	if now.Unix() >= claims.ExpiresAt || now.Unix() < claims.NotBefore {
+		return Claims{}, ErrTokenExpired
+	}
+
+	return claims, nil
+}
+
+func signJWT(key []byte, signingInput string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(signingInput))
+
+	return mac.Sum(nil)
+}
+
+func encodeSegment(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeSegment(segment string, v any) error {
+	b, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, v)
+}
@@ -0,0 +1,56 @@
+package clock
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock - источник текущего времени. Все метки времени, которые записывает сервис, берутся из него.
+// NOTE: колонки timestamp хранят время без пояса, поэтому системные часы отдают его в UTC
+type Clock interface {
+	Now() time.Time
+}
+
+type realClock struct{}
+
+// New возвращает системные часы.
+func New() Clock {
+	return realClock{}
+}
+
+func (realClock) Now() time.Time {
+	return time.Now().UTC()
+}
+
+// Fake - часы, которые идут только вручную. Безопасны для конкурентного использования.
+type Fake struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func NewFake(now time.Time) *Fake {
+	return &Fake{now: now}
+}
+
+func (f *Fake) Now() time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.now
+}
+
+// Set переводит часы на момент now.
+func (f *Fake) Set(now time.Time) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.now = now
+}
+
+// Advance переводит часы вперёд на d.
+func (f *Fake) Advance(d time.Duration) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.now = f.now.Add(d)
+}
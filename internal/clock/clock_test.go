@@ -0,0 +1,26 @@
+package clock
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFake(t *testing.T) {
+	start := time.Date(2025, time.November, 3, 12, 0, 0, 0, time.UTC)
+
+	c := NewFake(start)
+	assert.Equal(t, start, c.Now())
+	assert.Equal(t, start, c.Now(), "часы не идут сами")
+
+	c.Advance(90 * time.Minute)
+	assert.Equal(t, start.Add(90*time.Minute), c.Now())
+
+	c.Set(start)
+	assert.Equal(t, start, c.Now())
+}
+
+func TestNew(t *testing.T) {
+	assert.Equal(t, time.UTC, New().Now().Location())
+}
@@ -0,0 +1,178 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+
+	"pr-manager-service/internal/generated/api"
+
+	"github.com/samber/lo"
+)
+
+type AuditAction string
+
+const (
+	AuditTeamCreate              AuditAction = "team.create"
+	AuditUserChangeStatus        AuditAction = "user.change_status"
+	AuditPullRequestCreate       AuditAction = "pull_request.create"
+	AuditPullRequestMerge        AuditAction = "pull_request.merge"
+	AuditPullRequestChangeStatus AuditAction = "pull_request.change_status"
+	AuditPullRequestReassign     AuditAction = "pull_request.reassign"
+	AuditPullRequestReview       AuditAction = "pull_request.review"
+	AuditTeamAddMembers          AuditAction = "team.add_members"
+	AuditTeamRemoveMember        AuditAction = "team.remove_member"
+	AuditTeamMoveMember          AuditAction = "team.move_member"
+	AuditTeamSetMemberRole       AuditAction = "team.set_member_role"
+	AuditTeamRename              AuditAction = "team.rename"
+	AuditTeamDelete              AuditAction = "team.delete"
+	AuditTeamUpdateSettings      AuditAction = "team.update_settings"
+	AuditTeamSetFallbacks        AuditAction = "team.set_fallbacks"
+	AuditTeamSetOwners           AuditAction = "team.set_owners"
+	AuditWebhookCreate           AuditAction = "webhook.create"
+	AuditWebhookDelete           AuditAction = "webhook.delete"
+)
+
+type AuditTargetType string
+
+const (
+	AuditTargetTeam        AuditTargetType = "team"
+	AuditTargetUser        AuditTargetType = "user"
+	AuditTargetPullRequest AuditTargetType = "pull_request"
+	AuditTargetWebhook     AuditTargetType = "webhook"
+)
+
+// AuditEntry - запись журнала аудита об одной изменяющей операции.
+type AuditEntry struct {
+	ID int64
+	// Actor - пользователь, выполнивший операцию; пусто, если операцию выполнил сервис
+	Actor      string
+	Action     AuditAction
+	TargetType AuditTargetType
+	// TargetID - user_id, pull_request_id, имя команды или id подписки на вебхуки
+	TargetID string
+	// Before, After - состояние объекта до и после операции в JSON; пусто, если объекта не было
+	Before    json.RawMessage
+	After     json.RawMessage
+	RequestID string
+	CreatedAt time.Time
+}
+
+// AuditPullRequestState - состояние PR в журнале аудита.
+type AuditPullRequestState struct {
+	Name        string                `json:"name"`
+	AuthorID    string                `json:"author_id"`
+	TeamName    string                `json:"team_name"`
+	Status      api.PullRequestStatus `json:"status"`
+	ReviewerIDs []string              `json:"reviewer_ids"`
+}
+
+func NewAuditPullRequestState(pr PullRequest) AuditPullRequestState {
+	return AuditPullRequestState{
+		Name:        pr.Name,
+		AuthorID:    pr.AuthorUserID,
+		TeamName:    pr.TeamName,
+		Status:      ConvertPullRequestStatusToApi(pr.Status),
+		ReviewerIDs: lo.CoalesceSliceOrEmpty(pr.ReviewersUsersIDs, []string{}),
+	}
+}
+
+// WithReviewerReplaced возвращает состояние, в котором ревьювер oldReviewerID заменён на newReviewerID.
+func (s AuditPullRequestState) WithReviewerReplaced(oldReviewerID, newReviewerID string) AuditPullRequestState {
+	s.ReviewerIDs = lo.Map(s.ReviewerIDs, func(reviewerID string, _ int) string {
+		if reviewerID == oldReviewerID {
+			return newReviewerID
+		}
+
+		return reviewerID
+	})
+
+	return s
+}
+
+// AuditUserState - состояние пользователя в журнале аудита.
+type AuditUserState struct {
+	IsActive bool `json:"is_active"`
+}
+
+// AuditTeamState - название и настройки выбора ревьюверов команды в журнале аудита.
+type AuditTeamState struct {
+	Name               string           `json:"name"`
+	ReviewerStrategy   ReviewerStrategy `json:"reviewer_strategy"`
+	MinReviewers       int              `json:"min_reviewers"`
+	MaxReviewers       int              `json:"max_reviewers"`
+	RequiredApprovals  int              `json:"required_approvals"`
+	PreferWorkingHours bool             `json:"prefer_working_hours"`
+	ReviewSLAHours     int              `json:"review_sla_hours"`
+}
+
+func NewAuditTeamState(team Team) AuditTeamState {
+	return AuditTeamState{
+		Name:               team.Name,
+		ReviewerStrategy:   team.ReviewerStrategy,
+		MinReviewers:       team.MinReviewers,
+		MaxReviewers:       team.MaxReviewers,
+		RequiredApprovals:  team.RequiredApprovals,
+		PreferWorkingHours: team.PreferWorkingHours,
+		ReviewSLAHours:     team.ReviewSLAHours,
+	}
+}
+
+// AuditReviewState - решение ревьювера в журнале аудита.
+type AuditReviewState struct {
+	UserID  string        `json:"user_id"`
+	Verdict ReviewVerdict `json:"verdict"`
+	Comment string        `json:"comment"`
+}
+
+func NewAuditReviewState(review Review) AuditReviewState {
+	return AuditReviewState{
+		UserID:  review.UserID,
+		Verdict: review.Verdict,
+		Comment: review.Comment,
+	}
+}
+
+// AuditWebhookState - подписка на вебхуки в журнале аудита. Ключ подписи в журнал не попадает.
+type AuditWebhookState struct {
+	URL        string      `json:"url"`
+	EventTypes []EventType `json:"events"`
+}
+
+func NewAuditWebhookState(subscription WebhookSubscription) AuditWebhookState {
+	return AuditWebhookState{
+		URL:        subscription.URL,
+		EventTypes: lo.CoalesceSliceOrEmpty(subscription.EventTypes, []EventType{}),
+	}
+}
+
+type GetAuditLogRequest struct {
+	TargetType AuditTargetType `json:"target_type" validate:"omitempty,oneof=team user pull_request webhook"`
+	TargetID   string          `json:"target_id"   validate:"omitempty,max=255"`
+	Actor      string          `json:"actor"       validate:"omitempty,max=255"`
+	From       *time.Time      `json:"from"`
+	To         *time.Time      `json:"to"`
+	// Cursor - id последней записи предыдущей страницы; пусто - первая страница
+	Cursor int64 `json:"cursor" validate:"min=0"`
+	Limit  int   `json:"limit"  validate:"min=1,max=100"`
+}
+
+// AuditLogPage - страница журнала аудита от новых записей к старым.
+type AuditLogPage struct {
+	Entries []AuditEntry
+	// NextCursor - курсор следующей страницы; 0, если страница последняя
+	NextCursor int64
+}
+
+func ConvertAuditEntry(entry AuditEntry) api.AuditEntry {
+	return api.AuditEntry{
+		Id:         entry.ID,
+		Actor:      lo.EmptyableToPtr(entry.Actor),
+		Action:     api.AuditAction(entry.Action),
+		TargetType: api.AuditTargetType(entry.TargetType),
+		TargetId:   entry.TargetID,
+		Before:     entry.Before,
+		After:      entry.After,
+		RequestId:  lo.EmptyableToPtr(entry.RequestID),
+		CreatedAt:  entry.CreatedAt,
+	}
+}
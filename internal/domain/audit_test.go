@@ -0,0 +1,17 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuditPullRequestState_WithReviewerReplaced(t *testing.T) {
+	state := AuditPullRequestState{ReviewerIDs: []string{"u1", "u2"}}
+
+	replaced := state.WithReviewerReplaced("u1", "u3")
+
+	assert.Equal(t, []string{"u3", "u2"}, replaced.ReviewerIDs)
+	// NOTE: исходное состояние не меняется, оно пишется в журнал как before
+	assert.Equal(t, []string{"u1", "u2"}, state.ReviewerIDs)
+}
@@ -0,0 +1,45 @@
+package domain
+
+import (
+	"time"
+
+	"pr-manager-service/internal/generated/api"
+
+	"github.com/samber/lo"
+)
+
+// Unavailability - период, когда пользователь отсутствует и не выбирается ревьювером.
+type Unavailability struct {
+	ID       int64
+	UserID   string
+	StartsAt time.Time
+	EndsAt   time.Time
+	Reason   string
+}
+
+// Covers проверяет, что момент t попадает в окно отсутствия: начало включается, конец - нет.
+func (w Unavailability) Covers(t time.Time) bool {
+	return !t.Before(w.StartsAt) && t.Before(w.EndsAt)
+}
+
+type CreateUnavailabilityRequest struct {
+	UserID   string    `json:"user_id"   validate:"required,min=1,max=36"`
+	StartsAt time.Time `json:"starts_at" validate:"required"`
+	EndsAt   time.Time `json:"ends_at"   validate:"required,gtfield=StartsAt"`
+	Reason   string    `json:"reason"    validate:"max=200"`
+}
+
+type DeleteUnavailabilityRequest struct {
+	UserID string `json:"user_id" validate:"required,min=1,max=36"`
+	ID     int64  `json:"id"      validate:"required,min=1"`
+}
+
+func ConvertUnavailability(window Unavailability) api.UnavailabilityWindow {
+	return api.UnavailabilityWindow{
+		Id:       window.ID,
+		UserId:   window.UserID,
+		StartsAt: window.StartsAt,
+		EndsAt:   window.EndsAt,
+		Reason:   lo.EmptyableToPtr(window.Reason),
+	}
+}
@@ -0,0 +1,21 @@
+package domain
+
+// AdminActor - исполнитель операций по токену администратора в журнале аудита.
+// Символ @ не даёт спутать его с user_id.
+const AdminActor = "@admin"
+
+// Caller - кто выполняет запрос: администратор или пользователь.
+type Caller struct {
+	// UserID - пользователь из токена; пусто для токена администратора
+	UserID string
+	Admin  bool
+}
+
+// Actor возвращает исполнителя для журнала аудита.
+func (c Caller) Actor() string {
+	if c.Admin {
+		return AdminActor
+	}
+
+	return c.UserID
+}
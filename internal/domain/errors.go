@@ -2,6 +2,7 @@ package domain
 
 import (
 	"errors"
+	"strings"
 )
 
 var (
@@ -14,7 +15,22 @@ var (
 	ErrNotAssigned         = errors.New("reviewer is not assigned to this PR")
 	ErrNoCandidate         = errors.New("no active replacement candidate in team")
 	ErrUserInactive        = errors.New("inactive user cannot create a pull request")
+	ErrReviewersAtCapacity = errors.New("all candidates reached open reviews limit")
+	ErrSelfFallback        = errors.New("team cannot be its own fallback")
+	ErrInvalidOwnerPattern = errors.New("invalid owner rule pattern")
+	ErrInvalidTransition   = errors.New("pull request status transition is not allowed")
+	ErrPRNotActive         = errors.New("cannot reassign on draft or closed PR")
+	ErrNotEnoughApprovals  = errors.New("not enough approvals to merge")
+	ErrAlreadyInTeam       = errors.New("user is already a member of this team")
+	ErrAmbiguousTeam       = errors.New("user is a member of several teams, team_name is required")
+	ErrTeamNotEmpty        = errors.New("team has members")
+	ErrForbidden           = errors.New("operation is not allowed for caller")
+	ErrInvalidCursor       = errors.New("invalid cursor")
 	ErrInternal            = errors.New("internal server error")
+
+	ErrUnavailabilityNotFound      = errors.New("unavailability window not found")
+	ErrApprovalsExceedReviewers    = errors.New("required_approvals must not exceed max_reviewers")
+	ErrWebhookSubscriptionNotFound = errors.New("webhook subscription not found")
 )
 
 type ErrNotInTeam struct {
@@ -30,5 +46,5 @@ func NewErrNotInTeam(teamName string, userIDs []string) ErrNotInTeam {
 }
 
 func (e ErrNotInTeam) Error() string {
-	return "some users are not in team"
+	return "some users are not in team: " + strings.Join(e.UserIDs, ", ")
 }
@@ -0,0 +1,147 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// EventType - тип доменного события, по нему подписчики вебхуков фильтруют события.
+type EventType string
+
+const (
+	EventReviewersAssigned  EventType = "reviewers.assigned"
+	EventReviewerReassigned EventType = "reviewer.reassigned"
+	EventPullRequestMerged  EventType = "pull_request.merged"
+)
+
+// EventTypes - все типы событий в порядке, в котором они перечислены в API.
+var EventTypes = []EventType{EventReviewersAssigned, EventReviewerReassigned, EventPullRequestMerged}
+
+// EventData - данные конкретного события.
+type EventData interface {
+	EventType() EventType
+	// PullRequest - PR, к которому относится событие
+	PullRequest() string
+	// Users - пользователи, которых касается событие; по ним фильтруется поток событий
+	Users() []string
+}
+
+// Event - доменное событие; сериализуется целиком в outbox и в тело вебхука.
+type Event struct {
+	// ID - ключ идемпотентности: события доставляются как минимум один раз,
+	// и получатель отбрасывает повторные доставки по ID
+	ID         string    `json:"id"`
+	Type       EventType `json:"type"`
+	OccurredAt time.Time `json:"occurred_at"`
+	Data       EventData `json:"data"`
+}
+
+// UnmarshalJSON восстанавливает Data в типе, который соответствует Type.
+func (e *Event) UnmarshalJSON(b []byte) error {
+	var raw struct {
+		ID         string          `json:"id"`
+		Type       EventType       `json:"type"`
+		OccurredAt time.Time       `json:"occurred_at"`
+		Data       json.RawMessage `json:"data"`
+	}
+
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+
+	var (
+		data EventData
+		err  error
+	)
+
+	switch raw.Type {
+	case EventReviewersAssigned:
+		data, err = unmarshalEventData[ReviewersAssignedEvent](raw.Data)
+	case EventReviewerReassigned:
+		data, err = unmarshalEventData[ReviewerReassignedEvent](raw.Data)
+	case EventPullRequestMerged:
+		data, err = unmarshalEventData[PullRequestMergedEvent](raw.Data)
+	default:
+		return fmt.Errorf("unknown event type %q", raw.Type)
+	}
+	if err != nil {
+		return fmt.Errorf("event %s data: %w", raw.Type, err)
+	}
+
+	*e = Event{
+		ID:         raw.ID,
+		Type:       raw.Type,
+		OccurredAt: raw.OccurredAt,
+		Data:       data,
+	}
+
+	return nil
+}
+
+func unmarshalEventData[T EventData](raw json.RawMessage) (EventData, error) {
+	var data T
+	if err := json.Unmarshal(raw, &data); err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+// ReviewersAssignedEvent - PR назначены ревьюверы: при создании, переводе черновика в работу или переоткрытии.
+type ReviewersAssignedEvent struct {
+	PullRequestID string           `json:"pull_request_id"`
+	ReviewerIDs   []string         `json:"reviewer_ids"`
+	Reason        AssignmentReason `json:"reason"`
+}
+
+func (ReviewersAssignedEvent) EventType() EventType {
+	return EventReviewersAssigned
+}
+
+func (e ReviewersAssignedEvent) PullRequest() string {
+	return e.PullRequestID
+}
+
+func (e ReviewersAssignedEvent) Users() []string {
+	return e.ReviewerIDs
+}
+
+// ReviewerReassignedEvent - ревьювер PR заменён другим пользователем.
+type ReviewerReassignedEvent struct {
+	PullRequestID string           `json:"pull_request_id"`
+	OldReviewerID string           `json:"old_reviewer_id"`
+	NewReviewerID string           `json:"new_reviewer_id"`
+	Reason        AssignmentReason `json:"reason"`
+}
+
+func (ReviewerReassignedEvent) EventType() EventType {
+	return EventReviewerReassigned
+}
+
+func (e ReviewerReassignedEvent) PullRequest() string {
+	return e.PullRequestID
+}
+
+func (e ReviewerReassignedEvent) Users() []string {
+	return []string{e.OldReviewerID, e.NewReviewerID}
+}
+
+// PullRequestMergedEvent - PR смержен.
+type PullRequestMergedEvent struct {
+	PullRequestID string   `json:"pull_request_id"`
+	AuthorID      string   `json:"author_id"`
+	ReviewerIDs   []string `json:"reviewer_ids"`
+}
+
+func (PullRequestMergedEvent) EventType() EventType {
+	return EventPullRequestMerged
+}
+
+func (e PullRequestMergedEvent) PullRequest() string {
+	return e.PullRequestID
+}
+
+func (e PullRequestMergedEvent) Users() []string {
+	return append([]string{e.AuthorID}, e.ReviewerIDs...)
+}
@@ -0,0 +1,20 @@
+package domain
+
+// StreamEvent - событие журнала вместе с его позицией в потоке событий.
+type StreamEvent struct {
+	// Seq - позиция в порядке передачи событий релеем outbox, используется как id SSE-события
+	Seq   int64
+	Event Event
+}
+
+// EventStreamFilter - какие события отдавать в поток; пустые поля не фильтруют.
+type EventStreamFilter struct {
+	UserID   string `json:"user_id"   validate:"omitempty,max=36"`
+	TeamName string `json:"team_name" validate:"omitempty,min=2,max=50"`
+}
+
+type OpenEventStreamRequest struct {
+	Filter EventStreamFilter `json:"filter"`
+	// LastEventID - позиция, после которой продолжить поток; пусто - начать с новых событий
+	LastEventID *int64 `json:"last_event_id" validate:"omitempty,min=0"`
+}
@@ -0,0 +1,64 @@
+package domain
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvent_JSON(t *testing.T) {
+	occurredAt := time.Date(2025, time.November, 12, 12, 0, 0, 0, time.UTC)
+
+	events := []Event{
+		{
+			ID:         "1",
+			Type:       EventReviewersAssigned,
+			OccurredAt: occurredAt,
+			Data: ReviewersAssignedEvent{
+				PullRequestID: "100",
+				ReviewerIDs:   []string{"101", "102"},
+				Reason:        AssignmentReasonInitial,
+			},
+		},
+		{
+			ID:         "2",
+			Type:       EventReviewerReassigned,
+			OccurredAt: occurredAt,
+			Data: ReviewerReassignedEvent{
+				PullRequestID: "100",
+				OldReviewerID: "101",
+				NewReviewerID: "103",
+				Reason:        AssignmentReasonReassign,
+			},
+		},
+		{
+			ID:         "3",
+			Type:       EventPullRequestMerged,
+			OccurredAt: occurredAt,
+			Data:       PullRequestMergedEvent{PullRequestID: "100", AuthorID: "104"},
+		},
+	}
+
+	for _, event := range events {
+		payload, err := json.Marshal(event)
+		require.NoError(t, err)
+
+		var got Event
+		require.NoError(t, json.Unmarshal(payload, &got))
+		assert.Equal(t, event, got)
+	}
+
+	t.Run("unknown_type", func(t *testing.T) {
+		var got Event
+		require.Error(t, json.Unmarshal([]byte(`{"id":"1","type":"unknown","data":{}}`), &got))
+	})
+}
+
+func TestEventData_Users(t *testing.T) {
+	assert.Equal(t, []string{"101", "102"}, ReviewersAssignedEvent{ReviewerIDs: []string{"101", "102"}}.Users())
+	assert.Equal(t, []string{"101", "103"}, ReviewerReassignedEvent{OldReviewerID: "101", NewReviewerID: "103"}.Users())
+	assert.Equal(t, []string{"100", "101"}, PullRequestMergedEvent{AuthorID: "100", ReviewerIDs: []string{"101"}}.Users())
+}
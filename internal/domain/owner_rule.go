@@ -0,0 +1,148 @@
+package domain
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+
+	"pr-manager-service/internal/generated/api"
+)
+
+// OwnerTeamPrefix - владелец с этим префиксом ссылается на команду, иначе на user_id.
+const OwnerTeamPrefix = "@"
+
+// OwnerRule - правило владения путями в синтаксисе CODEOWNERS.
+// Для каждого файла применяется последнее подходящее правило.
+type OwnerRule struct {
+	Pattern string   `json:"pattern" validate:"required,max=255"`
+	Owners  []string `json:"owners"  validate:"required,min=1,max=20,dive,required,max=51"`
+}
+
+type SetTeamOwnersRequest struct {
+	TeamName string      `json:"team_name" validate:"required,min=2,max=50"`
+	Rules    []OwnerRule `json:"rules"     validate:"max=500,dive"`
+}
+
+// ParseOwner разбирает владельца: "@team name" - команда, иначе - user_id.
+func ParseOwner(owner string) (id string, isTeam bool) {
+	if name, ok := strings.CutPrefix(owner, OwnerTeamPrefix); ok {
+		return name, true
+	}
+
+	return owner, false
+}
+
+// CompileOwnerPattern переводит шаблон CODEOWNERS в регулярное выражение.
+//   - шаблон с "/" в начале или середине привязан к корню репозитория, иначе совпадает на любой глубине;
+//   - "/" в конце означает каталог;
+//   - "*" и "?" не выходят за пределы одного сегмента пути, "**" совпадает с любым числом сегментов;
+//   - шаблон каталога ("/" в конце или последний сегмент без "*" и "?") распространяется на всё его содержимое,
+//     а "docs/*" совпадает только с файлами самого каталога docs.
+//
+// Как и в CODEOWNERS, не поддерживаются "!", "[ ]" и экранирование "\".
+func CompileOwnerPattern(pattern string) (*regexp.Regexp, error) {
+	if pattern == "" || strings.HasPrefix(pattern, "!") || strings.ContainsAny(pattern, `[]\`) {
+		return nil, ErrInvalidOwnerPattern
+	}
+
+	anchored := strings.Contains(strings.TrimSuffix(pattern, "/"), "/")
+	dirOnly := strings.HasSuffix(pattern, "/")
+	lastSegment := pattern[strings.LastIndex(strings.TrimSuffix(pattern, "/"), "/")+1:]
+	subtree := !strings.ContainsAny(lastSegment, "*?")
+
+	body := strings.Trim(pattern, "/")
+	if body == "" {
+		return nil, ErrInvalidOwnerPattern
+	}
+
+	var expr strings.Builder
+	if anchored {
+		expr.WriteString("^")
+	} else {
+		expr.WriteString("^(?:.*/)?")
+	}
+
+	for i := 0; i < len(body); i++ {
+		switch {
+		case strings.HasPrefix(body[i:], "**/"):
+			expr.WriteString("(?:.*/)?")
+			i += 2
+		case strings.HasPrefix(body[i:], "**"):
+			expr.WriteString(".*")
+			i++
+		case body[i] == '*':
+			expr.WriteString("[^/]*")
+		case body[i] == '?':
+			expr.WriteString("[^/]")
+		default:
+			expr.WriteString(regexp.QuoteMeta(body[i : i+1]))
+		}
+	}
+
+	switch {
+	case dirOnly:
+		expr.WriteString("/.*$")
+	case subtree:
+		expr.WriteString("(?:/.*)?$")
+	default:
+		expr.WriteString("$")
+	}
+
+	return regexp.Compile(expr.String())
+}
+
+// MatchOwners возвращает владельцев изменённых файлов без повторов в порядке появления.
+func MatchOwners(rules []OwnerRule, paths []string) ([]string, error) {
+	patterns := make([]*regexp.Regexp, 0, len(rules))
+	for _, rule := range rules {
+		re, err := CompileOwnerPattern(rule.Pattern)
+		if err != nil {
+			return nil, err
+		}
+		patterns = append(patterns, re)
+	}
+
+	owners := []string{}
+	for _, path := range paths {
+		path = strings.TrimPrefix(path, "/")
+
+		for i := len(rules) - 1; i >= 0; i-- {
+			if !patterns[i].MatchString(path) {
+				continue
+			}
+
+			for _, owner := range rules[i].Owners {
+				if !slices.Contains(owners, owner) {
+					owners = append(owners, owner)
+				}
+			}
+			break
+		}
+	}
+
+	return owners, nil
+}
+
+func ConvertOwnerRulesToApi(rules []OwnerRule) []api.OwnerRule {
+	result := make([]api.OwnerRule, 0, len(rules))
+	for _, rule := range rules {
+		result = append(result, api.OwnerRule{
+			Pattern: rule.Pattern,
+			Owners:  rule.Owners,
+		})
+	}
+
+	return result
+}
+
+func ConvertOwnerRulesToDomain(rules []api.OwnerRule) []OwnerRule {
+	result := make([]OwnerRule, 0, len(rules))
+	for _, rule := range rules {
+		result = append(result, OwnerRule{
+			Pattern: rule.Pattern,
+			Owners:  rule.Owners,
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,105 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompileOwnerPattern(t *testing.T) {
+	testCases := []struct {
+		pattern   string
+		match     []string
+		notMatch  []string
+		expectErr bool
+	}{
+		{
+			pattern: "*",
+			match:   []string{"main.go", "internal/storage/team.go"},
+		},
+		{
+			pattern:  "*.sql",
+			match:    []string{"init.sql", "migrations/0001_init.up.sql"},
+			notMatch: []string{"init.sql.go"},
+		},
+		{
+			pattern:  "/internal/storage/",
+			match:    []string{"internal/storage/team.go", "internal/storage/sub/dir.go"},
+			notMatch: []string{"internal/storage", "pkg/internal/storage/team.go"},
+		},
+		{
+			pattern:  "docs/*",
+			match:    []string{"docs/readme.md"},
+			notMatch: []string{"internal/docs/readme.md", "docs/a/b.md"},
+		},
+		{
+			pattern:  "*.md",
+			match:    []string{"Readme.md", "docs/a/b.md"},
+			notMatch: []string{"docs/readme.md/a.go"},
+		},
+		{
+			pattern:  "**/logs",
+			match:    []string{"logs/a.txt", "build/logs/a.txt", "deeply/nested/logs/sub/a.txt"},
+			notMatch: []string{"build/logs.txt"},
+		},
+		{
+			pattern:  "/docs/api",
+			match:    []string{"docs/api", "docs/api/v1/openapi.yml"},
+			notMatch: []string{"docs/api.md"},
+		},
+		{
+			pattern:  "storage",
+			match:    []string{"storage/team.go", "internal/storage/team.go"},
+			notMatch: []string{"internal/storage.go"},
+		},
+		{
+			pattern:  "api/**/openapi.yml",
+			match:    []string{"api/openapi.yml", "api/v1/public/openapi.yml"},
+			notMatch: []string{"openapi.yml"},
+		},
+		{
+			pattern:  "internal/*/team.go",
+			match:    []string{"internal/storage/team.go"},
+			notMatch: []string{"internal/a/b/team.go"},
+		},
+		{pattern: "!*.go", expectErr: true},
+		{pattern: "[ab].go", expectErr: true},
+		{pattern: "/", expectErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.pattern, func(t *testing.T) {
+			re, err := CompileOwnerPattern(tc.pattern)
+			if tc.expectErr {
+				require.ErrorIs(t, err, ErrInvalidOwnerPattern)
+				return
+			}
+			require.NoError(t, err)
+
+			for _, path := range tc.match {
+				assert.True(t, re.MatchString(path), path)
+			}
+			for _, path := range tc.notMatch {
+				assert.False(t, re.MatchString(path), path)
+			}
+		})
+	}
+}
+
+func TestMatchOwners(t *testing.T) {
+	rules := []OwnerRule{
+		{Pattern: "*", Owners: []string{"@backend"}},
+		{Pattern: "/internal/storage/", Owners: []string{"u1"}},
+		{Pattern: "*.sql", Owners: []string{"u2", "u1"}},
+	}
+
+	// NOTE: для каждого файла применяется последнее подходящее правило
+	owners, err := MatchOwners(rules, []string{
+		"/internal/storage/team.go",
+		"migrations/0001_init.up.sql",
+		"Readme.md",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"u1", "u2", "@backend"}, owners)
+}
@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+)
+
+// PageCursor - позиция keyset-пагинации: ключ сортировки последней записи страницы.
+// Клиенту передаётся непрозрачной строкой.
+type PageCursor struct {
+	Time time.Time `json:"t"`
+	ID   string    `json:"id"`
+}
+
+// Encode возвращает курсор строкой для ответа API.
+func (c PageCursor) Encode() string {
+	// NOTE: структура из времени и строки всегда сериализуется
+	raw, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// DecodePageCursor разбирает курсор из запроса; пустая строка - первая страница.
+func DecodePageCursor(cursor string) (*PageCursor, error) {
+	if cursor == "" {
+		return nil, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	var result PageCursor
+	if err := json.Unmarshal(raw, &result); err != nil || result.ID == "" {
+		return nil, ErrInvalidCursor
+	}
+
+	return &result, nil
+}
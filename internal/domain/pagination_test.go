@@ -0,0 +1,41 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPageCursor(t *testing.T) {
+	t.Run("round_trip", func(t *testing.T) {
+		cursor := PageCursor{
+			Time: time.Date(2025, 11, 3, 10, 0, 0, 123456000, time.UTC),
+			ID:   "pr-1",
+		}
+
+		decoded, err := DecodePageCursor(cursor.Encode())
+		require.NoError(t, err)
+		require.NotNil(t, decoded)
+		assert.True(t, cursor.Time.Equal(decoded.Time))
+		assert.Equal(t, cursor.ID, decoded.ID)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		decoded, err := DecodePageCursor("")
+		require.NoError(t, err)
+		assert.Nil(t, decoded)
+	})
+
+	for name, cursor := range map[string]string{
+		"not_base64": "%%%",
+		"not_json":   "bm90IGpzb24",
+		"without_id": PageCursor{Time: time.Now()}.Encode(),
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := DecodePageCursor(cursor)
+			assert.ErrorIs(t, err, ErrInvalidCursor)
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package domain
+
+import "slices"
+
+// Principal - вызывающий вместе с его ролями в командах; по нему проверяются политики доступа.
+type Principal struct {
+	Caller
+	// Service - операцию выполняет сам сервис, например фоновый воркер; политики её не ограничивают
+	Service bool
+	// Teams - роли пользователя в командах; для администратора и сервиса не заполняется
+	Teams []TeamMembership
+}
+
+// ServicePrincipal - вызывающий для операций без запроса.
+var ServicePrincipal = Principal{Service: true}
+
+// NewPrincipal собирает вызывающего-пользователя с его ролями в командах.
+func NewPrincipal(caller Caller, user User) Principal {
+	return Principal{
+		Caller: caller,
+		Teams:  user.Teams,
+	}
+}
+
+// AuthorizeReassign разрешает переназначить ревьювера PR автору и lead команды PR.
+func (p Principal) AuthorizeReassign(pr PullRequest) error {
+	if p.privileged() || p.UserID == pr.AuthorUserID || p.hasRole(pr.TeamID, TeamRoleLead) {
+		return nil
+	}
+
+	return ErrForbidden
+}
+
+// AuthorizeMerge разрешает смержить PR автору, maintainer и lead команды PR.
+func (p Principal) AuthorizeMerge(pr PullRequest) error {
+	if p.privileged() ||
+		p.UserID == pr.AuthorUserID ||
+		p.hasRole(pr.TeamID, TeamRoleMaintainer, TeamRoleLead) {
+		return nil
+	}
+
+	return ErrForbidden
+}
+
+// AuthorizeStatusChange разрешает закрыть, переоткрыть PR или перевести черновик в работу тем же,
+// кто может его смержить: автору, maintainer и lead команды PR.
+func (p Principal) AuthorizeStatusChange(pr PullRequest) error {
+	return p.AuthorizeMerge(pr)
+}
+
+// AuthorizeActAs разрешает действовать от имени пользователя userID, например оставлять решение ревьювера
+// или создавать PR как автор, только самому пользователю. Администратор и сервис действуют от имени любого.
+func (p Principal) AuthorizeActAs(userID string) error {
+	if p.privileged() || p.UserID == userID {
+		return nil
+	}
+
+	return ErrForbidden
+}
+
+// AuthorizeUserStatusChange разрешает менять флаг активности самому пользователю
+// и lead любой из его команд. Участие target в командах должно быть заполнено.
+func (p Principal) AuthorizeUserStatusChange(target User) error {
+	if p.privileged() || p.UserID == target.ID {
+		return nil
+	}
+
+	for _, membership := range target.Teams {
+		if p.hasRole(membership.TeamID, TeamRoleLead) {
+			return nil
+		}
+	}
+
+	return ErrForbidden
+}
+
+func (p Principal) privileged() bool {
+	return p.Service || p.Admin
+}
+
+func (p Principal) hasRole(teamID string, roles ...TeamRole) bool {
+	return slices.ContainsFunc(p.Teams, func(m TeamMembership) bool {
+		return m.TeamID == teamID && slices.Contains(roles, m.Role)
+	})
+}
@@ -0,0 +1,129 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func userPrincipal(userID string, teams ...TeamMembership) Principal {
+	return NewPrincipal(Caller{UserID: userID}, User{ID: userID, Teams: teams})
+}
+
+func TestPrincipal_AuthorizeReassign(t *testing.T) {
+	pr := PullRequest{ID: "pr-1", AuthorUserID: "author", TeamID: "backend"}
+
+	tests := []struct {
+		name      string
+		principal Principal
+		allowed   bool
+	}{
+		{"service", ServicePrincipal, true},
+		{"admin", Principal{Caller: Caller{Admin: true}}, true},
+		{"author", userPrincipal("author"), true},
+		{"team_lead", userPrincipal("lead", TeamMembership{TeamID: "backend", Role: TeamRoleLead}), true},
+		{"team_maintainer", userPrincipal("m", TeamMembership{TeamID: "backend", Role: TeamRoleMaintainer}), false},
+		{"team_member", userPrincipal("u", TeamMembership{TeamID: "backend", Role: TeamRoleMember}), false},
+		{"other_team_lead", userPrincipal("lead", TeamMembership{TeamID: "frontend", Role: TeamRoleLead}), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.principal.AuthorizeReassign(pr)
+			if tt.allowed {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, ErrForbidden)
+			}
+		})
+	}
+}
+
+func TestPrincipal_AuthorizeMerge(t *testing.T) {
+	pr := PullRequest{ID: "pr-1", AuthorUserID: "author", TeamID: "backend"}
+
+	tests := []struct {
+		name      string
+		principal Principal
+		allowed   bool
+	}{
+		{"service", ServicePrincipal, true},
+		{"admin", Principal{Caller: Caller{Admin: true}}, true},
+		{"author", userPrincipal("author"), true},
+		{"team_lead", userPrincipal("lead", TeamMembership{TeamID: "backend", Role: TeamRoleLead}), true},
+		{"team_maintainer", userPrincipal("m", TeamMembership{TeamID: "backend", Role: TeamRoleMaintainer}), true},
+		{"team_member", userPrincipal("u", TeamMembership{TeamID: "backend", Role: TeamRoleMember}), false},
+		{"other_team_maintainer", userPrincipal("m", TeamMembership{TeamID: "frontend", Role: TeamRoleMaintainer}), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.principal.AuthorizeMerge(pr)
+			if tt.allowed {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, ErrForbidden)
+			}
+		})
+	}
+}
+
+func TestPrincipal_AuthorizeUserStatusChange(t *testing.T) {
+	target := User{
+		ID: "u2",
+		Teams: []TeamMembership{
+			{TeamID: "backend", Role: TeamRoleMember},
+			{TeamID: "platform", Role: TeamRoleMember},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		principal Principal
+		allowed   bool
+	}{
+		{"service", ServicePrincipal, true},
+		{"admin", Principal{Caller: Caller{Admin: true}}, true},
+		{"self", userPrincipal("u2"), true},
+		{"lead_of_second_team", userPrincipal("lead", TeamMembership{TeamID: "platform", Role: TeamRoleLead}), true},
+		{"maintainer", userPrincipal("m", TeamMembership{TeamID: "backend", Role: TeamRoleMaintainer}), false},
+		{"teammate", userPrincipal("u3", TeamMembership{TeamID: "backend", Role: TeamRoleMember}), false},
+		{"other_team_lead", userPrincipal("lead", TeamMembership{TeamID: "frontend", Role: TeamRoleLead}), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.principal.AuthorizeUserStatusChange(target)
+			if tt.allowed {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, ErrForbidden)
+			}
+		})
+	}
+}
+
+func TestPrincipal_AuthorizeActAs(t *testing.T) {
+	tests := []struct {
+		name      string
+		principal Principal
+		allowed   bool
+	}{
+		{"service", ServicePrincipal, true},
+		{"admin", Principal{Caller: Caller{Admin: true}}, true},
+		{"self", userPrincipal("u2"), true},
+		{"team_lead", userPrincipal("lead", TeamMembership{TeamID: "backend", Role: TeamRoleLead}), false},
+		{"other_user", userPrincipal("u3"), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.principal.AuthorizeActAs("u2")
+			if tt.allowed {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, ErrForbidden)
+			}
+		})
+	}
+}
@@ -1,8 +1,13 @@
 package domain
 
 import (
-	"pr-manager-service/internal/generated/api"
+	"fmt"
+	"slices"
 	"time"
+
+	"pr-manager-service/internal/generated/api"
+
+	"github.com/samber/lo"
 )
 
 type PullRequest struct {
@@ -13,21 +18,112 @@ type PullRequest struct {
 	CreatedAt         *time.Time
 	MergedAt          *time.Time
 	Status            PullRequestStatus
+	// TeamID, TeamName - команда, для которой создан PR; из неё выбираются ревьюверы
+	TeamID   string
+	TeamName string
+	// NeedsMoreReviewers - при создании в команде не хватило активных участников до min_reviewers
+	NeedsMoreReviewers bool
+	// ReviewerPools - из какой команды выбран каждый ревьювер, ключ - user_id
+	ReviewerPools map[string]ReviewerPool
+	// ChangedFiles - пути изменённых файлов, по ним назначаются владельцы кода
+	ChangedFiles []string
+	// IsOverdue - хотя бы один ревьювер держит PR дольше SLA команды; заполняется usecases
+	IsOverdue bool
+}
+
+type ReviewerPool struct {
+	TeamName   string `json:"team_name"`
+	IsFallback bool   `json:"is_fallback"`
+	IsOwner    bool   `json:"is_owner"`
+	// AssignedAt - время назначения ревьювера; при переназначении отсчёт SLA начинается заново
+	AssignedAt time.Time `json:"assigned_at"`
+	// IsOverdue - ревьювер держит PR дольше SLA команды; заполняется usecases
+	IsOverdue bool `json:"-"`
+	// Escalated - воркер эскалации не нашёл замену ревьюверу и больше не обрабатывает назначение
+	Escalated bool `json:"escalated"`
+}
+
+// ReviewerAssignment - выбранный ревьювер и команда, из которой он выбран.
+type ReviewerAssignment struct {
+	UserID     string
+	TeamID     string
+	TeamName   string
+	IsFallback bool
+	// IsOwner - ревьювер назначен как владелец изменённых файлов
+	IsOwner bool
+	// AssignedBy - исполнитель, по запросу которого назначен ревьювер (см. Caller.Actor); пусто, если назначил сервис
+	AssignedBy string
 }
 
+// AssignmentReason - причина назначения или снятия ревьювера, хранится в истории назначений.
+type AssignmentReason string
+
+const (
+	AssignmentReasonInitial      AssignmentReason = "initial"
+	AssignmentReasonReassign     AssignmentReason = "reassign"
+	AssignmentReasonDeactivation AssignmentReason = "deactivation"
+	AssignmentReasonTeamChange   AssignmentReason = "team_change"
+	AssignmentReasonEscalation   AssignmentReason = "escalation"
+)
+
 type PullRequestStatus uint8
 
 const (
-	StatusOpen   PullRequestStatus = 0
-	StatusMerged PullRequestStatus = 1
+	StatusOpen     PullRequestStatus = 0
+	StatusMerged   PullRequestStatus = 1
+	StatusDraft    PullRequestStatus = 2
+	StatusClosed   PullRequestStatus = 3
+	StatusReopened PullRequestStatus = 4
 )
 
+// ActivePullRequestStatuses - статусы, в которых PR ждёт ревью и учитывается в нагрузке ревьюверов.
+var ActivePullRequestStatuses = []PullRequestStatus{StatusOpen, StatusReopened}
+
+// pullRequestTransitions - единственное место, где описаны допустимые переходы между статусами PR.
+var pullRequestTransitions = map[PullRequestStatus][]PullRequestStatus{
+	StatusDraft:    {StatusOpen, StatusClosed},
+	StatusOpen:     {StatusMerged, StatusClosed},
+	StatusReopened: {StatusMerged, StatusClosed},
+	StatusClosed:   {StatusReopened},
+	StatusMerged:   {},
+}
+
+// IsActive сообщает, ждёт ли PR ревью.
+func (s PullRequestStatus) IsActive() bool {
+	return slices.Contains(ActivePullRequestStatuses, s)
+}
+
+// CanTransitionTo сообщает, допустим ли переход PR из статуса s в статус next.
+func (s PullRequestStatus) CanTransitionTo(next PullRequestStatus) bool {
+	return slices.Contains(pullRequestTransitions[s], next)
+}
+
+// ValidateTransition возвращает ErrInvalidTransition, если переход из from в to запрещён.
+func ValidateTransition(from, to PullRequestStatus) error {
+	if !from.CanTransitionTo(to) {
+		return fmt.Errorf(
+			"%w: %s -> %s",
+			ErrInvalidTransition,
+			ConvertPullRequestStatusToApi(from),
+			ConvertPullRequestStatusToApi(to),
+		)
+	}
+
+	return nil
+}
+
 func ConvertPullRequestStatusToDomain(status api.PullRequestStatus) PullRequestStatus {
 	switch status {
 	case api.OPEN:
 		return StatusOpen
 	case api.MERGED:
 		return StatusMerged
+	case api.DRAFT:
+		return StatusDraft
+	case api.CLOSED:
+		return StatusClosed
+	case api.REOPENED:
+		return StatusReopened
 	}
 	return StatusOpen
 }
@@ -38,24 +134,186 @@ func ConvertPullRequestStatusToApi(status PullRequestStatus) api.PullRequestStat
 		return api.OPEN
 	case StatusMerged:
 		return api.MERGED
+	case StatusDraft:
+		return api.DRAFT
+	case StatusClosed:
+		return api.CLOSED
+	case StatusReopened:
+		return api.REOPENED
 	}
 	return api.OPEN
 }
 
 func ConvertPullRequest(pr PullRequest) api.PullRequest {
 	return api.PullRequest{
-		PullRequestId:     pr.ID,
-		PullRequestName:   pr.Name,
-		AuthorId:          pr.AuthorUserID,
-		AssignedReviewers: pr.ReviewersUsersIDs,
-		Status:            ConvertPullRequestStatusToApi(pr.Status),
-		CreatedAt:         pr.CreatedAt,
-		MergedAt:          pr.MergedAt,
+		PullRequestId:      pr.ID,
+		PullRequestName:    pr.Name,
+		AuthorId:           pr.AuthorUserID,
+		TeamName:           lo.EmptyableToPtr(pr.TeamName),
+		AssignedReviewers:  pr.ReviewersUsersIDs,
+		Status:             ConvertPullRequestStatusToApi(pr.Status),
+		CreatedAt:          pr.CreatedAt,
+		MergedAt:           pr.MergedAt,
+		NeedsMoreReviewers: pr.NeedsMoreReviewers,
+		ReviewerPools:      ConvertReviewerPools(pr.ReviewersUsersIDs, pr.ReviewerPools),
+		IsOverdue:          pr.IsOverdue,
+	}
+}
+
+func ConvertReviewerPools(reviewersIDs []string, pools map[string]ReviewerPool) []api.ReviewerPool {
+	result := make([]api.ReviewerPool, 0, len(reviewersIDs))
+
+	for _, reviewerID := range reviewersIDs {
+		pool, ok := pools[reviewerID]
+		if !ok {
+			continue
+		}
+
+		result = append(result, api.ReviewerPool{
+			UserId:     reviewerID,
+			TeamName:   pool.TeamName,
+			IsFallback: pool.IsFallback,
+			IsOwner:    pool.IsOwner,
+			AssignedAt: lo.EmptyableToPtr(pool.AssignedAt),
+			IsOverdue:  lo.ToPtr(pool.IsOverdue),
+		})
+	}
+
+	return result
+}
+
+// ReviewerPoolsFromAssignments собирает команды ревьюверов, назначенных в момент assignedAt.
+func ReviewerPoolsFromAssignments(assignments []ReviewerAssignment, assignedAt time.Time) map[string]ReviewerPool {
+	pools := make(map[string]ReviewerPool, len(assignments))
+
+	for _, assignment := range assignments {
+		pools[assignment.UserID] = ReviewerPool{
+			TeamName:   assignment.TeamName,
+			IsFallback: assignment.IsFallback,
+			IsOwner:    assignment.IsOwner,
+			AssignedAt: assignedAt,
+		}
 	}
+
+	return pools
 }
 
 type CreatePullRequestRequest struct {
 	ID           string `json:"pull_request_id"   validate:"required,min=1,max=36"`
 	Name         string `json:"pull_request_name" validate:"required,min=2,max=50"`
 	AuthorUserID string `json:"author_id"         validate:"required,min=1,max=36"`
+	// TeamName - команда, для которой создаётся PR; обязательна, если автор состоит в нескольких командах
+	TeamName string `json:"team_name" validate:"omitempty,min=2,max=50"`
+	// ChangedFiles - пути изменённых файлов для назначения владельцев кода
+	ChangedFiles []string `json:"changed_files" validate:"max=1000,dive,required,max=4096"`
+	// Draft - PR создаётся черновиком, ревьюверы назначаются после markReady
+	Draft bool `json:"draft"`
+}
+
+// ListPullRequestsRequest - фильтры списка PR; фильтры совмещаются.
+type ListPullRequestsRequest struct {
+	Statuses   []api.PullRequestStatus `json:"status"      validate:"unique,dive,oneof=OPEN MERGED DRAFT CLOSED REOPENED"`
+	AuthorID   string                  `json:"author_id"   validate:"omitempty,max=36"`
+	ReviewerID string                  `json:"reviewer_id" validate:"omitempty,max=36"`
+	TeamName   string                  `json:"team_name"   validate:"omitempty,min=2,max=50"`
+	// CreatedFrom, CreatedTo - полуинтервал [from, to) времени создания
+	CreatedFrom *time.Time `json:"created_from"`
+	CreatedTo   *time.Time `json:"created_to"`
+	// MergedFrom, MergedTo - полуинтервал [from, to) времени мержа; PR без мержа не попадают
+	MergedFrom *time.Time `json:"merged_from"`
+	MergedTo   *time.Time `json:"merged_to"`
+	// Cursor - next_cursor предыдущей страницы; пусто - первая страница
+	Cursor string `json:"cursor" validate:"omitempty,max=512"`
+	Limit  int    `json:"limit"  validate:"min=1,max=100"`
+}
+
+// PullRequestStatuses возвращает фильтр статусов в доменных значениях.
+func (r ListPullRequestsRequest) PullRequestStatuses() []PullRequestStatus {
+	return convertPullRequestStatusesToDomain(r.Statuses)
+}
+
+func convertPullRequestStatusesToDomain(statuses []api.PullRequestStatus) []PullRequestStatus {
+	return lo.Map(statuses, func(status api.PullRequestStatus, _ int) PullRequestStatus {
+		return ConvertPullRequestStatusToDomain(status)
+	})
+}
+
+// PullRequestFilter - условия выборки списка PR в storage.
+type PullRequestFilter struct {
+	Statuses    []PullRequestStatus
+	AuthorID    string
+	ReviewerID  string
+	TeamID      string
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	MergedFrom  *time.Time
+	MergedTo    *time.Time
+	// After - PR, идущие в списке после этой позиции
+	After *PageCursor
+}
+
+// PullRequestPage - страница списка PR от новых к старым.
+type PullRequestPage struct {
+	PullRequests []PullRequest
+	// NextCursor - курсор следующей страницы; пусто, если страница последняя
+	NextCursor string
+}
+
+// GetUserReviewsRequest - запрос PR, где пользователь сейчас ревьювер.
+type GetUserReviewsRequest struct {
+	UserID   string                  `json:"user_id" validate:"required,min=1,max=36"`
+	Statuses []api.PullRequestStatus `json:"status"  validate:"min=1,unique,dive,oneof=OPEN MERGED DRAFT CLOSED REOPENED"`
+	// Cursor - next_cursor предыдущей страницы; пусто - первая страница
+	Cursor string `json:"cursor" validate:"omitempty,max=512"`
+	Limit  int    `json:"limit"  validate:"min=1,max=100"`
+}
+
+// PullRequestStatuses возвращает фильтр статусов в доменных значениях.
+func (r GetUserReviewsRequest) PullRequestStatuses() []PullRequestStatus {
+	return convertPullRequestStatusesToDomain(r.Statuses)
+}
+
+// AssignedReviewFilter - условия выборки PR, где пользователь сейчас ревьювер, в storage.
+type AssignedReviewFilter struct {
+	UserID   string
+	Statuses []PullRequestStatus
+	// After - PR, идущие после этой позиции: времени назначения ревьювера и id PR
+	After *PageCursor
+}
+
+// AssignedReview - PR, где пользователь сейчас ревьювер, и время его назначения.
+type AssignedReview struct {
+	PullRequest PullRequest
+	AssignedAt  time.Time
+}
+
+// UserReviewsPage - страница PR ревьювера от дольше всех ждущих его ревью к недавно назначенным.
+type UserReviewsPage struct {
+	Reviews []AssignedReview
+	// NextCursor - курсор следующей страницы; пусто, если страница последняя
+	NextCursor string
+	// Total - сколько PR подходит под фильтр статусов на всех страницах
+	Total int
+	// StatusCounts - сколько PR пользователь ревьюит в каждом статусе, без учёта фильтра
+	StatusCounts map[PullRequestStatus]int
+}
+
+func ConvertAssignedReview(review AssignedReview) api.PullRequestShort {
+	return api.PullRequestShort{
+		PullRequestId:   review.PullRequest.ID,
+		PullRequestName: review.PullRequest.Name,
+		AuthorId:        review.PullRequest.AuthorUserID,
+		Status:          ConvertPullRequestStatusToApi(review.PullRequest.Status),
+		AssignedAt:      lo.EmptyableToPtr(review.AssignedAt),
+	}
+}
+
+func ConvertReviewStatusCounts(counts map[PullRequestStatus]int) api.ReviewStatusCounts {
+	return api.ReviewStatusCounts{
+		OPEN:     counts[StatusOpen],
+		REOPENED: counts[StatusReopened],
+		DRAFT:    counts[StatusDraft],
+		CLOSED:   counts[StatusClosed],
+		MERGED:   counts[StatusMerged],
+	}
 }
@@ -0,0 +1,42 @@
+package domain
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateTransition(t *testing.T) {
+	allowed := map[PullRequestStatus][]PullRequestStatus{
+		StatusDraft:    {StatusOpen, StatusClosed},
+		StatusOpen:     {StatusMerged, StatusClosed},
+		StatusReopened: {StatusMerged, StatusClosed},
+		StatusClosed:   {StatusReopened},
+		StatusMerged:   {},
+	}
+	statuses := []PullRequestStatus{StatusDraft, StatusOpen, StatusReopened, StatusClosed, StatusMerged}
+
+	for _, from := range statuses {
+		for _, to := range statuses {
+			name := string(ConvertPullRequestStatusToApi(from)) + "_" + string(ConvertPullRequestStatusToApi(to))
+
+			t.Run(name, func(t *testing.T) {
+				err := ValidateTransition(from, to)
+				if slices.Contains(allowed[from], to) {
+					assert.NoError(t, err)
+				} else {
+					assert.ErrorIs(t, err, ErrInvalidTransition)
+				}
+			})
+		}
+	}
+}
+
+func TestPullRequestStatus_IsActive(t *testing.T) {
+	assert.True(t, StatusOpen.IsActive())
+	assert.True(t, StatusReopened.IsActive())
+	assert.False(t, StatusDraft.IsActive())
+	assert.False(t, StatusClosed.IsActive())
+	assert.False(t, StatusMerged.IsActive())
+}
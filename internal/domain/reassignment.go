@@ -0,0 +1,59 @@
+package domain
+
+import "pr-manager-service/internal/generated/api"
+
+// ReviewerReplacement - замена ревьювера в PR. NewReviewerID пуст, если замены не нашлось.
+type ReviewerReplacement struct {
+	PullRequestID string
+	OldReviewerID string
+	NewReviewerID string
+}
+
+// ReassignmentResult - итог замены пользователя во всех его активных PR.
+type ReassignmentResult struct {
+	Reassigned  []ReviewerReplacement
+	NoCandidate []ReviewerReplacement
+}
+
+// EscalationResult - итог обработки просроченных ревью воркером эскалации.
+// Reassigned - ревью переданы коллегам, Escalated - лиду команды, Unresolved - замены не нашлось,
+// Failed - обработка завершилась ошибкой и будет повторена в следующем проходе.
+type EscalationResult struct {
+	Reassigned []ReviewerReplacement
+	Escalated  []ReviewerReplacement
+	Unresolved []ReviewerReplacement
+	Failed     []ReviewerReplacement
+}
+
+// DeactivateUsersResult - итог массовой деактивации: кто из пользователей был активен и как заменены их ревью.
+type DeactivateUsersResult struct {
+	DeactivatedUserIDs []string
+	ReassignmentResult
+}
+
+func ConvertReviewerReplacements(replacements []ReviewerReplacement) []api.ReviewerReplacement {
+	result := make([]api.ReviewerReplacement, 0, len(replacements))
+
+	for _, replacement := range replacements {
+		result = append(result, api.ReviewerReplacement{
+			PullRequestId: replacement.PullRequestID,
+			OldUserId:     replacement.OldReviewerID,
+			ReplacedBy:    replacement.NewReviewerID,
+		})
+	}
+
+	return result
+}
+
+func ConvertUnreplacedReviewers(replacements []ReviewerReplacement) []api.UnreplacedReviewer {
+	result := make([]api.UnreplacedReviewer, 0, len(replacements))
+
+	for _, replacement := range replacements {
+		result = append(result, api.UnreplacedReviewer{
+			PullRequestId: replacement.PullRequestID,
+			UserId:        replacement.OldReviewerID,
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,43 @@
+package domain
+
+import (
+	"time"
+
+	"pr-manager-service/internal/generated/api"
+)
+
+type ReviewVerdict string
+
+const (
+	VerdictApprove        ReviewVerdict = "APPROVE"
+	VerdictRequestChanges ReviewVerdict = "REQUEST_CHANGES"
+	// VerdictComment не меняет решение ревьювера: при подсчёте одобрений учитывается последний
+	// вердикт APPROVE или REQUEST_CHANGES.
+	VerdictComment ReviewVerdict = "COMMENT"
+)
+
+type Review struct {
+	ID            int64
+	PullRequestID string
+	UserID        string
+	Verdict       ReviewVerdict
+	Comment       string
+	SubmittedAt   time.Time
+}
+
+type SubmitReviewRequest struct {
+	PullRequestID string        `json:"pull_request_id" validate:"required,min=1,max=36"`
+	UserID        string        `json:"user_id"         validate:"required,min=1,max=36"`
+	Verdict       ReviewVerdict `json:"verdict"         validate:"required,oneof=APPROVE REQUEST_CHANGES COMMENT"`
+	Comment       string        `json:"comment"         validate:"max=2000"`
+}
+
+func ConvertReview(review Review) api.PullRequestReview {
+	return api.PullRequestReview{
+		PullRequestId: review.PullRequestID,
+		UserId:        review.UserID,
+		Verdict:       api.ReviewVerdict(review.Verdict),
+		Comment:       review.Comment,
+		SubmittedAt:   review.SubmittedAt,
+	}
+}
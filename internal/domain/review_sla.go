@@ -0,0 +1,67 @@
+package domain
+
+import "time"
+
+// BusinessTimeBetween возвращает рабочее время пользователя между from и to: учитываются только будни
+// по его часовому поясу, а если заданы рабочие часы - только они. Ночная смена относится к дню, в который началась.
+func (u User) BusinessTimeBetween(from, to time.Time) time.Duration {
+	if !to.After(from) {
+		return 0
+	}
+
+	location := u.location()
+	from, to = from.In(location), to.In(location)
+
+	var total time.Duration
+
+	// NOTE: начинаем с предыдущего дня, чтобы учесть ночную смену, которая началась до from
+	day := time.Date(from.Year(), from.Month(), from.Day()-1, 0, 0, 0, 0, location)
+	for day.Before(to) {
+		next := day.AddDate(0, 0, 1)
+
+		if day.Weekday() != time.Saturday && day.Weekday() != time.Sunday {
+			start, end := day, next
+			if u.WorkingHours != nil {
+				start = atMinute(day, u.WorkingHours.StartMinute)
+				end = atMinute(day, u.WorkingHours.EndMinute)
+				if u.WorkingHours.EndMinute <= u.WorkingHours.StartMinute {
+					end = atMinute(next, u.WorkingHours.EndMinute)
+				}
+			}
+
+			total += overlap(start, end, from, to)
+		}
+
+		day = next
+	}
+
+	return total
+}
+
+// ReviewOverdue проверяет, что ревьювер держит PR команды team дольше её SLA.
+// Время считается в рабочих часах ревьювера с момента его назначения; у команды без SLA ревью не просрочиваются.
+func ReviewOverdue(team Team, reviewer User, assignedAt, now time.Time) bool {
+	if team.ReviewSLAHours <= 0 {
+		return false
+	}
+
+	return reviewer.BusinessTimeBetween(assignedAt, now) >= time.Duration(team.ReviewSLAHours)*time.Hour
+}
+
+func atMinute(day time.Time, minute int) time.Time {
+	return time.Date(day.Year(), day.Month(), day.Day(), 0, minute, 0, 0, day.Location())
+}
+
+func overlap(start, end, from, to time.Time) time.Duration {
+	if start.Before(from) {
+		start = from
+	}
+	if end.After(to) {
+		end = to
+	}
+	if !end.After(start) {
+		return 0
+	}
+
+	return end.Sub(start)
+}
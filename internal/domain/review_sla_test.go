@@ -0,0 +1,84 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUser_BusinessTimeBetween(t *testing.T) {
+	// NOTE: 2025-11-07 - пятница
+	friday := func(hour, minute int) time.Time {
+		return time.Date(2025, time.November, 7, hour, minute, 0, 0, time.UTC)
+	}
+	dayHours := &WorkingHours{StartMinute: 9 * 60, EndMinute: 18 * 60}
+
+	testCases := []struct {
+		name   string
+		user   User
+		from   time.Time
+		to     time.Time
+		expect time.Duration
+	}{
+		{
+			name:   "same_day",
+			user:   User{},
+			from:   friday(10, 0),
+			to:     friday(13, 30),
+			expect: 3*time.Hour + 30*time.Minute,
+		},
+		{
+			name:   "weekend_skipped",
+			user:   User{},
+			from:   friday(12, 0),
+			to:     friday(12, 0).AddDate(0, 0, 3),
+			expect: 24 * time.Hour,
+		},
+		{
+			name:   "working_hours",
+			user:   User{Timezone: "UTC", WorkingHours: dayHours},
+			from:   friday(17, 0),
+			to:     friday(10, 0).AddDate(0, 0, 3),
+			expect: 2 * time.Hour,
+		},
+		{
+			// NOTE: 06:00 UTC - 09:00 в Москве
+			name:   "user_timezone",
+			user:   User{Timezone: "Europe/Moscow", WorkingHours: dayHours},
+			from:   friday(5, 0),
+			to:     friday(8, 0),
+			expect: 2 * time.Hour,
+		},
+		{
+			// NOTE: смена с вечера пятницы считается целиком, смена с вечера воскресенья до утра понедельника - нет
+			name:   "overnight",
+			user:   User{Timezone: "UTC", WorkingHours: &WorkingHours{StartMinute: 22 * 60, EndMinute: 6 * 60}},
+			from:   friday(12, 0),
+			to:     friday(12, 0).AddDate(0, 0, 3),
+			expect: 8 * time.Hour,
+		},
+		{
+			name:   "reversed",
+			user:   User{},
+			from:   friday(13, 0),
+			to:     friday(12, 0),
+			expect: 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expect, tc.user.BusinessTimeBetween(tc.from, tc.to))
+		})
+	}
+}
+
+func TestReviewOverdue(t *testing.T) {
+	assignedAt := time.Date(2025, time.November, 7, 12, 0, 0, 0, time.UTC)
+	now := assignedAt.AddDate(0, 0, 3)
+
+	assert.True(t, ReviewOverdue(Team{ReviewSLAHours: 24}, User{}, assignedAt, now))
+	assert.False(t, ReviewOverdue(Team{ReviewSLAHours: 25}, User{}, assignedAt, now))
+	assert.False(t, ReviewOverdue(Team{}, User{}, assignedAt, now))
+}
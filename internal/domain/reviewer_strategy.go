@@ -0,0 +1,45 @@
+package domain
+
+import "pr-manager-service/internal/generated/api"
+
+// ReviewerStrategy - стратегия выбора ревьюверов для PR.
+type ReviewerStrategy string
+
+const (
+	// ReviewerStrategyRandom - случайный выбор среди активных коллег.
+	ReviewerStrategyRandom ReviewerStrategy = "random"
+	// ReviewerStrategyRoundRobin - выбор по кругу внутри команды.
+	ReviewerStrategyRoundRobin ReviewerStrategy = "round_robin"
+	// ReviewerStrategyLeastLoaded - выбор коллег с наименьшим числом открытых ревью.
+	ReviewerStrategyLeastLoaded ReviewerStrategy = "least_loaded"
+	// ReviewerStrategyLeastRecentlyAssigned - выбор коллег, которых назначали давнее всего.
+	ReviewerStrategyLeastRecentlyAssigned ReviewerStrategy = "least_recently_assigned"
+)
+
+func (s ReviewerStrategy) IsValid() bool {
+	switch s {
+	case ReviewerStrategyRandom,
+		ReviewerStrategyRoundRobin,
+		ReviewerStrategyLeastLoaded,
+		ReviewerStrategyLeastRecentlyAssigned:
+		return true
+	}
+	return false
+}
+
+func ConvertReviewerStrategyToApi(strategy ReviewerStrategy) *api.ReviewerStrategy {
+	if strategy == "" {
+		return nil
+	}
+
+	apiStrategy := api.ReviewerStrategy(strategy)
+	return &apiStrategy
+}
+
+func ConvertReviewerStrategyToDomain(strategy *api.ReviewerStrategy) ReviewerStrategy {
+	if strategy == nil {
+		return ""
+	}
+
+	return ReviewerStrategy(*strategy)
+}
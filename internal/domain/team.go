@@ -1,16 +1,119 @@
 package domain
 
+const (
+	DefaultMinReviewers = 1
+	DefaultMaxReviewers = 2
+)
+
 type Team struct {
-	ID   string
-	Name string
+	ID                string
+	Name              string
+	ReviewerStrategy  ReviewerStrategy
+	MinReviewers      int
+	MaxReviewers      int
+	RequiredApprovals int
+	// PreferWorkingHours - выбирать в первую очередь кандидатов, у которых сейчас рабочее время
+	PreferWorkingHours bool
+	// ReviewSLAHours - сколько рабочих часов ревьювер может держать PR; 0 - без ограничения
+	ReviewSLAHours int
 }
 
 type CreateTeamRequest struct {
-	Name    string              `json:"team_name" validate:"required,min=2,max=50"`
-	Members []CreateUserRequest `json:"members"   validate:"required,min=2,max=50"`
+	Name               string              `json:"team_name"          validate:"required,min=2,max=50"`
+	Members            []CreateUserRequest `json:"members"            validate:"required,min=2,max=50"`
+	ReviewerStrategy   ReviewerStrategy    `json:"reviewer_strategy"  validate:"omitempty,oneof=random round_robin least_loaded least_recently_assigned"`
+	MinReviewers       int                 `json:"min_reviewers"      validate:"min=0,max=10"`
+	MaxReviewers       int                 `json:"max_reviewers"      validate:"min=1,max=10,gtefield=MinReviewers"`
+	RequiredApprovals  int                 `json:"required_approvals" validate:"min=0,max=10,ltefield=MaxReviewers"`
+	PreferWorkingHours bool                `json:"prefer_working_hours"`
+	ReviewSLAHours     int                 `json:"review_sla_hours"   validate:"min=0,max=720"`
+}
+
+// UpdateTeamSettingsRequest - новые настройки выбора ревьюверов; nil-поля не меняются.
+type UpdateTeamSettingsRequest struct {
+	TeamName           string            `json:"team_name"          validate:"required,min=2,max=50"`
+	ReviewerStrategy   *ReviewerStrategy `json:"reviewer_strategy"  validate:"omitnil,oneof=random round_robin least_loaded least_recently_assigned"`
+	MinReviewers       int               `json:"min_reviewers"      validate:"min=0,max=10"`
+	MaxReviewers       int               `json:"max_reviewers"      validate:"min=1,max=10,gtefield=MinReviewers"`
+	RequiredApprovals  *int              `json:"required_approvals" validate:"omitnil,min=0,max=10"`
+	PreferWorkingHours *bool             `json:"prefer_working_hours"`
+	ReviewSLAHours     *int              `json:"review_sla_hours"   validate:"omitnil,min=0,max=720"`
+}
+
+// ApplyTo возвращает команду с настройками из запроса; незаданные настройки остаются прежними.
+func (r UpdateTeamSettingsRequest) ApplyTo(team Team) Team {
+	team.MinReviewers = r.MinReviewers
+	team.MaxReviewers = r.MaxReviewers
+
+	if r.ReviewerStrategy != nil {
+		team.ReviewerStrategy = *r.ReviewerStrategy
+	}
+
+	if r.RequiredApprovals != nil {
+		team.RequiredApprovals = *r.RequiredApprovals
+	}
+
+	if r.PreferWorkingHours != nil {
+		team.PreferWorkingHours = *r.PreferWorkingHours
+	}
+
+	if r.ReviewSLAHours != nil {
+		team.ReviewSLAHours = *r.ReviewSLAHours
+	}
+
+	return team
+}
+
+// ValidateSettings проверяет, что настройки команды совместимы: одобрений для мержа требуется
+// не больше, чем назначается ревьюверов, иначе PR команды нельзя будет смержить.
+func (t Team) ValidateSettings() error {
+	if t.RequiredApprovals > t.MaxReviewers {
+		return ErrApprovalsExceedReviewers
+	}
+
+	return nil
+}
+
+type SetTeamFallbacksRequest struct {
+	TeamName          string   `json:"team_name"           validate:"required,min=2,max=50"`
+	FallbackTeamNames []string `json:"fallback_team_names" validate:"max=10,unique,dive,min=2,max=50"`
 }
 
 type DeactivateUsersRequest struct {
 	TeamName string   `json:"team_name" validate:"required,min=2,max=50"`
-	UserIDs  []string `json:"user_ids"  validate:"required,min=2,max=50,dive,min=1,max=36"`
+	UserIDs  []string `json:"user_ids"  validate:"required,min=2,max=50,unique,dive,min=1,max=36"`
+}
+
+type AddTeamMembersRequest struct {
+	TeamName string              `json:"team_name" validate:"required,min=2,max=50"`
+	Members  []CreateUserRequest `json:"members"   validate:"required,min=1,max=50,unique=ID"`
+}
+
+type RemoveTeamMemberRequest struct {
+	TeamName string `json:"team_name" validate:"required,min=2,max=50"`
+	UserID   string `json:"user_id"   validate:"required,min=1,max=36"`
+}
+
+type MoveTeamMemberRequest struct {
+	UserID   string `json:"user_id"   validate:"required,min=1,max=36"`
+	TeamName string `json:"team_name" validate:"required,min=2,max=50"`
+	// FromTeamName - команда, из которой переносится пользователь; обязательна, если он состоит в нескольких командах
+	FromTeamName string `json:"from_team_name" validate:"omitempty,min=2,max=50,nefield=TeamName"`
+	// ReassignReviews - заменить пользователя в его PR в статусах OPEN и REOPENED коллегами из прежней команды
+	ReassignReviews bool `json:"reassign_reviews"`
+}
+
+type SetTeamMemberRoleRequest struct {
+	TeamName string   `json:"team_name" validate:"required,min=2,max=50"`
+	UserID   string   `json:"user_id"   validate:"required,min=1,max=36"`
+	Role     TeamRole `json:"role"      validate:"required,oneof=member maintainer lead"`
+}
+
+type RenameTeamRequest struct {
+	TeamName    string `json:"team_name"     validate:"required,min=2,max=50"`
+	NewTeamName string `json:"new_team_name" validate:"required,min=2,max=50,nefield=TeamName"`
+}
+
+type DeleteTeamRequest struct {
+	TeamName string `json:"team_name" validate:"required,min=2,max=50"`
 }
@@ -1,19 +1,111 @@
 package domain
 
+import (
+	"slices"
+
+	"pr-manager-service/internal/generated/api"
+
+	"github.com/samber/lo"
+)
+
 type User struct {
 	ID       string
 	Name     string
 	IsActive bool
-	TeamID   string
+	// Teams - команды пользователя в порядке вступления; заполняется только GetUserFull и GetTeamFullByName
+	Teams []TeamMembership
+	// Timezone - часовой пояс из базы IANA, в котором заданы WorkingHours
+	Timezone     string
+	WorkingHours *WorkingHours
+}
+
+// TeamRole - роль пользователя в команде.
+type TeamRole string
+
+const (
+	TeamRoleMember TeamRole = "member"
+	// TeamRoleMaintainer может мержить PR команды
+	TeamRoleMaintainer TeamRole = "maintainer"
+	// TeamRoleLead получает права maintainer, а также переназначает ревьюверов и меняет активность участников команды
+	TeamRoleLead TeamRole = "lead"
+)
+
+// TeamMembership - участие пользователя в команде.
+type TeamMembership struct {
+	TeamID   string   `json:"team_id"`
+	TeamName string   `json:"team_name"`
+	Role     TeamRole `json:"role"`
+}
+
+// Membership возвращает участие пользователя в команде teamID.
+func (u User) Membership(teamID string) (TeamMembership, bool) {
+	idx := slices.IndexFunc(u.Teams, func(m TeamMembership) bool {
+		return m.TeamID == teamID
+	})
+	if idx < 0 {
+		return TeamMembership{}, false
+	}
+
+	return u.Teams[idx], true
+}
+
+// TeamNames возвращает названия команд пользователя в порядке вступления.
+func (u User) TeamNames() []string {
+	return lo.Map(u.Teams, func(m TeamMembership, _ int) string {
+		return m.TeamName
+	})
 }
 
 type CreateUserRequest struct {
-	ID       string `json:"user_id"   validate:"required,min=1,max=36"`
-	Name     string `json:"username"  validate:"required,min=2,max=50"`
-	IsActive bool   `json:"is_active" validate:"required"`
+	ID       string   `json:"user_id"   validate:"required,min=1,max=36"`
+	Name     string   `json:"username"  validate:"required,min=2,max=50"`
+	IsActive bool     `json:"is_active" validate:"required"`
+	Role     TeamRole `json:"role"      validate:"omitempty,oneof=member maintainer lead"`
 }
 
 type UpdateUserStatusRequest struct {
 	ID       string `json:"username"  validate:"required,min=1,max=36"`
 	IsActive bool   `json:"is_active"`
 }
+
+func ConvertTeamRoleToApi(role TeamRole) *api.TeamRole {
+	if role == "" {
+		return nil
+	}
+
+	apiRole := api.TeamRole(role)
+	return &apiRole
+}
+
+func ConvertTeamRoleToDomain(role *api.TeamRole) TeamRole {
+	if role == nil {
+		return ""
+	}
+
+	return TeamRole(*role)
+}
+
+// ConvertUser собирает пользователя для ответа; team_name - первая команда пользователя.
+func ConvertUser(user User) api.User {
+	result := api.User{
+		UserId:       user.ID,
+		Username:     user.Name,
+		IsActive:     user.IsActive,
+		Teams:        make([]api.UserTeam, 0, len(user.Teams)),
+		Timezone:     lo.EmptyableToPtr(user.Timezone),
+		WorkingHours: ConvertWorkingHoursToApi(user.WorkingHours),
+	}
+
+	for _, membership := range user.Teams {
+		result.Teams = append(result.Teams, api.UserTeam{
+			TeamName: membership.TeamName,
+			Role:     api.TeamRole(membership.Role),
+		})
+	}
+
+	if len(user.Teams) > 0 {
+		result.TeamName = user.Teams[0].TeamName
+	}
+
+	return result
+}
@@ -0,0 +1,153 @@
+package domain
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"pr-manager-service/internal/generated/api"
+
+	"github.com/samber/lo"
+)
+
+const (
+	// WebhookMaxAttempts - после стольких неудачных попыток доставка считается проваленной.
+	WebhookMaxAttempts = 8
+
+	webhookRetryBaseDelay = 10 * time.Second
+	webhookRetryMaxDelay  = time.Hour
+
+	webhookSignaturePrefix = "sha256="
+)
+
+// WebhookSubscription - подписка внешнего сервиса на события.
+type WebhookSubscription struct {
+	ID  int64
+	URL string
+	// EventTypes - на какие события подписка; пустой список - на все
+	EventTypes []EventType
+	// Secret - ключ подписи тела запроса, в ответах API не возвращается
+	Secret    string
+	CreatedAt time.Time
+}
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliveryDelivered WebhookDeliveryStatus = "delivered"
+	WebhookDeliveryFailed    WebhookDeliveryStatus = "failed"
+)
+
+// WebhookDelivery - доставка одного события одной подписке вместе с результатом последней попытки.
+type WebhookDelivery struct {
+	ID             int64
+	SubscriptionID int64
+	EventID        string
+	EventType      EventType
+	Payload        []byte
+	Status         WebhookDeliveryStatus
+	Attempts       int
+	// NextAttemptAt - когда выполнить следующую попытку; пусто, если доставка завершена
+	NextAttemptAt  *time.Time
+	LastStatusCode int
+	LastError      string
+	CreatedAt      time.Time
+	DeliveredAt    *time.Time
+	// URL и Secret берутся из подписки и заполняются только для отправки
+	URL    string
+	Secret string
+}
+
+// RecordAttempt учитывает результат попытки доставки в момент now. Ошибка err - сетевая ошибка
+// или ответ получателя не из диапазона 2xx. Неудачная доставка повторяется с экспоненциальной задержкой,
+// пока не будет исчерпано WebhookMaxAttempts попыток.
+func (d *WebhookDelivery) RecordAttempt(statusCode int, err error, now time.Time) {
+	d.Attempts++
+	d.LastStatusCode = statusCode
+	d.LastError = ""
+
+	if err == nil {
+		d.Status = WebhookDeliveryDelivered
+		d.NextAttemptAt = nil
+		d.DeliveredAt = &now
+		return
+	}
+
+	d.LastError = err.Error()
+
+	if d.Attempts >= WebhookMaxAttempts {
+		d.Status = WebhookDeliveryFailed
+		d.NextAttemptAt = nil
+		return
+	}
+
+	d.Status = WebhookDeliveryPending
+	d.NextAttemptAt = lo.ToPtr(now.Add(WebhookRetryDelay(d.Attempts)))
+}
+
+// WebhookRetryDelay возвращает задержку перед повтором после attempts неудачных попыток:
+// 10s, 20s, 40s и так далее, но не больше часа.
+func WebhookRetryDelay(attempts int) time.Duration {
+	delay := webhookRetryBaseDelay
+	for range attempts - 1 {
+		delay *= 2
+		if delay >= webhookRetryMaxDelay {
+			return webhookRetryMaxDelay
+		}
+	}
+
+	return delay
+}
+
+// SignWebhookPayload возвращает подпись тела запроса в формате sha256=<hex HMAC-SHA256>.
+func SignWebhookPayload(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+
+	return webhookSignaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+type CreateWebhookSubscriptionRequest struct {
+	URL        string      `json:"url"    validate:"required,max=2048,http_url"`
+	EventTypes []EventType `json:"events" validate:"unique,dive,oneof=reviewers.assigned reviewer.reassigned pull_request.merged"`
+	Secret     string      `json:"secret" validate:"required,min=16,max=256"`
+}
+
+type DeleteWebhookSubscriptionRequest struct {
+	ID int64 `json:"id" validate:"required,min=1"`
+}
+
+type GetWebhookDeliveriesRequest struct {
+	SubscriptionID int64                 `json:"subscription_id" validate:"required,min=1"`
+	Status         WebhookDeliveryStatus `json:"status"          validate:"omitempty,oneof=pending delivered failed"`
+	Limit          int                   `json:"limit"           validate:"min=1,max=100"`
+}
+
+func ConvertWebhookSubscription(subscription WebhookSubscription) api.WebhookSubscription {
+	return api.WebhookSubscription{
+		Id:  subscription.ID,
+		Url: subscription.URL,
+		Events: lo.Map(subscription.EventTypes, func(eventType EventType, _ int) api.WebhookEventType {
+			return api.WebhookEventType(eventType)
+		}),
+		CreatedAt: subscription.CreatedAt,
+	}
+}
+
+func ConvertWebhookDelivery(delivery WebhookDelivery) api.WebhookDelivery {
+	return api.WebhookDelivery{
+		Id:             delivery.ID,
+		SubscriptionId: delivery.SubscriptionID,
+		EventId:        delivery.EventID,
+		EventType:      api.WebhookEventType(delivery.EventType),
+		Status:         api.WebhookDeliveryStatus(delivery.Status),
+		Attempts:       delivery.Attempts,
+		NextAttemptAt:  delivery.NextAttemptAt,
+		LastStatusCode: lo.EmptyableToPtr(delivery.LastStatusCode),
+		LastError:      lo.EmptyableToPtr(delivery.LastError),
+		CreatedAt:      delivery.CreatedAt,
+		DeliveredAt:    delivery.DeliveredAt,
+	}
+}
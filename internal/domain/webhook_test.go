@@ -0,0 +1,71 @@
+package domain
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhookRetryDelay(t *testing.T) {
+	testCases := []struct {
+		attempts int
+		expect   time.Duration
+	}{
+		{attempts: 1, expect: 10 * time.Second},
+		{attempts: 2, expect: 20 * time.Second},
+		{attempts: 5, expect: 160 * time.Second},
+		{attempts: 10, expect: time.Hour},
+		{attempts: 100, expect: time.Hour},
+	}
+
+	for _, tc := range testCases {
+		assert.Equal(t, tc.expect, WebhookRetryDelay(tc.attempts), "attempts %d", tc.attempts)
+	}
+}
+
+func TestWebhookDelivery_RecordAttempt(t *testing.T) {
+	now := time.Date(2025, time.November, 12, 12, 0, 0, 0, time.UTC)
+
+	t.Run("delivered", func(t *testing.T) {
+		delivery := WebhookDelivery{Status: WebhookDeliveryPending, Attempts: 1, LastError: "timeout"}
+		delivery.RecordAttempt(http.StatusNoContent, nil, now)
+
+		assert.Equal(t, WebhookDeliveryDelivered, delivery.Status)
+		assert.Equal(t, 2, delivery.Attempts)
+		assert.Empty(t, delivery.LastError)
+		assert.Nil(t, delivery.NextAttemptAt)
+		assert.Equal(t, &now, delivery.DeliveredAt)
+	})
+
+	t.Run("retry", func(t *testing.T) {
+		delivery := WebhookDelivery{Status: WebhookDeliveryPending}
+		delivery.RecordAttempt(http.StatusInternalServerError, errors.New("unexpected status 500"), now)
+
+		assert.Equal(t, WebhookDeliveryPending, delivery.Status)
+		assert.Equal(t, 1, delivery.Attempts)
+		assert.Equal(t, http.StatusInternalServerError, delivery.LastStatusCode)
+		assert.Equal(t, "unexpected status 500", delivery.LastError)
+		assert.Equal(t, now.Add(10*time.Second), *delivery.NextAttemptAt)
+	})
+
+	t.Run("attempts_exhausted", func(t *testing.T) {
+		delivery := WebhookDelivery{Status: WebhookDeliveryPending, Attempts: WebhookMaxAttempts - 1}
+		delivery.RecordAttempt(0, errors.New("connection refused"), now)
+
+		assert.Equal(t, WebhookDeliveryFailed, delivery.Status)
+		assert.Equal(t, WebhookMaxAttempts, delivery.Attempts)
+		assert.Nil(t, delivery.NextAttemptAt)
+		assert.Nil(t, delivery.DeliveredAt)
+	})
+}
+
+func TestSignWebhookPayload(t *testing.T) {
+	// NOTE: эталон - echo -n '{"id":"1"}' | openssl dgst -sha256 -hmac 'secret'
+	assert.Equal(t,
+		"sha256=6146142a2ce0159e84c0767881e4ec80bc397da62526e7d19f70795eb79460c0",
+		SignWebhookPayload("secret", []byte(`{"id":"1"}`)),
+	)
+}
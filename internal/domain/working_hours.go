@@ -0,0 +1,92 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"pr-manager-service/internal/generated/api"
+)
+
+const (
+	DefaultTimezone = "UTC"
+
+	workingHoursLayout = "15:04"
+)
+
+// WorkingHours - рабочие часы пользователя в минутах от полуночи по его часовому поясу.
+// Начало включается, конец - нет; если конец раньше начала, интервал переходит через полночь.
+type WorkingHours struct {
+	StartMinute int
+	EndMinute   int
+}
+
+// Contains проверяет, что время дня t попадает в рабочие часы.
+func (h WorkingHours) Contains(t time.Time) bool {
+	minute := t.Hour()*60 + t.Minute()
+
+	if h.StartMinute <= h.EndMinute {
+		return minute >= h.StartMinute && minute < h.EndMinute
+	}
+
+	return minute >= h.StartMinute || minute < h.EndMinute
+}
+
+// InWorkingHours проверяет, что у пользователя сейчас рабочее время.
+// Пользователь без рабочих часов считается доступным в любое время.
+func (u User) InWorkingHours(now time.Time) bool {
+	if u.WorkingHours == nil {
+		return true
+	}
+
+	return u.WorkingHours.Contains(now.In(u.location()))
+}
+
+// location возвращает часовой пояс пользователя; неизвестный или пустой считается UTC.
+func (u User) location() *time.Location {
+	location, err := time.LoadLocation(u.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+
+	return location
+}
+
+type SetWorkingHoursRequest struct {
+	UserID   string `json:"user_id"  validate:"required,min=1,max=36"`
+	Timezone string `json:"timezone" validate:"required,max=64,timezone"`
+	// Start и End задаются вместе в формате HH:MM; без них рабочие часы сбрасываются
+	Start string `json:"start" validate:"required_with=End,omitempty,datetime=15:04"`
+	End   string `json:"end"   validate:"required_with=Start,omitempty,datetime=15:04,nefield=Start"`
+}
+
+// WorkingHours возвращает рабочие часы из провалидированного запроса или nil, если они не заданы.
+func (r SetWorkingHoursRequest) WorkingHours() *WorkingHours {
+	if r.Start == "" {
+		return nil
+	}
+
+	return &WorkingHours{
+		StartMinute: parseMinuteOfDay(r.Start),
+		EndMinute:   parseMinuteOfDay(r.End),
+	}
+}
+
+func parseMinuteOfDay(value string) int {
+	t, _ := time.Parse(workingHoursLayout, value)
+	return t.Hour()*60 + t.Minute()
+}
+
+func formatMinuteOfDay(minute int) string {
+	return fmt.Sprintf("%02d:%02d", minute/60, minute%60)
+}
+
+func ConvertWorkingHoursToApi(hours *WorkingHours) *api.WorkingHours {
+	if hours == nil {
+		return nil
+	}
+
+	return &api.WorkingHours{
+		Start: formatMinuteOfDay(hours.StartMinute),
+		End:   formatMinuteOfDay(hours.EndMinute),
+	}
+}
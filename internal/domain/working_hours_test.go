@@ -0,0 +1,87 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUser_InWorkingHours(t *testing.T) {
+	dayHours := &WorkingHours{StartMinute: 9 * 60, EndMinute: 18 * 60}
+	nightHours := &WorkingHours{StartMinute: 22 * 60, EndMinute: 6 * 60}
+
+	testCases := []struct {
+		name   string
+		user   User
+		now    time.Time
+		expect bool
+	}{
+		{
+			name:   "no_working_hours",
+			user:   User{},
+			now:    time.Date(2025, time.November, 3, 3, 0, 0, 0, time.UTC),
+			expect: true,
+		},
+		{
+			name:   "start_included",
+			user:   User{Timezone: "UTC", WorkingHours: dayHours},
+			now:    time.Date(2025, time.November, 3, 9, 0, 0, 0, time.UTC),
+			expect: true,
+		},
+		{
+			name:   "end_excluded",
+			user:   User{Timezone: "UTC", WorkingHours: dayHours},
+			now:    time.Date(2025, time.November, 3, 18, 0, 0, 0, time.UTC),
+			expect: false,
+		},
+		{
+			// NOTE: 07:00 UTC - 10:00 в Москве
+			name:   "user_timezone",
+			user:   User{Timezone: "Europe/Moscow", WorkingHours: dayHours},
+			now:    time.Date(2025, time.November, 3, 7, 0, 0, 0, time.UTC),
+			expect: true,
+		},
+		{
+			// NOTE: 12:00 в UTC+5 - 07:00 в UTC
+			name:   "now_in_other_location",
+			user:   User{Timezone: "UTC", WorkingHours: dayHours},
+			now:    time.Date(2025, time.November, 3, 12, 0, 0, 0, time.FixedZone("UTC+5", 5*60*60)),
+			expect: false,
+		},
+		{
+			name:   "overnight_before_midnight",
+			user:   User{Timezone: "UTC", WorkingHours: nightHours},
+			now:    time.Date(2025, time.November, 3, 23, 30, 0, 0, time.UTC),
+			expect: true,
+		},
+		{
+			name:   "overnight_after_midnight",
+			user:   User{Timezone: "UTC", WorkingHours: nightHours},
+			now:    time.Date(2025, time.November, 3, 5, 59, 0, 0, time.UTC),
+			expect: true,
+		},
+		{
+			name:   "overnight_daytime",
+			user:   User{Timezone: "UTC", WorkingHours: nightHours},
+			now:    time.Date(2025, time.November, 3, 12, 0, 0, 0, time.UTC),
+			expect: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expect, tc.user.InWorkingHours(tc.now))
+		})
+	}
+}
+
+func TestSetWorkingHoursRequest_WorkingHours(t *testing.T) {
+	hours := SetWorkingHoursRequest{Start: "09:30", End: "18:00"}.WorkingHours()
+	require.NotNil(t, hours)
+	assert.Equal(t, WorkingHours{StartMinute: 9*60 + 30, EndMinute: 18 * 60}, *hours)
+	assert.Equal(t, "09:30", ConvertWorkingHoursToApi(hours).Start)
+
+	assert.Nil(t, SetWorkingHoursRequest{}.WorkingHours())
+}
@@ -89,21 +89,53 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// GetAudit request
+	GetAudit(ctx context.Context, params *GetAuditParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetEventsStream request
+	GetEventsStream(ctx context.Context, params *GetEventsStreamParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostPullRequestCloseWithBody request with any body
+	PostPullRequestCloseWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostPullRequestClose(ctx context.Context, body PostPullRequestCloseJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PostPullRequestCreateWithBody request with any body
 	PostPullRequestCreateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	PostPullRequestCreate(ctx context.Context, body PostPullRequestCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetPullRequestList request
+	GetPullRequestList(ctx context.Context, params *GetPullRequestListParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostPullRequestMarkReadyWithBody request with any body
+	PostPullRequestMarkReadyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostPullRequestMarkReady(ctx context.Context, body PostPullRequestMarkReadyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PostPullRequestMergeWithBody request with any body
 	PostPullRequestMergeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	PostPullRequestMerge(ctx context.Context, body PostPullRequestMergeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetPullRequestOverdue request
+	GetPullRequestOverdue(ctx context.Context, params *GetPullRequestOverdueParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PostPullRequestReassignWithBody request with any body
 	PostPullRequestReassignWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	PostPullRequestReassign(ctx context.Context, body PostPullRequestReassignJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// PostPullRequestReopenWithBody request with any body
+	PostPullRequestReopenWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostPullRequestReopen(ctx context.Context, body PostPullRequestReopenJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostPullRequestReviewWithBody request with any body
+	PostPullRequestReviewWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostPullRequestReview(ctx context.Context, body PostPullRequestReviewJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetStatsGet request
 	GetStatsGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -112,9 +144,75 @@ type ClientInterface interface {
 
 	PostTeamAdd(ctx context.Context, body PostTeamAddJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// PostTeamAddMembersWithBody request with any body
+	PostTeamAddMembersWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostTeamAddMembers(ctx context.Context, body PostTeamAddMembersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostTeamDeactivateUsersWithBody request with any body
+	PostTeamDeactivateUsersWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostTeamDeactivateUsers(ctx context.Context, body PostTeamDeactivateUsersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostTeamDeleteWithBody request with any body
+	PostTeamDeleteWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostTeamDelete(ctx context.Context, body PostTeamDeleteJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetTeamGet request
 	GetTeamGet(ctx context.Context, params *GetTeamGetParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// PostTeamMoveMemberWithBody request with any body
+	PostTeamMoveMemberWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostTeamMoveMember(ctx context.Context, body PostTeamMoveMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTeamOwners request
+	GetTeamOwners(ctx context.Context, params *GetTeamOwnersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostTeamOwnersWithBody request with any body
+	PostTeamOwnersWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostTeamOwners(ctx context.Context, body PostTeamOwnersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostTeamRemoveMemberWithBody request with any body
+	PostTeamRemoveMemberWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostTeamRemoveMember(ctx context.Context, body PostTeamRemoveMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostTeamRenameWithBody request with any body
+	PostTeamRenameWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostTeamRename(ctx context.Context, body PostTeamRenameJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostTeamSetFallbacksWithBody request with any body
+	PostTeamSetFallbacksWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostTeamSetFallbacks(ctx context.Context, body PostTeamSetFallbacksJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostTeamSetMemberRoleWithBody request with any body
+	PostTeamSetMemberRoleWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostTeamSetMemberRole(ctx context.Context, body PostTeamSetMemberRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostTeamSetSettingsWithBody request with any body
+	PostTeamSetSettingsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostTeamSetSettings(ctx context.Context, body PostTeamSetSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUsersAvailability request
+	GetUsersAvailability(ctx context.Context, params *GetUsersAvailabilityParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostUsersAvailabilityWithBody request with any body
+	PostUsersAvailabilityWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostUsersAvailability(ctx context.Context, body PostUsersAvailabilityJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostUsersAvailabilityDeleteWithBody request with any body
+	PostUsersAvailabilityDeleteWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostUsersAvailabilityDelete(ctx context.Context, body PostUsersAvailabilityDeleteJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetUsersGetReview request
 	GetUsersGetReview(ctx context.Context, params *GetUsersGetReviewParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -122,10 +220,31 @@ type ClientInterface interface {
 	PostUsersSetIsActiveWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	PostUsersSetIsActive(ctx context.Context, body PostUsersSetIsActiveJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostUsersSetWorkingHoursWithBody request with any body
+	PostUsersSetWorkingHoursWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostUsersSetWorkingHours(ctx context.Context, body PostUsersSetWorkingHoursJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostWebhooksDeleteWithBody request with any body
+	PostWebhooksDeleteWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostWebhooksDelete(ctx context.Context, body PostWebhooksDeleteJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetWebhooksDeliveries request
+	GetWebhooksDeliveries(ctx context.Context, params *GetWebhooksDeliveriesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetWebhooksList request
+	GetWebhooksList(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostWebhooksSubscribeWithBody request with any body
+	PostWebhooksSubscribeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostWebhooksSubscribe(ctx context.Context, body PostWebhooksSubscribeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
-func (c *Client) PostPullRequestCreateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostPullRequestCreateRequestWithBody(c.Server, contentType, body)
+func (c *Client) GetAudit(ctx context.Context, params *GetAuditParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAuditRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -136,8 +255,8 @@ func (c *Client) PostPullRequestCreateWithBody(ctx context.Context, contentType
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostPullRequestCreate(ctx context.Context, body PostPullRequestCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostPullRequestCreateRequest(c.Server, body)
+func (c *Client) GetEventsStream(ctx context.Context, params *GetEventsStreamParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetEventsStreamRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -148,8 +267,8 @@ func (c *Client) PostPullRequestCreate(ctx context.Context, body PostPullRequest
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostPullRequestMergeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostPullRequestMergeRequestWithBody(c.Server, contentType, body)
+func (c *Client) PostPullRequestCloseWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPullRequestCloseRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -160,8 +279,8 @@ func (c *Client) PostPullRequestMergeWithBody(ctx context.Context, contentType s
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostPullRequestMerge(ctx context.Context, body PostPullRequestMergeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostPullRequestMergeRequest(c.Server, body)
+func (c *Client) PostPullRequestClose(ctx context.Context, body PostPullRequestCloseJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPullRequestCloseRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -172,8 +291,8 @@ func (c *Client) PostPullRequestMerge(ctx context.Context, body PostPullRequestM
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostPullRequestReassignWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostPullRequestReassignRequestWithBody(c.Server, contentType, body)
+func (c *Client) PostPullRequestCreateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPullRequestCreateRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -184,8 +303,8 @@ func (c *Client) PostPullRequestReassignWithBody(ctx context.Context, contentTyp
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostPullRequestReassign(ctx context.Context, body PostPullRequestReassignJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostPullRequestReassignRequest(c.Server, body)
+func (c *Client) PostPullRequestCreate(ctx context.Context, body PostPullRequestCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPullRequestCreateRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -196,8 +315,8 @@ func (c *Client) PostPullRequestReassign(ctx context.Context, body PostPullReque
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetStatsGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetStatsGetRequest(c.Server)
+func (c *Client) GetPullRequestList(ctx context.Context, params *GetPullRequestListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPullRequestListRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -208,8 +327,8 @@ func (c *Client) GetStatsGet(ctx context.Context, reqEditors ...RequestEditorFn)
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostTeamAddWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostTeamAddRequestWithBody(c.Server, contentType, body)
+func (c *Client) PostPullRequestMarkReadyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPullRequestMarkReadyRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -220,8 +339,8 @@ func (c *Client) PostTeamAddWithBody(ctx context.Context, contentType string, bo
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostTeamAdd(ctx context.Context, body PostTeamAddJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostTeamAddRequest(c.Server, body)
+func (c *Client) PostPullRequestMarkReady(ctx context.Context, body PostPullRequestMarkReadyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPullRequestMarkReadyRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -232,8 +351,8 @@ func (c *Client) PostTeamAdd(ctx context.Context, body PostTeamAddJSONRequestBod
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetTeamGet(ctx context.Context, params *GetTeamGetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetTeamGetRequest(c.Server, params)
+func (c *Client) PostPullRequestMergeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPullRequestMergeRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -244,8 +363,8 @@ func (c *Client) GetTeamGet(ctx context.Context, params *GetTeamGetParams, reqEd
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetUsersGetReview(ctx context.Context, params *GetUsersGetReviewParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetUsersGetReviewRequest(c.Server, params)
+func (c *Client) PostPullRequestMerge(ctx context.Context, body PostPullRequestMergeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPullRequestMergeRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -256,8 +375,8 @@ func (c *Client) GetUsersGetReview(ctx context.Context, params *GetUsersGetRevie
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostUsersSetIsActiveWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostUsersSetIsActiveRequestWithBody(c.Server, contentType, body)
+func (c *Client) GetPullRequestOverdue(ctx context.Context, params *GetPullRequestOverdueParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPullRequestOverdueRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -268,8 +387,8 @@ func (c *Client) PostUsersSetIsActiveWithBody(ctx context.Context, contentType s
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostUsersSetIsActive(ctx context.Context, body PostUsersSetIsActiveJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostUsersSetIsActiveRequest(c.Server, body)
+func (c *Client) PostPullRequestReassignWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPullRequestReassignRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -280,758 +399,5386 @@ func (c *Client) PostUsersSetIsActive(ctx context.Context, body PostUsersSetIsAc
 	return c.Client.Do(req)
 }
 
-// NewPostPullRequestCreateRequest calls the generic PostPullRequestCreate builder with application/json body
-func NewPostPullRequestCreateRequest(server string, body PostPullRequestCreateJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) PostPullRequestReassign(ctx context.Context, body PostPullRequestReassignJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPullRequestReassignRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostPullRequestCreateRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostPullRequestCreateRequestWithBody generates requests for PostPullRequestCreate with any type of body
-func NewPostPullRequestCreateRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PostPullRequestReopenWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPullRequestReopenRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/pullRequest/create")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostPullRequestReopen(ctx context.Context, body PostPullRequestReopenJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPullRequestReopenRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewPostPullRequestMergeRequest calls the generic PostPullRequestMerge builder with application/json body
-func NewPostPullRequestMergeRequest(server string, body PostPullRequestMergeJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) PostPullRequestReviewWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPullRequestReviewRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostPullRequestMergeRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostPullRequestMergeRequestWithBody generates requests for PostPullRequestMerge with any type of body
-func NewPostPullRequestMergeRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PostPullRequestReview(ctx context.Context, body PostPullRequestReviewJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPullRequestReviewRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/pullRequest/merge")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetStatsGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetStatsGetRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewPostPullRequestReassignRequest calls the generic PostPullRequestReassign builder with application/json body
-func NewPostPullRequestReassignRequest(server string, body PostPullRequestReassignJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) PostTeamAddWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostTeamAddRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostPullRequestReassignRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostPullRequestReassignRequestWithBody generates requests for PostPullRequestReassign with any type of body
-func NewPostPullRequestReassignRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PostTeamAdd(ctx context.Context, body PostTeamAddJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostTeamAddRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/pullRequest/reassign")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostTeamAddMembersWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostTeamAddMembersRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetStatsGetRequest generates requests for GetStatsGet
-func NewGetStatsGetRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PostTeamAddMembers(ctx context.Context, body PostTeamAddMembersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostTeamAddMembersRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/stats/get")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostTeamDeactivateUsersWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostTeamDeactivateUsersRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewPostTeamAddRequest calls the generic PostTeamAdd builder with application/json body
-func NewPostTeamAddRequest(server string, body PostTeamAddJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) PostTeamDeactivateUsers(ctx context.Context, body PostTeamDeactivateUsersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostTeamDeactivateUsersRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostTeamAddRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostTeamAddRequestWithBody generates requests for PostTeamAdd with any type of body
-func NewPostTeamAddRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PostTeamDeleteWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostTeamDeleteRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/team/add")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostTeamDelete(ctx context.Context, body PostTeamDeleteJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostTeamDeleteRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+func (c *Client) GetTeamGet(ctx context.Context, params *GetTeamGetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTeamGetRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGetTeamGetRequest generates requests for GetTeamGet
-func NewGetTeamGetRequest(server string, params *GetTeamGetParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PostTeamMoveMemberWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostTeamMoveMemberRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/team/get")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostTeamMoveMember(ctx context.Context, body PostTeamMoveMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostTeamMoveMemberRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "team_name", runtime.ParamLocationQuery, params.TeamName); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) GetTeamOwners(ctx context.Context, params *GetTeamOwnersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTeamOwnersRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGetUsersGetReviewRequest generates requests for GetUsersGetReview
-func NewGetUsersGetReviewRequest(server string, params *GetUsersGetReviewParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PostTeamOwnersWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostTeamOwnersRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/users/getReview")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostTeamOwners(ctx context.Context, body PostTeamOwnersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostTeamOwnersRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, params.UserId); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
+func (c *Client) PostTeamRemoveMemberWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostTeamRemoveMemberRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-		queryURL.RawQuery = queryValues.Encode()
+func (c *Client) PostTeamRemoveMember(ctx context.Context, body PostTeamRemoveMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostTeamRemoveMemberRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) PostTeamRenameWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostTeamRenameRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	return req, nil
+func (c *Client) PostTeamRename(ctx context.Context, body PostTeamRenameJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostTeamRenameRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostUsersSetIsActiveRequest calls the generic PostUsersSetIsActive builder with application/json body
-func NewPostUsersSetIsActiveRequest(server string, body PostUsersSetIsActiveJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) PostTeamSetFallbacksWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostTeamSetFallbacksRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostUsersSetIsActiveRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostUsersSetIsActiveRequestWithBody generates requests for PostUsersSetIsActive with any type of body
-func NewPostUsersSetIsActiveRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+func (c *Client) PostTeamSetFallbacks(ctx context.Context, body PostTeamSetFallbacksJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostTeamSetFallbacksRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) PostTeamSetMemberRoleWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostTeamSetMemberRoleRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/users/setIsActive")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+func (c *Client) PostTeamSetMemberRole(ctx context.Context, body PostTeamSetMemberRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostTeamSetMemberRoleRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostTeamSetSettingsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostTeamSetSettingsRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+func (c *Client) PostTeamSetSettings(ctx context.Context, body PostTeamSetSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostTeamSetSettingsRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req.Header.Add("Content-Type", contentType)
+func (c *Client) GetUsersAvailability(ctx context.Context, params *GetUsersAvailabilityParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUsersAvailabilityRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	return req, nil
+func (c *Client) PostUsersAvailabilityWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostUsersAvailabilityRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+func (c *Client) PostUsersAvailability(ctx context.Context, body PostUsersAvailabilityJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostUsersAvailabilityRequest(c.Server, body)
+	if err != nil {
+		return nil, err
 	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-	return nil
+	return c.Client.Do(req)
 }
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
+func (c *Client) PostUsersAvailabilityDeleteWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostUsersAvailabilityDeleteRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+func (c *Client) PostUsersAvailabilityDelete(ctx context.Context, body PostUsersAvailabilityDeleteJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostUsersAvailabilityDeleteRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+func (c *Client) GetUsersGetReview(ctx context.Context, params *GetUsersGetReviewParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUsersGetReviewRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
 }
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// PostPullRequestCreateWithBodyWithResponse request with any body
-	PostPullRequestCreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestCreateResponse, error)
-
-	PostPullRequestCreateWithResponse(ctx context.Context, body PostPullRequestCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPullRequestCreateResponse, error)
-
-	// PostPullRequestMergeWithBodyWithResponse request with any body
-	PostPullRequestMergeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestMergeResponse, error)
-
-	PostPullRequestMergeWithResponse(ctx context.Context, body PostPullRequestMergeJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPullRequestMergeResponse, error)
-
-	// PostPullRequestReassignWithBodyWithResponse request with any body
-	PostPullRequestReassignWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestReassignResponse, error)
-
-	PostPullRequestReassignWithResponse(ctx context.Context, body PostPullRequestReassignJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPullRequestReassignResponse, error)
-
-	// GetStatsGetWithResponse request
-	GetStatsGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetStatsGetResponse, error)
-
-	// PostTeamAddWithBodyWithResponse request with any body
-	PostTeamAddWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTeamAddResponse, error)
-
-	PostTeamAddWithResponse(ctx context.Context, body PostTeamAddJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTeamAddResponse, error)
-
-	// GetTeamGetWithResponse request
-	GetTeamGetWithResponse(ctx context.Context, params *GetTeamGetParams, reqEditors ...RequestEditorFn) (*GetTeamGetResponse, error)
-
-	// GetUsersGetReviewWithResponse request
-	GetUsersGetReviewWithResponse(ctx context.Context, params *GetUsersGetReviewParams, reqEditors ...RequestEditorFn) (*GetUsersGetReviewResponse, error)
-
-	// PostUsersSetIsActiveWithBodyWithResponse request with any body
-	PostUsersSetIsActiveWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUsersSetIsActiveResponse, error)
-
-	PostUsersSetIsActiveWithResponse(ctx context.Context, body PostUsersSetIsActiveJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUsersSetIsActiveResponse, error)
-}
-
-type PostPullRequestCreateResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *CreatePullRequestResponse
-	JSON404      *ErrorResponse
-	JSON409      *ErrorResponse
-}
-
-// Status returns HTTPResponse.Status
-func (r PostPullRequestCreateResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *Client) PostUsersSetIsActiveWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostUsersSetIsActiveRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
-
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostPullRequestCreateResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-	return 0
-}
-
-type PostPullRequestMergeResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *MergePullRequestResponse
-	JSON404      *ErrorResponse
+	return c.Client.Do(req)
 }
 
-// Status returns HTTPResponse.Status
-func (r PostPullRequestMergeResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *Client) PostUsersSetIsActive(ctx context.Context, body PostUsersSetIsActiveJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostUsersSetIsActiveRequest(c.Server, body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
-
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostPullRequestMergeResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-	return 0
-}
-
-type PostPullRequestReassignResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ReassignPullRequestResponse
-	JSON404      *ErrorResponse
-	JSON409      *ErrorResponse
+	return c.Client.Do(req)
 }
 
-// Status returns HTTPResponse.Status
-func (r PostPullRequestReassignResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *Client) PostUsersSetWorkingHoursWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostUsersSetWorkingHoursRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
-
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostPullRequestReassignResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-	return 0
-}
-
-type GetStatsGetResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Stats
+	return c.Client.Do(req)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetStatsGetResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *Client) PostUsersSetWorkingHours(ctx context.Context, body PostUsersSetWorkingHoursJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostUsersSetWorkingHoursRequest(c.Server, body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
-
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetStatsGetResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-	return 0
-}
-
-type PostTeamAddResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *TeamAddResponse
-	JSON400      *ErrorResponse
+	return c.Client.Do(req)
 }
 
-// Status returns HTTPResponse.Status
-func (r PostTeamAddResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *Client) PostWebhooksDeleteWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostWebhooksDeleteRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
-
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostTeamAddResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-	return 0
-}
-
-type GetTeamGetResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Team
-	JSON404      *ErrorResponse
+	return c.Client.Do(req)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetTeamGetResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *Client) PostWebhooksDelete(ctx context.Context, body PostWebhooksDeleteJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostWebhooksDeleteRequest(c.Server, body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
-
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetTeamGetResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-	return 0
-}
-
-type GetUsersGetReviewResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *UsersGetReviewResponse
+	return c.Client.Do(req)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetUsersGetReviewResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *Client) GetWebhooksDeliveries(ctx context.Context, params *GetWebhooksDeliveriesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWebhooksDeliveriesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
-
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetUsersGetReviewResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-	return 0
-}
-
-type PostUsersSetIsActiveResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *SetIsActiveResponse
-	JSON404      *ErrorResponse
+	return c.Client.Do(req)
 }
 
-// Status returns HTTPResponse.Status
-func (r PostUsersSetIsActiveResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *Client) GetWebhooksList(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWebhooksListRequest(c.Server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
-
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostUsersSetIsActiveResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-	return 0
+	return c.Client.Do(req)
 }
 
-// PostPullRequestCreateWithBodyWithResponse request with arbitrary body returning *PostPullRequestCreateResponse
-func (c *ClientWithResponses) PostPullRequestCreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestCreateResponse, error) {
-	rsp, err := c.PostPullRequestCreateWithBody(ctx, contentType, body, reqEditors...)
+func (c *Client) PostWebhooksSubscribeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostWebhooksSubscribeRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostPullRequestCreateResponse(rsp)
-}
-
-func (c *ClientWithResponses) PostPullRequestCreateWithResponse(ctx context.Context, body PostPullRequestCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPullRequestCreateResponse, error) {
-	rsp, err := c.PostPullRequestCreate(ctx, body, reqEditors...)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return ParsePostPullRequestCreateResponse(rsp)
+	return c.Client.Do(req)
 }
 
-// PostPullRequestMergeWithBodyWithResponse request with arbitrary body returning *PostPullRequestMergeResponse
-func (c *ClientWithResponses) PostPullRequestMergeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestMergeResponse, error) {
-	rsp, err := c.PostPullRequestMergeWithBody(ctx, contentType, body, reqEditors...)
+func (c *Client) PostWebhooksSubscribe(ctx context.Context, body PostWebhooksSubscribeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostWebhooksSubscribeRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostPullRequestMergeResponse(rsp)
-}
-
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewGetAuditRequest generates requests for GetAudit
+func NewGetAuditRequest(server string, params *GetAuditParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/audit")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.TargetType != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "target_type", runtime.ParamLocationQuery, *params.TargetType); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.TargetId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "target_id", runtime.ParamLocationQuery, *params.TargetId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Actor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "actor", runtime.ParamLocationQuery, *params.Actor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.From != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetEventsStreamRequest generates requests for GetEventsStream
+func NewGetEventsStreamRequest(server string, params *GetEventsStreamParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/events/stream")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.UserId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, *params.UserId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.TeamName != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "team_name", runtime.ParamLocationQuery, *params.TeamName); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.LastEventID != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Last-Event-ID", runtime.ParamLocationHeader, *params.LastEventID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Last-Event-ID", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPostPullRequestCloseRequest calls the generic PostPullRequestClose builder with application/json body
+func NewPostPullRequestCloseRequest(server string, body PostPullRequestCloseJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostPullRequestCloseRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostPullRequestCloseRequestWithBody generates requests for PostPullRequestClose with any type of body
+func NewPostPullRequestCloseRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pullRequest/close")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostPullRequestCreateRequest calls the generic PostPullRequestCreate builder with application/json body
+func NewPostPullRequestCreateRequest(server string, body PostPullRequestCreateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostPullRequestCreateRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostPullRequestCreateRequestWithBody generates requests for PostPullRequestCreate with any type of body
+func NewPostPullRequestCreateRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pullRequest/create")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetPullRequestListRequest generates requests for GetPullRequestList
+func NewGetPullRequestListRequest(server string, params *GetPullRequestListParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pullRequest/list")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Status != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.AuthorId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "author_id", runtime.ParamLocationQuery, *params.AuthorId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ReviewerId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "reviewer_id", runtime.ParamLocationQuery, *params.ReviewerId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.TeamName != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "team_name", runtime.ParamLocationQuery, *params.TeamName); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CreatedFrom != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "created_from", runtime.ParamLocationQuery, *params.CreatedFrom); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CreatedTo != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "created_to", runtime.ParamLocationQuery, *params.CreatedTo); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.MergedFrom != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "merged_from", runtime.ParamLocationQuery, *params.MergedFrom); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.MergedTo != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "merged_to", runtime.ParamLocationQuery, *params.MergedTo); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostPullRequestMarkReadyRequest calls the generic PostPullRequestMarkReady builder with application/json body
+func NewPostPullRequestMarkReadyRequest(server string, body PostPullRequestMarkReadyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostPullRequestMarkReadyRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostPullRequestMarkReadyRequestWithBody generates requests for PostPullRequestMarkReady with any type of body
+func NewPostPullRequestMarkReadyRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pullRequest/markReady")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostPullRequestMergeRequest calls the generic PostPullRequestMerge builder with application/json body
+func NewPostPullRequestMergeRequest(server string, body PostPullRequestMergeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostPullRequestMergeRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostPullRequestMergeRequestWithBody generates requests for PostPullRequestMerge with any type of body
+func NewPostPullRequestMergeRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pullRequest/merge")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetPullRequestOverdueRequest generates requests for GetPullRequestOverdue
+func NewGetPullRequestOverdueRequest(server string, params *GetPullRequestOverdueParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pullRequest/overdue")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.TeamName != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "team_name", runtime.ParamLocationQuery, *params.TeamName); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostPullRequestReassignRequest calls the generic PostPullRequestReassign builder with application/json body
+func NewPostPullRequestReassignRequest(server string, body PostPullRequestReassignJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostPullRequestReassignRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostPullRequestReassignRequestWithBody generates requests for PostPullRequestReassign with any type of body
+func NewPostPullRequestReassignRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pullRequest/reassign")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostPullRequestReopenRequest calls the generic PostPullRequestReopen builder with application/json body
+func NewPostPullRequestReopenRequest(server string, body PostPullRequestReopenJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostPullRequestReopenRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostPullRequestReopenRequestWithBody generates requests for PostPullRequestReopen with any type of body
+func NewPostPullRequestReopenRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pullRequest/reopen")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostPullRequestReviewRequest calls the generic PostPullRequestReview builder with application/json body
+func NewPostPullRequestReviewRequest(server string, body PostPullRequestReviewJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostPullRequestReviewRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostPullRequestReviewRequestWithBody generates requests for PostPullRequestReview with any type of body
+func NewPostPullRequestReviewRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pullRequest/review")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetStatsGetRequest generates requests for GetStatsGet
+func NewGetStatsGetRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/stats/get")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostTeamAddRequest calls the generic PostTeamAdd builder with application/json body
+func NewPostTeamAddRequest(server string, body PostTeamAddJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostTeamAddRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostTeamAddRequestWithBody generates requests for PostTeamAdd with any type of body
+func NewPostTeamAddRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/team/add")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostTeamAddMembersRequest calls the generic PostTeamAddMembers builder with application/json body
+func NewPostTeamAddMembersRequest(server string, body PostTeamAddMembersJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostTeamAddMembersRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostTeamAddMembersRequestWithBody generates requests for PostTeamAddMembers with any type of body
+func NewPostTeamAddMembersRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/team/addMembers")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostTeamDeactivateUsersRequest calls the generic PostTeamDeactivateUsers builder with application/json body
+func NewPostTeamDeactivateUsersRequest(server string, body PostTeamDeactivateUsersJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostTeamDeactivateUsersRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostTeamDeactivateUsersRequestWithBody generates requests for PostTeamDeactivateUsers with any type of body
+func NewPostTeamDeactivateUsersRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/team/deactivateUsers")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostTeamDeleteRequest calls the generic PostTeamDelete builder with application/json body
+func NewPostTeamDeleteRequest(server string, body PostTeamDeleteJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostTeamDeleteRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostTeamDeleteRequestWithBody generates requests for PostTeamDelete with any type of body
+func NewPostTeamDeleteRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/team/delete")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetTeamGetRequest generates requests for GetTeamGet
+func NewGetTeamGetRequest(server string, params *GetTeamGetParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/team/get")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "team_name", runtime.ParamLocationQuery, params.TeamName); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostTeamMoveMemberRequest calls the generic PostTeamMoveMember builder with application/json body
+func NewPostTeamMoveMemberRequest(server string, body PostTeamMoveMemberJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostTeamMoveMemberRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostTeamMoveMemberRequestWithBody generates requests for PostTeamMoveMember with any type of body
+func NewPostTeamMoveMemberRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/team/moveMember")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetTeamOwnersRequest generates requests for GetTeamOwners
+func NewGetTeamOwnersRequest(server string, params *GetTeamOwnersParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/team/owners")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "team_name", runtime.ParamLocationQuery, params.TeamName); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostTeamOwnersRequest calls the generic PostTeamOwners builder with application/json body
+func NewPostTeamOwnersRequest(server string, body PostTeamOwnersJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostTeamOwnersRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostTeamOwnersRequestWithBody generates requests for PostTeamOwners with any type of body
+func NewPostTeamOwnersRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/team/owners")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostTeamRemoveMemberRequest calls the generic PostTeamRemoveMember builder with application/json body
+func NewPostTeamRemoveMemberRequest(server string, body PostTeamRemoveMemberJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostTeamRemoveMemberRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostTeamRemoveMemberRequestWithBody generates requests for PostTeamRemoveMember with any type of body
+func NewPostTeamRemoveMemberRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/team/removeMember")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostTeamRenameRequest calls the generic PostTeamRename builder with application/json body
+func NewPostTeamRenameRequest(server string, body PostTeamRenameJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostTeamRenameRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostTeamRenameRequestWithBody generates requests for PostTeamRename with any type of body
+func NewPostTeamRenameRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/team/rename")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostTeamSetFallbacksRequest calls the generic PostTeamSetFallbacks builder with application/json body
+func NewPostTeamSetFallbacksRequest(server string, body PostTeamSetFallbacksJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostTeamSetFallbacksRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostTeamSetFallbacksRequestWithBody generates requests for PostTeamSetFallbacks with any type of body
+func NewPostTeamSetFallbacksRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/team/setFallbacks")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostTeamSetMemberRoleRequest calls the generic PostTeamSetMemberRole builder with application/json body
+func NewPostTeamSetMemberRoleRequest(server string, body PostTeamSetMemberRoleJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostTeamSetMemberRoleRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostTeamSetMemberRoleRequestWithBody generates requests for PostTeamSetMemberRole with any type of body
+func NewPostTeamSetMemberRoleRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/team/setMemberRole")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostTeamSetSettingsRequest calls the generic PostTeamSetSettings builder with application/json body
+func NewPostTeamSetSettingsRequest(server string, body PostTeamSetSettingsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostTeamSetSettingsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostTeamSetSettingsRequestWithBody generates requests for PostTeamSetSettings with any type of body
+func NewPostTeamSetSettingsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/team/setSettings")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetUsersAvailabilityRequest generates requests for GetUsersAvailability
+func NewGetUsersAvailabilityRequest(server string, params *GetUsersAvailabilityParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/availability")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, params.UserId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostUsersAvailabilityRequest calls the generic PostUsersAvailability builder with application/json body
+func NewPostUsersAvailabilityRequest(server string, body PostUsersAvailabilityJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostUsersAvailabilityRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostUsersAvailabilityRequestWithBody generates requests for PostUsersAvailability with any type of body
+func NewPostUsersAvailabilityRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/availability")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostUsersAvailabilityDeleteRequest calls the generic PostUsersAvailabilityDelete builder with application/json body
+func NewPostUsersAvailabilityDeleteRequest(server string, body PostUsersAvailabilityDeleteJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostUsersAvailabilityDeleteRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostUsersAvailabilityDeleteRequestWithBody generates requests for PostUsersAvailabilityDelete with any type of body
+func NewPostUsersAvailabilityDeleteRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/availability/delete")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetUsersGetReviewRequest generates requests for GetUsersGetReview
+func NewGetUsersGetReviewRequest(server string, params *GetUsersGetReviewParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/getReview")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, params.UserId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.Status != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostUsersSetIsActiveRequest calls the generic PostUsersSetIsActive builder with application/json body
+func NewPostUsersSetIsActiveRequest(server string, body PostUsersSetIsActiveJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostUsersSetIsActiveRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostUsersSetIsActiveRequestWithBody generates requests for PostUsersSetIsActive with any type of body
+func NewPostUsersSetIsActiveRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/setIsActive")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostUsersSetWorkingHoursRequest calls the generic PostUsersSetWorkingHours builder with application/json body
+func NewPostUsersSetWorkingHoursRequest(server string, body PostUsersSetWorkingHoursJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostUsersSetWorkingHoursRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostUsersSetWorkingHoursRequestWithBody generates requests for PostUsersSetWorkingHours with any type of body
+func NewPostUsersSetWorkingHoursRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/setWorkingHours")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostWebhooksDeleteRequest calls the generic PostWebhooksDelete builder with application/json body
+func NewPostWebhooksDeleteRequest(server string, body PostWebhooksDeleteJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostWebhooksDeleteRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostWebhooksDeleteRequestWithBody generates requests for PostWebhooksDelete with any type of body
+func NewPostWebhooksDeleteRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/webhooks/delete")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetWebhooksDeliveriesRequest generates requests for GetWebhooksDeliveries
+func NewGetWebhooksDeliveriesRequest(server string, params *GetWebhooksDeliveriesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/webhooks/deliveries")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "subscription_id", runtime.ParamLocationQuery, params.SubscriptionId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.Status != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetWebhooksListRequest generates requests for GetWebhooksList
+func NewGetWebhooksListRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/webhooks/list")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostWebhooksSubscribeRequest calls the generic PostWebhooksSubscribe builder with application/json body
+func NewPostWebhooksSubscribeRequest(server string, body PostWebhooksSubscribeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostWebhooksSubscribeRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostWebhooksSubscribeRequestWithBody generates requests for PostWebhooksSubscribe with any type of body
+func NewPostWebhooksSubscribeRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/webhooks/subscribe")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetAuditWithResponse request
+	GetAuditWithResponse(ctx context.Context, params *GetAuditParams, reqEditors ...RequestEditorFn) (*GetAuditResponse, error)
+
+	// GetEventsStreamWithResponse request
+	GetEventsStreamWithResponse(ctx context.Context, params *GetEventsStreamParams, reqEditors ...RequestEditorFn) (*GetEventsStreamResponse, error)
+
+	// PostPullRequestCloseWithBodyWithResponse request with any body
+	PostPullRequestCloseWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestCloseResponse, error)
+
+	PostPullRequestCloseWithResponse(ctx context.Context, body PostPullRequestCloseJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPullRequestCloseResponse, error)
+
+	// PostPullRequestCreateWithBodyWithResponse request with any body
+	PostPullRequestCreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestCreateResponse, error)
+
+	PostPullRequestCreateWithResponse(ctx context.Context, body PostPullRequestCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPullRequestCreateResponse, error)
+
+	// GetPullRequestListWithResponse request
+	GetPullRequestListWithResponse(ctx context.Context, params *GetPullRequestListParams, reqEditors ...RequestEditorFn) (*GetPullRequestListResponse, error)
+
+	// PostPullRequestMarkReadyWithBodyWithResponse request with any body
+	PostPullRequestMarkReadyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestMarkReadyResponse, error)
+
+	PostPullRequestMarkReadyWithResponse(ctx context.Context, body PostPullRequestMarkReadyJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPullRequestMarkReadyResponse, error)
+
+	// PostPullRequestMergeWithBodyWithResponse request with any body
+	PostPullRequestMergeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestMergeResponse, error)
+
+	PostPullRequestMergeWithResponse(ctx context.Context, body PostPullRequestMergeJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPullRequestMergeResponse, error)
+
+	// GetPullRequestOverdueWithResponse request
+	GetPullRequestOverdueWithResponse(ctx context.Context, params *GetPullRequestOverdueParams, reqEditors ...RequestEditorFn) (*GetPullRequestOverdueResponse, error)
+
+	// PostPullRequestReassignWithBodyWithResponse request with any body
+	PostPullRequestReassignWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestReassignResponse, error)
+
+	PostPullRequestReassignWithResponse(ctx context.Context, body PostPullRequestReassignJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPullRequestReassignResponse, error)
+
+	// PostPullRequestReopenWithBodyWithResponse request with any body
+	PostPullRequestReopenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestReopenResponse, error)
+
+	PostPullRequestReopenWithResponse(ctx context.Context, body PostPullRequestReopenJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPullRequestReopenResponse, error)
+
+	// PostPullRequestReviewWithBodyWithResponse request with any body
+	PostPullRequestReviewWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestReviewResponse, error)
+
+	PostPullRequestReviewWithResponse(ctx context.Context, body PostPullRequestReviewJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPullRequestReviewResponse, error)
+
+	// GetStatsGetWithResponse request
+	GetStatsGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetStatsGetResponse, error)
+
+	// PostTeamAddWithBodyWithResponse request with any body
+	PostTeamAddWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTeamAddResponse, error)
+
+	PostTeamAddWithResponse(ctx context.Context, body PostTeamAddJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTeamAddResponse, error)
+
+	// PostTeamAddMembersWithBodyWithResponse request with any body
+	PostTeamAddMembersWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTeamAddMembersResponse, error)
+
+	PostTeamAddMembersWithResponse(ctx context.Context, body PostTeamAddMembersJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTeamAddMembersResponse, error)
+
+	// PostTeamDeactivateUsersWithBodyWithResponse request with any body
+	PostTeamDeactivateUsersWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTeamDeactivateUsersResponse, error)
+
+	PostTeamDeactivateUsersWithResponse(ctx context.Context, body PostTeamDeactivateUsersJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTeamDeactivateUsersResponse, error)
+
+	// PostTeamDeleteWithBodyWithResponse request with any body
+	PostTeamDeleteWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTeamDeleteResponse, error)
+
+	PostTeamDeleteWithResponse(ctx context.Context, body PostTeamDeleteJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTeamDeleteResponse, error)
+
+	// GetTeamGetWithResponse request
+	GetTeamGetWithResponse(ctx context.Context, params *GetTeamGetParams, reqEditors ...RequestEditorFn) (*GetTeamGetResponse, error)
+
+	// PostTeamMoveMemberWithBodyWithResponse request with any body
+	PostTeamMoveMemberWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTeamMoveMemberResponse, error)
+
+	PostTeamMoveMemberWithResponse(ctx context.Context, body PostTeamMoveMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTeamMoveMemberResponse, error)
+
+	// GetTeamOwnersWithResponse request
+	GetTeamOwnersWithResponse(ctx context.Context, params *GetTeamOwnersParams, reqEditors ...RequestEditorFn) (*GetTeamOwnersResponse, error)
+
+	// PostTeamOwnersWithBodyWithResponse request with any body
+	PostTeamOwnersWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTeamOwnersResponse, error)
+
+	PostTeamOwnersWithResponse(ctx context.Context, body PostTeamOwnersJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTeamOwnersResponse, error)
+
+	// PostTeamRemoveMemberWithBodyWithResponse request with any body
+	PostTeamRemoveMemberWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTeamRemoveMemberResponse, error)
+
+	PostTeamRemoveMemberWithResponse(ctx context.Context, body PostTeamRemoveMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTeamRemoveMemberResponse, error)
+
+	// PostTeamRenameWithBodyWithResponse request with any body
+	PostTeamRenameWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTeamRenameResponse, error)
+
+	PostTeamRenameWithResponse(ctx context.Context, body PostTeamRenameJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTeamRenameResponse, error)
+
+	// PostTeamSetFallbacksWithBodyWithResponse request with any body
+	PostTeamSetFallbacksWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTeamSetFallbacksResponse, error)
+
+	PostTeamSetFallbacksWithResponse(ctx context.Context, body PostTeamSetFallbacksJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTeamSetFallbacksResponse, error)
+
+	// PostTeamSetMemberRoleWithBodyWithResponse request with any body
+	PostTeamSetMemberRoleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTeamSetMemberRoleResponse, error)
+
+	PostTeamSetMemberRoleWithResponse(ctx context.Context, body PostTeamSetMemberRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTeamSetMemberRoleResponse, error)
+
+	// PostTeamSetSettingsWithBodyWithResponse request with any body
+	PostTeamSetSettingsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTeamSetSettingsResponse, error)
+
+	PostTeamSetSettingsWithResponse(ctx context.Context, body PostTeamSetSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTeamSetSettingsResponse, error)
+
+	// GetUsersAvailabilityWithResponse request
+	GetUsersAvailabilityWithResponse(ctx context.Context, params *GetUsersAvailabilityParams, reqEditors ...RequestEditorFn) (*GetUsersAvailabilityResponse, error)
+
+	// PostUsersAvailabilityWithBodyWithResponse request with any body
+	PostUsersAvailabilityWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUsersAvailabilityResponse, error)
+
+	PostUsersAvailabilityWithResponse(ctx context.Context, body PostUsersAvailabilityJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUsersAvailabilityResponse, error)
+
+	// PostUsersAvailabilityDeleteWithBodyWithResponse request with any body
+	PostUsersAvailabilityDeleteWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUsersAvailabilityDeleteResponse, error)
+
+	PostUsersAvailabilityDeleteWithResponse(ctx context.Context, body PostUsersAvailabilityDeleteJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUsersAvailabilityDeleteResponse, error)
+
+	// GetUsersGetReviewWithResponse request
+	GetUsersGetReviewWithResponse(ctx context.Context, params *GetUsersGetReviewParams, reqEditors ...RequestEditorFn) (*GetUsersGetReviewResponse, error)
+
+	// PostUsersSetIsActiveWithBodyWithResponse request with any body
+	PostUsersSetIsActiveWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUsersSetIsActiveResponse, error)
+
+	PostUsersSetIsActiveWithResponse(ctx context.Context, body PostUsersSetIsActiveJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUsersSetIsActiveResponse, error)
+
+	// PostUsersSetWorkingHoursWithBodyWithResponse request with any body
+	PostUsersSetWorkingHoursWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUsersSetWorkingHoursResponse, error)
+
+	PostUsersSetWorkingHoursWithResponse(ctx context.Context, body PostUsersSetWorkingHoursJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUsersSetWorkingHoursResponse, error)
+
+	// PostWebhooksDeleteWithBodyWithResponse request with any body
+	PostWebhooksDeleteWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostWebhooksDeleteResponse, error)
+
+	PostWebhooksDeleteWithResponse(ctx context.Context, body PostWebhooksDeleteJSONRequestBody, reqEditors ...RequestEditorFn) (*PostWebhooksDeleteResponse, error)
+
+	// GetWebhooksDeliveriesWithResponse request
+	GetWebhooksDeliveriesWithResponse(ctx context.Context, params *GetWebhooksDeliveriesParams, reqEditors ...RequestEditorFn) (*GetWebhooksDeliveriesResponse, error)
+
+	// GetWebhooksListWithResponse request
+	GetWebhooksListWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetWebhooksListResponse, error)
+
+	// PostWebhooksSubscribeWithBodyWithResponse request with any body
+	PostWebhooksSubscribeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostWebhooksSubscribeResponse, error)
+
+	PostWebhooksSubscribeWithResponse(ctx context.Context, body PostWebhooksSubscribeJSONRequestBody, reqEditors ...RequestEditorFn) (*PostWebhooksSubscribeResponse, error)
+}
+
+type GetAuditResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AuditLogResponse
+	JSON400      *ErrorResponse
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAuditResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAuditResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetEventsStreamResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorResponse
+	JSON401      *Unauthorized
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetEventsStreamResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetEventsStreamResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostPullRequestCloseResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PullRequestResponse
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostPullRequestCloseResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostPullRequestCloseResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostPullRequestCreateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *CreatePullRequestResponse
+	JSON400      *ErrorResponse
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostPullRequestCreateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostPullRequestCreateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPullRequestListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PullRequestListResponse
+	JSON400      *ErrorResponse
+	JSON401      *Unauthorized
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPullRequestListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPullRequestListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostPullRequestMarkReadyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PullRequestResponse
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostPullRequestMarkReadyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostPullRequestMarkReadyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostPullRequestMergeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MergePullRequestResponse
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostPullRequestMergeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostPullRequestMergeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPullRequestOverdueResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OverduePullRequestsResponse
+	JSON401      *Unauthorized
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPullRequestOverdueResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPullRequestOverdueResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostPullRequestReassignResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ReassignPullRequestResponse
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostPullRequestReassignResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostPullRequestReassignResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostPullRequestReopenResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PullRequestResponse
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostPullRequestReopenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostPullRequestReopenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostPullRequestReviewResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SubmitReviewResponse
+	JSON400      *ErrorResponse
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostPullRequestReviewResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostPullRequestReviewResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetStatsGetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Stats
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r GetStatsGetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetStatsGetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostTeamAddResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *TeamAddResponse
+	JSON400      *ErrorResponse
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+}
+
+// Status returns HTTPResponse.Status
+func (r PostTeamAddResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostTeamAddResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostTeamAddMembersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TeamResponse
+	JSON400      *ErrorResponse
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostTeamAddMembersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostTeamAddMembersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostTeamDeactivateUsersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DeactivateUsersResponse
+	JSON400      *ErrorResponse
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostTeamDeactivateUsersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostTeamDeactivateUsersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostTeamDeleteResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorResponse
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostTeamDeleteResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostTeamDeleteResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTeamGetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Team
+	JSON401      *Unauthorized
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTeamGetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTeamGetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostTeamMoveMemberResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TeamMemberResponse
+	JSON400      *ErrorResponse
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostTeamMoveMemberResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostTeamMoveMemberResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTeamOwnersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TeamOwnersResponse
+	JSON401      *Unauthorized
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTeamOwnersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTeamOwnersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostTeamOwnersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TeamOwnersResponse
+	JSON400      *ErrorResponse
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostTeamOwnersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostTeamOwnersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostTeamRemoveMemberResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TeamMemberResponse
+	JSON400      *ErrorResponse
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostTeamRemoveMemberResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostTeamRemoveMemberResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostTeamRenameResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TeamResponse
+	JSON400      *ErrorResponse
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostTeamRenameResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostTeamRenameResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostTeamSetFallbacksResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TeamFallbacksResponse
+	JSON400      *ErrorResponse
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostTeamSetFallbacksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostTeamSetFallbacksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostTeamSetMemberRoleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TeamResponse
+	JSON400      *ErrorResponse
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostTeamSetMemberRoleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostTeamSetMemberRoleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostTeamSetSettingsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TeamSettingsResponse
+	JSON400      *ErrorResponse
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostTeamSetSettingsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostTeamSetSettingsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUsersAvailabilityResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UserAvailabilityResponse
+	JSON401      *Unauthorized
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUsersAvailabilityResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUsersAvailabilityResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostUsersAvailabilityResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *UnavailabilityWindowResponse
+	JSON400      *ErrorResponse
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostUsersAvailabilityResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostUsersAvailabilityResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostUsersAvailabilityDeleteResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostUsersAvailabilityDeleteResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostUsersAvailabilityDeleteResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUsersGetReviewResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UsersGetReviewResponse
+	JSON400      *ErrorResponse
+	JSON401      *Unauthorized
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUsersGetReviewResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUsersGetReviewResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostUsersSetIsActiveResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SetIsActiveResponse
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostUsersSetIsActiveResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostUsersSetIsActiveResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostUsersSetWorkingHoursResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UserResponse
+	JSON400      *ErrorResponse
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostUsersSetWorkingHoursResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostUsersSetWorkingHoursResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostWebhooksDeleteResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostWebhooksDeleteResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostWebhooksDeleteResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetWebhooksDeliveriesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WebhookDeliveriesResponse
+	JSON400      *ErrorResponse
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWebhooksDeliveriesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWebhooksDeliveriesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetWebhooksListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WebhookSubscriptionsResponse
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWebhooksListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWebhooksListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostWebhooksSubscribeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *WebhookSubscriptionResponse
+	JSON400      *ErrorResponse
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+}
+
+// Status returns HTTPResponse.Status
+func (r PostWebhooksSubscribeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostWebhooksSubscribeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetAuditWithResponse request returning *GetAuditResponse
+func (c *ClientWithResponses) GetAuditWithResponse(ctx context.Context, params *GetAuditParams, reqEditors ...RequestEditorFn) (*GetAuditResponse, error) {
+	rsp, err := c.GetAudit(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAuditResponse(rsp)
+}
+
+// GetEventsStreamWithResponse request returning *GetEventsStreamResponse
+func (c *ClientWithResponses) GetEventsStreamWithResponse(ctx context.Context, params *GetEventsStreamParams, reqEditors ...RequestEditorFn) (*GetEventsStreamResponse, error) {
+	rsp, err := c.GetEventsStream(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetEventsStreamResponse(rsp)
+}
+
+// PostPullRequestCloseWithBodyWithResponse request with arbitrary body returning *PostPullRequestCloseResponse
+func (c *ClientWithResponses) PostPullRequestCloseWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestCloseResponse, error) {
+	rsp, err := c.PostPullRequestCloseWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPullRequestCloseResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostPullRequestCloseWithResponse(ctx context.Context, body PostPullRequestCloseJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPullRequestCloseResponse, error) {
+	rsp, err := c.PostPullRequestClose(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPullRequestCloseResponse(rsp)
+}
+
+// PostPullRequestCreateWithBodyWithResponse request with arbitrary body returning *PostPullRequestCreateResponse
+func (c *ClientWithResponses) PostPullRequestCreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestCreateResponse, error) {
+	rsp, err := c.PostPullRequestCreateWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPullRequestCreateResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostPullRequestCreateWithResponse(ctx context.Context, body PostPullRequestCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPullRequestCreateResponse, error) {
+	rsp, err := c.PostPullRequestCreate(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPullRequestCreateResponse(rsp)
+}
+
+// GetPullRequestListWithResponse request returning *GetPullRequestListResponse
+func (c *ClientWithResponses) GetPullRequestListWithResponse(ctx context.Context, params *GetPullRequestListParams, reqEditors ...RequestEditorFn) (*GetPullRequestListResponse, error) {
+	rsp, err := c.GetPullRequestList(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPullRequestListResponse(rsp)
+}
+
+// PostPullRequestMarkReadyWithBodyWithResponse request with arbitrary body returning *PostPullRequestMarkReadyResponse
+func (c *ClientWithResponses) PostPullRequestMarkReadyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestMarkReadyResponse, error) {
+	rsp, err := c.PostPullRequestMarkReadyWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPullRequestMarkReadyResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostPullRequestMarkReadyWithResponse(ctx context.Context, body PostPullRequestMarkReadyJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPullRequestMarkReadyResponse, error) {
+	rsp, err := c.PostPullRequestMarkReady(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPullRequestMarkReadyResponse(rsp)
+}
+
+// PostPullRequestMergeWithBodyWithResponse request with arbitrary body returning *PostPullRequestMergeResponse
+func (c *ClientWithResponses) PostPullRequestMergeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestMergeResponse, error) {
+	rsp, err := c.PostPullRequestMergeWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPullRequestMergeResponse(rsp)
+}
+
 func (c *ClientWithResponses) PostPullRequestMergeWithResponse(ctx context.Context, body PostPullRequestMergeJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPullRequestMergeResponse, error) {
 	rsp, err := c.PostPullRequestMerge(ctx, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostPullRequestMergeResponse(rsp)
+	return ParsePostPullRequestMergeResponse(rsp)
+}
+
+// GetPullRequestOverdueWithResponse request returning *GetPullRequestOverdueResponse
+func (c *ClientWithResponses) GetPullRequestOverdueWithResponse(ctx context.Context, params *GetPullRequestOverdueParams, reqEditors ...RequestEditorFn) (*GetPullRequestOverdueResponse, error) {
+	rsp, err := c.GetPullRequestOverdue(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPullRequestOverdueResponse(rsp)
+}
+
+// PostPullRequestReassignWithBodyWithResponse request with arbitrary body returning *PostPullRequestReassignResponse
+func (c *ClientWithResponses) PostPullRequestReassignWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestReassignResponse, error) {
+	rsp, err := c.PostPullRequestReassignWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPullRequestReassignResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostPullRequestReassignWithResponse(ctx context.Context, body PostPullRequestReassignJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPullRequestReassignResponse, error) {
+	rsp, err := c.PostPullRequestReassign(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPullRequestReassignResponse(rsp)
+}
+
+// PostPullRequestReopenWithBodyWithResponse request with arbitrary body returning *PostPullRequestReopenResponse
+func (c *ClientWithResponses) PostPullRequestReopenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestReopenResponse, error) {
+	rsp, err := c.PostPullRequestReopenWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPullRequestReopenResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostPullRequestReopenWithResponse(ctx context.Context, body PostPullRequestReopenJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPullRequestReopenResponse, error) {
+	rsp, err := c.PostPullRequestReopen(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPullRequestReopenResponse(rsp)
+}
+
+// PostPullRequestReviewWithBodyWithResponse request with arbitrary body returning *PostPullRequestReviewResponse
+func (c *ClientWithResponses) PostPullRequestReviewWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestReviewResponse, error) {
+	rsp, err := c.PostPullRequestReviewWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPullRequestReviewResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostPullRequestReviewWithResponse(ctx context.Context, body PostPullRequestReviewJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPullRequestReviewResponse, error) {
+	rsp, err := c.PostPullRequestReview(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPullRequestReviewResponse(rsp)
+}
+
+// GetStatsGetWithResponse request returning *GetStatsGetResponse
+func (c *ClientWithResponses) GetStatsGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetStatsGetResponse, error) {
+	rsp, err := c.GetStatsGet(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetStatsGetResponse(rsp)
+}
+
+// PostTeamAddWithBodyWithResponse request with arbitrary body returning *PostTeamAddResponse
+func (c *ClientWithResponses) PostTeamAddWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTeamAddResponse, error) {
+	rsp, err := c.PostTeamAddWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostTeamAddResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostTeamAddWithResponse(ctx context.Context, body PostTeamAddJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTeamAddResponse, error) {
+	rsp, err := c.PostTeamAdd(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostTeamAddResponse(rsp)
+}
+
+// PostTeamAddMembersWithBodyWithResponse request with arbitrary body returning *PostTeamAddMembersResponse
+func (c *ClientWithResponses) PostTeamAddMembersWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTeamAddMembersResponse, error) {
+	rsp, err := c.PostTeamAddMembersWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostTeamAddMembersResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostTeamAddMembersWithResponse(ctx context.Context, body PostTeamAddMembersJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTeamAddMembersResponse, error) {
+	rsp, err := c.PostTeamAddMembers(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostTeamAddMembersResponse(rsp)
+}
+
+// PostTeamDeactivateUsersWithBodyWithResponse request with arbitrary body returning *PostTeamDeactivateUsersResponse
+func (c *ClientWithResponses) PostTeamDeactivateUsersWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTeamDeactivateUsersResponse, error) {
+	rsp, err := c.PostTeamDeactivateUsersWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostTeamDeactivateUsersResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostTeamDeactivateUsersWithResponse(ctx context.Context, body PostTeamDeactivateUsersJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTeamDeactivateUsersResponse, error) {
+	rsp, err := c.PostTeamDeactivateUsers(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostTeamDeactivateUsersResponse(rsp)
+}
+
+// PostTeamDeleteWithBodyWithResponse request with arbitrary body returning *PostTeamDeleteResponse
+func (c *ClientWithResponses) PostTeamDeleteWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTeamDeleteResponse, error) {
+	rsp, err := c.PostTeamDeleteWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostTeamDeleteResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostTeamDeleteWithResponse(ctx context.Context, body PostTeamDeleteJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTeamDeleteResponse, error) {
+	rsp, err := c.PostTeamDelete(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostTeamDeleteResponse(rsp)
+}
+
+// GetTeamGetWithResponse request returning *GetTeamGetResponse
+func (c *ClientWithResponses) GetTeamGetWithResponse(ctx context.Context, params *GetTeamGetParams, reqEditors ...RequestEditorFn) (*GetTeamGetResponse, error) {
+	rsp, err := c.GetTeamGet(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTeamGetResponse(rsp)
+}
+
+// PostTeamMoveMemberWithBodyWithResponse request with arbitrary body returning *PostTeamMoveMemberResponse
+func (c *ClientWithResponses) PostTeamMoveMemberWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTeamMoveMemberResponse, error) {
+	rsp, err := c.PostTeamMoveMemberWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostTeamMoveMemberResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostTeamMoveMemberWithResponse(ctx context.Context, body PostTeamMoveMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTeamMoveMemberResponse, error) {
+	rsp, err := c.PostTeamMoveMember(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostTeamMoveMemberResponse(rsp)
+}
+
+// GetTeamOwnersWithResponse request returning *GetTeamOwnersResponse
+func (c *ClientWithResponses) GetTeamOwnersWithResponse(ctx context.Context, params *GetTeamOwnersParams, reqEditors ...RequestEditorFn) (*GetTeamOwnersResponse, error) {
+	rsp, err := c.GetTeamOwners(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTeamOwnersResponse(rsp)
+}
+
+// PostTeamOwnersWithBodyWithResponse request with arbitrary body returning *PostTeamOwnersResponse
+func (c *ClientWithResponses) PostTeamOwnersWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTeamOwnersResponse, error) {
+	rsp, err := c.PostTeamOwnersWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostTeamOwnersResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostTeamOwnersWithResponse(ctx context.Context, body PostTeamOwnersJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTeamOwnersResponse, error) {
+	rsp, err := c.PostTeamOwners(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostTeamOwnersResponse(rsp)
+}
+
+// PostTeamRemoveMemberWithBodyWithResponse request with arbitrary body returning *PostTeamRemoveMemberResponse
+func (c *ClientWithResponses) PostTeamRemoveMemberWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTeamRemoveMemberResponse, error) {
+	rsp, err := c.PostTeamRemoveMemberWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostTeamRemoveMemberResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostTeamRemoveMemberWithResponse(ctx context.Context, body PostTeamRemoveMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTeamRemoveMemberResponse, error) {
+	rsp, err := c.PostTeamRemoveMember(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostTeamRemoveMemberResponse(rsp)
+}
+
+// PostTeamRenameWithBodyWithResponse request with arbitrary body returning *PostTeamRenameResponse
+func (c *ClientWithResponses) PostTeamRenameWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTeamRenameResponse, error) {
+	rsp, err := c.PostTeamRenameWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostTeamRenameResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostTeamRenameWithResponse(ctx context.Context, body PostTeamRenameJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTeamRenameResponse, error) {
+	rsp, err := c.PostTeamRename(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostTeamRenameResponse(rsp)
+}
+
+// PostTeamSetFallbacksWithBodyWithResponse request with arbitrary body returning *PostTeamSetFallbacksResponse
+func (c *ClientWithResponses) PostTeamSetFallbacksWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTeamSetFallbacksResponse, error) {
+	rsp, err := c.PostTeamSetFallbacksWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostTeamSetFallbacksResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostTeamSetFallbacksWithResponse(ctx context.Context, body PostTeamSetFallbacksJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTeamSetFallbacksResponse, error) {
+	rsp, err := c.PostTeamSetFallbacks(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostTeamSetFallbacksResponse(rsp)
+}
+
+// PostTeamSetMemberRoleWithBodyWithResponse request with arbitrary body returning *PostTeamSetMemberRoleResponse
+func (c *ClientWithResponses) PostTeamSetMemberRoleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTeamSetMemberRoleResponse, error) {
+	rsp, err := c.PostTeamSetMemberRoleWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostTeamSetMemberRoleResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostTeamSetMemberRoleWithResponse(ctx context.Context, body PostTeamSetMemberRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTeamSetMemberRoleResponse, error) {
+	rsp, err := c.PostTeamSetMemberRole(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostTeamSetMemberRoleResponse(rsp)
+}
+
+// PostTeamSetSettingsWithBodyWithResponse request with arbitrary body returning *PostTeamSetSettingsResponse
+func (c *ClientWithResponses) PostTeamSetSettingsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTeamSetSettingsResponse, error) {
+	rsp, err := c.PostTeamSetSettingsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostTeamSetSettingsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostTeamSetSettingsWithResponse(ctx context.Context, body PostTeamSetSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTeamSetSettingsResponse, error) {
+	rsp, err := c.PostTeamSetSettings(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostTeamSetSettingsResponse(rsp)
+}
+
+// GetUsersAvailabilityWithResponse request returning *GetUsersAvailabilityResponse
+func (c *ClientWithResponses) GetUsersAvailabilityWithResponse(ctx context.Context, params *GetUsersAvailabilityParams, reqEditors ...RequestEditorFn) (*GetUsersAvailabilityResponse, error) {
+	rsp, err := c.GetUsersAvailability(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUsersAvailabilityResponse(rsp)
+}
+
+// PostUsersAvailabilityWithBodyWithResponse request with arbitrary body returning *PostUsersAvailabilityResponse
+func (c *ClientWithResponses) PostUsersAvailabilityWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUsersAvailabilityResponse, error) {
+	rsp, err := c.PostUsersAvailabilityWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostUsersAvailabilityResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostUsersAvailabilityWithResponse(ctx context.Context, body PostUsersAvailabilityJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUsersAvailabilityResponse, error) {
+	rsp, err := c.PostUsersAvailability(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostUsersAvailabilityResponse(rsp)
+}
+
+// PostUsersAvailabilityDeleteWithBodyWithResponse request with arbitrary body returning *PostUsersAvailabilityDeleteResponse
+func (c *ClientWithResponses) PostUsersAvailabilityDeleteWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUsersAvailabilityDeleteResponse, error) {
+	rsp, err := c.PostUsersAvailabilityDeleteWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostUsersAvailabilityDeleteResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostUsersAvailabilityDeleteWithResponse(ctx context.Context, body PostUsersAvailabilityDeleteJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUsersAvailabilityDeleteResponse, error) {
+	rsp, err := c.PostUsersAvailabilityDelete(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostUsersAvailabilityDeleteResponse(rsp)
+}
+
+// GetUsersGetReviewWithResponse request returning *GetUsersGetReviewResponse
+func (c *ClientWithResponses) GetUsersGetReviewWithResponse(ctx context.Context, params *GetUsersGetReviewParams, reqEditors ...RequestEditorFn) (*GetUsersGetReviewResponse, error) {
+	rsp, err := c.GetUsersGetReview(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUsersGetReviewResponse(rsp)
+}
+
+// PostUsersSetIsActiveWithBodyWithResponse request with arbitrary body returning *PostUsersSetIsActiveResponse
+func (c *ClientWithResponses) PostUsersSetIsActiveWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUsersSetIsActiveResponse, error) {
+	rsp, err := c.PostUsersSetIsActiveWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostUsersSetIsActiveResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostUsersSetIsActiveWithResponse(ctx context.Context, body PostUsersSetIsActiveJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUsersSetIsActiveResponse, error) {
+	rsp, err := c.PostUsersSetIsActive(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostUsersSetIsActiveResponse(rsp)
+}
+
+// PostUsersSetWorkingHoursWithBodyWithResponse request with arbitrary body returning *PostUsersSetWorkingHoursResponse
+func (c *ClientWithResponses) PostUsersSetWorkingHoursWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUsersSetWorkingHoursResponse, error) {
+	rsp, err := c.PostUsersSetWorkingHoursWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostUsersSetWorkingHoursResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostUsersSetWorkingHoursWithResponse(ctx context.Context, body PostUsersSetWorkingHoursJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUsersSetWorkingHoursResponse, error) {
+	rsp, err := c.PostUsersSetWorkingHours(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostUsersSetWorkingHoursResponse(rsp)
+}
+
+// PostWebhooksDeleteWithBodyWithResponse request with arbitrary body returning *PostWebhooksDeleteResponse
+func (c *ClientWithResponses) PostWebhooksDeleteWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostWebhooksDeleteResponse, error) {
+	rsp, err := c.PostWebhooksDeleteWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostWebhooksDeleteResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostWebhooksDeleteWithResponse(ctx context.Context, body PostWebhooksDeleteJSONRequestBody, reqEditors ...RequestEditorFn) (*PostWebhooksDeleteResponse, error) {
+	rsp, err := c.PostWebhooksDelete(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostWebhooksDeleteResponse(rsp)
+}
+
+// GetWebhooksDeliveriesWithResponse request returning *GetWebhooksDeliveriesResponse
+func (c *ClientWithResponses) GetWebhooksDeliveriesWithResponse(ctx context.Context, params *GetWebhooksDeliveriesParams, reqEditors ...RequestEditorFn) (*GetWebhooksDeliveriesResponse, error) {
+	rsp, err := c.GetWebhooksDeliveries(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWebhooksDeliveriesResponse(rsp)
+}
+
+// GetWebhooksListWithResponse request returning *GetWebhooksListResponse
+func (c *ClientWithResponses) GetWebhooksListWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetWebhooksListResponse, error) {
+	rsp, err := c.GetWebhooksList(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWebhooksListResponse(rsp)
+}
+
+// PostWebhooksSubscribeWithBodyWithResponse request with arbitrary body returning *PostWebhooksSubscribeResponse
+func (c *ClientWithResponses) PostWebhooksSubscribeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostWebhooksSubscribeResponse, error) {
+	rsp, err := c.PostWebhooksSubscribeWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostWebhooksSubscribeResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostWebhooksSubscribeWithResponse(ctx context.Context, body PostWebhooksSubscribeJSONRequestBody, reqEditors ...RequestEditorFn) (*PostWebhooksSubscribeResponse, error) {
+	rsp, err := c.PostWebhooksSubscribe(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostWebhooksSubscribeResponse(rsp)
+}
+
+// ParseGetAuditResponse parses an HTTP response from a GetAuditWithResponse call
+func ParseGetAuditResponse(rsp *http.Response) (*GetAuditResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAuditResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AuditLogResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetEventsStreamResponse parses an HTTP response from a GetEventsStreamWithResponse call
+func ParseGetEventsStreamResponse(rsp *http.Response) (*GetEventsStreamResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetEventsStreamResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostPullRequestCloseResponse parses an HTTP response from a PostPullRequestCloseWithResponse call
+func ParsePostPullRequestCloseResponse(rsp *http.Response) (*PostPullRequestCloseResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostPullRequestCloseResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PullRequestResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostPullRequestCreateResponse parses an HTTP response from a PostPullRequestCreateWithResponse call
+func ParsePostPullRequestCreateResponse(rsp *http.Response) (*PostPullRequestCreateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostPullRequestCreateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest CreatePullRequestResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetPullRequestListResponse parses an HTTP response from a GetPullRequestListWithResponse call
+func ParseGetPullRequestListResponse(rsp *http.Response) (*GetPullRequestListResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetPullRequestListResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PullRequestListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostPullRequestMarkReadyResponse parses an HTTP response from a PostPullRequestMarkReadyWithResponse call
+func ParsePostPullRequestMarkReadyResponse(rsp *http.Response) (*PostPullRequestMarkReadyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostPullRequestMarkReadyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PullRequestResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostPullRequestMergeResponse parses an HTTP response from a PostPullRequestMergeWithResponse call
+func ParsePostPullRequestMergeResponse(rsp *http.Response) (*PostPullRequestMergeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostPullRequestMergeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MergePullRequestResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetPullRequestOverdueResponse parses an HTTP response from a GetPullRequestOverdueWithResponse call
+func ParseGetPullRequestOverdueResponse(rsp *http.Response) (*GetPullRequestOverdueResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetPullRequestOverdueResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OverduePullRequestsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostPullRequestReassignResponse parses an HTTP response from a PostPullRequestReassignWithResponse call
+func ParsePostPullRequestReassignResponse(rsp *http.Response) (*PostPullRequestReassignResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostPullRequestReassignResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ReassignPullRequestResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostPullRequestReopenResponse parses an HTTP response from a PostPullRequestReopenWithResponse call
+func ParsePostPullRequestReopenResponse(rsp *http.Response) (*PostPullRequestReopenResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostPullRequestReopenResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PullRequestResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostPullRequestReviewResponse parses an HTTP response from a PostPullRequestReviewWithResponse call
+func ParsePostPullRequestReviewResponse(rsp *http.Response) (*PostPullRequestReviewResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostPullRequestReviewResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SubmitReviewResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetStatsGetResponse parses an HTTP response from a GetStatsGetWithResponse call
+func ParseGetStatsGetResponse(rsp *http.Response) (*GetStatsGetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetStatsGetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Stats
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostTeamAddResponse parses an HTTP response from a PostTeamAddWithResponse call
+func ParsePostTeamAddResponse(rsp *http.Response) (*PostTeamAddResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostTeamAddResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest TeamAddResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostTeamAddMembersResponse parses an HTTP response from a PostTeamAddMembersWithResponse call
+func ParsePostTeamAddMembersResponse(rsp *http.Response) (*PostTeamAddMembersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostTeamAddMembersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TeamResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostTeamDeactivateUsersResponse parses an HTTP response from a PostTeamDeactivateUsersWithResponse call
+func ParsePostTeamDeactivateUsersResponse(rsp *http.Response) (*PostTeamDeactivateUsersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostTeamDeactivateUsersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DeactivateUsersResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostTeamDeleteResponse parses an HTTP response from a PostTeamDeleteWithResponse call
+func ParsePostTeamDeleteResponse(rsp *http.Response) (*PostTeamDeleteResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostTeamDeleteResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTeamGetResponse parses an HTTP response from a GetTeamGetWithResponse call
+func ParseGetTeamGetResponse(rsp *http.Response) (*GetTeamGetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTeamGetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Team
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostTeamMoveMemberResponse parses an HTTP response from a PostTeamMoveMemberWithResponse call
+func ParsePostTeamMoveMemberResponse(rsp *http.Response) (*PostTeamMoveMemberResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostTeamMoveMemberResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TeamMemberResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTeamOwnersResponse parses an HTTP response from a GetTeamOwnersWithResponse call
+func ParseGetTeamOwnersResponse(rsp *http.Response) (*GetTeamOwnersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTeamOwnersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TeamOwnersResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostTeamOwnersResponse parses an HTTP response from a PostTeamOwnersWithResponse call
+func ParsePostTeamOwnersResponse(rsp *http.Response) (*PostTeamOwnersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostTeamOwnersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TeamOwnersResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostTeamRemoveMemberResponse parses an HTTP response from a PostTeamRemoveMemberWithResponse call
+func ParsePostTeamRemoveMemberResponse(rsp *http.Response) (*PostTeamRemoveMemberResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostTeamRemoveMemberResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TeamMemberResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostTeamRenameResponse parses an HTTP response from a PostTeamRenameWithResponse call
+func ParsePostTeamRenameResponse(rsp *http.Response) (*PostTeamRenameResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostTeamRenameResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TeamResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostTeamSetFallbacksResponse parses an HTTP response from a PostTeamSetFallbacksWithResponse call
+func ParsePostTeamSetFallbacksResponse(rsp *http.Response) (*PostTeamSetFallbacksResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostTeamSetFallbacksResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TeamFallbacksResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostTeamSetMemberRoleResponse parses an HTTP response from a PostTeamSetMemberRoleWithResponse call
+func ParsePostTeamSetMemberRoleResponse(rsp *http.Response) (*PostTeamSetMemberRoleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostTeamSetMemberRoleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TeamResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
 }
 
-// PostPullRequestReassignWithBodyWithResponse request with arbitrary body returning *PostPullRequestReassignResponse
-func (c *ClientWithResponses) PostPullRequestReassignWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestReassignResponse, error) {
-	rsp, err := c.PostPullRequestReassignWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// ParsePostTeamSetSettingsResponse parses an HTTP response from a PostTeamSetSettingsWithResponse call
+func ParsePostTeamSetSettingsResponse(rsp *http.Response) (*PostTeamSetSettingsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostTeamSetSettingsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TeamSettingsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
-	return ParsePostPullRequestReassignResponse(rsp)
-}
 
-func (c *ClientWithResponses) PostPullRequestReassignWithResponse(ctx context.Context, body PostPullRequestReassignJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPullRequestReassignResponse, error) {
-	rsp, err := c.PostPullRequestReassign(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostPullRequestReassignResponse(rsp)
+	return response, nil
 }
 
-// GetStatsGetWithResponse request returning *GetStatsGetResponse
-func (c *ClientWithResponses) GetStatsGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetStatsGetResponse, error) {
-	rsp, err := c.GetStatsGet(ctx, reqEditors...)
+// ParseGetUsersAvailabilityResponse parses an HTTP response from a GetUsersAvailabilityWithResponse call
+func ParseGetUsersAvailabilityResponse(rsp *http.Response) (*GetUsersAvailabilityResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetStatsGetResponse(rsp)
-}
 
-// PostTeamAddWithBodyWithResponse request with arbitrary body returning *PostTeamAddResponse
-func (c *ClientWithResponses) PostTeamAddWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTeamAddResponse, error) {
-	rsp, err := c.PostTeamAddWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetUsersAvailabilityResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParsePostTeamAddResponse(rsp)
-}
 
-func (c *ClientWithResponses) PostTeamAddWithResponse(ctx context.Context, body PostTeamAddJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTeamAddResponse, error) {
-	rsp, err := c.PostTeamAdd(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostTeamAddResponse(rsp)
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UserAvailabilityResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
-// GetTeamGetWithResponse request returning *GetTeamGetResponse
-func (c *ClientWithResponses) GetTeamGetWithResponse(ctx context.Context, params *GetTeamGetParams, reqEditors ...RequestEditorFn) (*GetTeamGetResponse, error) {
-	rsp, err := c.GetTeamGet(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
 	}
-	return ParseGetTeamGetResponse(rsp)
+
+	return response, nil
 }
 
-// GetUsersGetReviewWithResponse request returning *GetUsersGetReviewResponse
-func (c *ClientWithResponses) GetUsersGetReviewWithResponse(ctx context.Context, params *GetUsersGetReviewParams, reqEditors ...RequestEditorFn) (*GetUsersGetReviewResponse, error) {
-	rsp, err := c.GetUsersGetReview(ctx, params, reqEditors...)
+// ParsePostUsersAvailabilityResponse parses an HTTP response from a PostUsersAvailabilityWithResponse call
+func ParsePostUsersAvailabilityResponse(rsp *http.Response) (*PostUsersAvailabilityResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetUsersGetReviewResponse(rsp)
-}
 
-// PostUsersSetIsActiveWithBodyWithResponse request with arbitrary body returning *PostUsersSetIsActiveResponse
-func (c *ClientWithResponses) PostUsersSetIsActiveWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUsersSetIsActiveResponse, error) {
-	rsp, err := c.PostUsersSetIsActiveWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &PostUsersAvailabilityResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParsePostUsersSetIsActiveResponse(rsp)
-}
 
-func (c *ClientWithResponses) PostUsersSetIsActiveWithResponse(ctx context.Context, body PostUsersSetIsActiveJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUsersSetIsActiveResponse, error) {
-	rsp, err := c.PostUsersSetIsActive(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest UnavailabilityWindowResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
-	return ParsePostUsersSetIsActiveResponse(rsp)
+
+	return response, nil
 }
 
-// ParsePostPullRequestCreateResponse parses an HTTP response from a PostPullRequestCreateWithResponse call
-func ParsePostPullRequestCreateResponse(rsp *http.Response) (*PostPullRequestCreateResponse, error) {
+// ParsePostUsersAvailabilityDeleteResponse parses an HTTP response from a PostUsersAvailabilityDeleteWithResponse call
+func ParsePostUsersAvailabilityDeleteResponse(rsp *http.Response) (*PostUsersAvailabilityDeleteResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostPullRequestCreateResponse{
+	response := &PostUsersAvailabilityDeleteResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest CreatePullRequestResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest ErrorResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON409 = &dest
+		response.JSON404 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParsePostPullRequestMergeResponse parses an HTTP response from a PostPullRequestMergeWithResponse call
-func ParsePostPullRequestMergeResponse(rsp *http.Response) (*PostPullRequestMergeResponse, error) {
+// ParseGetUsersGetReviewResponse parses an HTTP response from a GetUsersGetReviewWithResponse call
+func ParseGetUsersGetReviewResponse(rsp *http.Response) (*GetUsersGetReviewResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostPullRequestMergeResponse{
+	response := &GetUsersGetReviewResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest MergePullRequestResponse
+		var dest UsersGetReviewResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -1044,126 +5791,189 @@ func ParsePostPullRequestMergeResponse(rsp *http.Response) (*PostPullRequestMerg
 	return response, nil
 }
 
-// ParsePostPullRequestReassignResponse parses an HTTP response from a PostPullRequestReassignWithResponse call
-func ParsePostPullRequestReassignResponse(rsp *http.Response) (*PostPullRequestReassignResponse, error) {
+// ParsePostUsersSetIsActiveResponse parses an HTTP response from a PostUsersSetIsActiveWithResponse call
+func ParsePostUsersSetIsActiveResponse(rsp *http.Response) (*PostUsersSetIsActiveResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostPullRequestReassignResponse{
+	response := &PostUsersSetIsActiveResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ReassignPullRequestResponse
+		var dest SetIsActiveResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest ErrorResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON409 = &dest
+		response.JSON404 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseGetStatsGetResponse parses an HTTP response from a GetStatsGetWithResponse call
-func ParseGetStatsGetResponse(rsp *http.Response) (*GetStatsGetResponse, error) {
+// ParsePostUsersSetWorkingHoursResponse parses an HTTP response from a PostUsersSetWorkingHoursWithResponse call
+func ParsePostUsersSetWorkingHoursResponse(rsp *http.Response) (*PostUsersSetWorkingHoursResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetStatsGetResponse{
+	response := &PostUsersSetWorkingHoursResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Stats
+		var dest UserResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParsePostTeamAddResponse parses an HTTP response from a PostTeamAddWithResponse call
-func ParsePostTeamAddResponse(rsp *http.Response) (*PostTeamAddResponse, error) {
+// ParsePostWebhooksDeleteResponse parses an HTTP response from a PostWebhooksDeleteWithResponse call
+func ParsePostWebhooksDeleteResponse(rsp *http.Response) (*PostWebhooksDeleteResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostTeamAddResponse{
+	response := &PostWebhooksDeleteResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest TeamAddResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON404 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseGetTeamGetResponse parses an HTTP response from a GetTeamGetWithResponse call
-func ParseGetTeamGetResponse(rsp *http.Response) (*GetTeamGetResponse, error) {
+// ParseGetWebhooksDeliveriesResponse parses an HTTP response from a GetWebhooksDeliveriesWithResponse call
+func ParseGetWebhooksDeliveriesResponse(rsp *http.Response) (*GetWebhooksDeliveriesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetTeamGetResponse{
+	response := &GetWebhooksDeliveriesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Team
+		var dest WebhookDeliveriesResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -1176,59 +5986,87 @@ func ParseGetTeamGetResponse(rsp *http.Response) (*GetTeamGetResponse, error) {
 	return response, nil
 }
 
-// ParseGetUsersGetReviewResponse parses an HTTP response from a GetUsersGetReviewWithResponse call
-func ParseGetUsersGetReviewResponse(rsp *http.Response) (*GetUsersGetReviewResponse, error) {
+// ParseGetWebhooksListResponse parses an HTTP response from a GetWebhooksListWithResponse call
+func ParseGetWebhooksListResponse(rsp *http.Response) (*GetWebhooksListResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetUsersGetReviewResponse{
+	response := &GetWebhooksListResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest UsersGetReviewResponse
+		var dest WebhookSubscriptionsResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParsePostUsersSetIsActiveResponse parses an HTTP response from a PostUsersSetIsActiveWithResponse call
-func ParsePostUsersSetIsActiveResponse(rsp *http.Response) (*PostUsersSetIsActiveResponse, error) {
+// ParsePostWebhooksSubscribeResponse parses an HTTP response from a PostWebhooksSubscribeWithResponse call
+func ParsePostWebhooksSubscribeResponse(rsp *http.Response) (*PostWebhooksSubscribeResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostUsersSetIsActiveResponse{
+	response := &PostWebhooksSubscribeResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SetIsActiveResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest WebhookSubscriptionResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON201 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
 
 	}
 
@@ -13,30 +13,111 @@ import (
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
-	// Создать PR и автоматически назначить до 2 ревьюверов из команды автора
+	// Журнал аудита изменяющих операций
+	// (GET /audit)
+	GetAudit(c *gin.Context, params GetAuditParams)
+	// Поток событий назначений (Server-Sent Events)
+	// (GET /events/stream)
+	GetEventsStream(c *gin.Context, params GetEventsStreamParams)
+	// Закрыть PR без мержа (DRAFT/OPEN/REOPENED -> CLOSED)
+	// (POST /pullRequest/close)
+	PostPullRequestClose(c *gin.Context)
+	// Создать PR и автоматически назначить ревьюверов из команды, для которой он создаётся
 	// (POST /pullRequest/create)
 	PostPullRequestCreate(c *gin.Context)
+	// Получить список PR с фильтрами
+	// (GET /pullRequest/list)
+	GetPullRequestList(c *gin.Context, params GetPullRequestListParams)
+	// Перевести черновик в работу и назначить ревьюверов (DRAFT -> OPEN)
+	// (POST /pullRequest/markReady)
+	PostPullRequestMarkReady(c *gin.Context)
 	// Пометить PR как MERGED (идемпотентная операция)
 	// (POST /pullRequest/merge)
 	PostPullRequestMerge(c *gin.Context)
+	// Получить PR, ревью которых просрочено
+	// (GET /pullRequest/overdue)
+	GetPullRequestOverdue(c *gin.Context, params GetPullRequestOverdueParams)
 	// Переназначить конкретного ревьювера на другого из его команды
 	// (POST /pullRequest/reassign)
 	PostPullRequestReassign(c *gin.Context)
+	// Переоткрыть закрытый PR (CLOSED -> REOPENED)
+	// (POST /pullRequest/reopen)
+	PostPullRequestReopen(c *gin.Context)
+	// Оставить решение ревьювера по PR
+	// (POST /pullRequest/review)
+	PostPullRequestReview(c *gin.Context)
 	// Статистика
 	// (GET /stats/get)
 	GetStatsGet(c *gin.Context)
 	// Создать команду с участниками (создаёт/обновляет пользователей)
 	// (POST /team/add)
 	PostTeamAdd(c *gin.Context)
+	// Добавить участников в существующую команду
+	// (POST /team/addMembers)
+	PostTeamAddMembers(c *gin.Context)
+	// Массово деактивировать пользователей команды и переназначить их открытые ревью
+	// (POST /team/deactivateUsers)
+	PostTeamDeactivateUsers(c *gin.Context)
+	// Удалить команду без участников
+	// (POST /team/delete)
+	PostTeamDelete(c *gin.Context)
 	// Получить команду с участниками
 	// (GET /team/get)
 	GetTeamGet(c *gin.Context, params GetTeamGetParams)
+	// Перенести пользователя в другую команду
+	// (POST /team/moveMember)
+	PostTeamMoveMember(c *gin.Context)
+	// Получить правила владения путями команды
+	// (GET /team/owners)
+	GetTeamOwners(c *gin.Context, params GetTeamOwnersParams)
+	// Заменить правила владения путями команды
+	// (POST /team/owners)
+	PostTeamOwners(c *gin.Context)
+	// Удалить участника из команды
+	// (POST /team/removeMember)
+	PostTeamRemoveMember(c *gin.Context)
+	// Переименовать команду
+	// (POST /team/rename)
+	PostTeamRename(c *gin.Context)
+	// Задать резервные команды для выбора ревьюверов
+	// (POST /team/setFallbacks)
+	PostTeamSetFallbacks(c *gin.Context)
+	// Изменить роль участника команды
+	// (POST /team/setMemberRole)
+	PostTeamSetMemberRole(c *gin.Context)
+	// Изменить настройки выбора ревьюверов команды
+	// (POST /team/setSettings)
+	PostTeamSetSettings(c *gin.Context)
+	// Получить окна отсутствия пользователя
+	// (GET /users/availability)
+	GetUsersAvailability(c *gin.Context, params GetUsersAvailabilityParams)
+	// Добавить окно отсутствия пользователя
+	// (POST /users/availability)
+	PostUsersAvailability(c *gin.Context)
+	// Удалить окно отсутствия пользователя
+	// (POST /users/availability/delete)
+	PostUsersAvailabilityDelete(c *gin.Context)
 	// Получить PR'ы, где пользователь назначен ревьювером
 	// (GET /users/getReview)
 	GetUsersGetReview(c *gin.Context, params GetUsersGetReviewParams)
 	// Установить флаг активности пользователя
 	// (POST /users/setIsActive)
 	PostUsersSetIsActive(c *gin.Context)
+	// Задать часовой пояс и рабочие часы пользователя
+	// (POST /users/setWorkingHours)
+	PostUsersSetWorkingHours(c *gin.Context)
+	// Удалить подписку вместе с журналом её доставок
+	// (POST /webhooks/delete)
+	PostWebhooksDelete(c *gin.Context)
+	// Журнал доставок подписки
+	// (GET /webhooks/deliveries)
+	GetWebhooksDeliveries(c *gin.Context, params GetWebhooksDeliveriesParams)
+	// Получить подписки на события
+	// (GET /webhooks/list)
+	GetWebhooksList(c *gin.Context)
+	// Подписаться на события
+	// (POST /webhooks/subscribe)
+	PostWebhooksSubscribe(c *gin.Context)
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -48,9 +129,459 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(c *gin.Context)
 
+// GetAudit operation middleware
+func (siw *ServerInterfaceWrapper) GetAudit(c *gin.Context) {
+
+	var err error
+
+	c.Set(AdminTokenScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAuditParams
+
+	// ------------- Optional query parameter "target_type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "target_type", c.Request.URL.Query(), &params.TargetType)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter target_type: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "target_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "target_id", c.Request.URL.Query(), &params.TargetId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter target_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "actor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "actor", c.Request.URL.Query(), &params.Actor)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter actor: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", c.Request.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", c.Request.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", c.Request.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cursor: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetAudit(c, params)
+}
+
+// GetEventsStream operation middleware
+func (siw *ServerInterfaceWrapper) GetEventsStream(c *gin.Context) {
+
+	var err error
+
+	c.Set(AdminTokenScopes, []string{})
+
+	c.Set(UserTokenScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetEventsStreamParams
+
+	// ------------- Optional query parameter "user_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "user_id", c.Request.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_name", c.Request.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter team_name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "Last-Event-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Last-Event-ID")]; found {
+		var LastEventID int64
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for Last-Event-ID, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Last-Event-ID", valueList[0], &LastEventID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter Last-Event-ID: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.LastEventID = &LastEventID
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetEventsStream(c, params)
+}
+
+// PostPullRequestClose operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestClose(c *gin.Context) {
+
+	c.Set(AdminTokenScopes, []string{})
+
+	c.Set(UserTokenScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostPullRequestClose(c)
+}
+
 // PostPullRequestCreate operation middleware
 func (siw *ServerInterfaceWrapper) PostPullRequestCreate(c *gin.Context) {
 
+	c.Set(AdminTokenScopes, []string{})
+
+	c.Set(UserTokenScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostPullRequestCreate(c)
+}
+
+// GetPullRequestList operation middleware
+func (siw *ServerInterfaceWrapper) GetPullRequestList(c *gin.Context) {
+
+	var err error
+
+	c.Set(AdminTokenScopes, []string{})
+
+	c.Set(UserTokenScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPullRequestListParams
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", c.Request.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "author_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "author_id", c.Request.URL.Query(), &params.AuthorId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter author_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "reviewer_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "reviewer_id", c.Request.URL.Query(), &params.ReviewerId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter reviewer_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_name", c.Request.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter team_name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "created_from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_from", c.Request.URL.Query(), &params.CreatedFrom)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter created_from: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "created_to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_to", c.Request.URL.Query(), &params.CreatedTo)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter created_to: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "merged_from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "merged_from", c.Request.URL.Query(), &params.MergedFrom)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter merged_from: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "merged_to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "merged_to", c.Request.URL.Query(), &params.MergedTo)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter merged_to: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", c.Request.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cursor: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetPullRequestList(c, params)
+}
+
+// PostPullRequestMarkReady operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestMarkReady(c *gin.Context) {
+
+	c.Set(AdminTokenScopes, []string{})
+
+	c.Set(UserTokenScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostPullRequestMarkReady(c)
+}
+
+// PostPullRequestMerge operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestMerge(c *gin.Context) {
+
+	c.Set(AdminTokenScopes, []string{})
+
+	c.Set(UserTokenScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostPullRequestMerge(c)
+}
+
+// GetPullRequestOverdue operation middleware
+func (siw *ServerInterfaceWrapper) GetPullRequestOverdue(c *gin.Context) {
+
+	var err error
+
+	c.Set(AdminTokenScopes, []string{})
+
+	c.Set(UserTokenScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPullRequestOverdueParams
+
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_name", c.Request.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter team_name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetPullRequestOverdue(c, params)
+}
+
+// PostPullRequestReassign operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestReassign(c *gin.Context) {
+
+	c.Set(AdminTokenScopes, []string{})
+
+	c.Set(UserTokenScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostPullRequestReassign(c)
+}
+
+// PostPullRequestReopen operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestReopen(c *gin.Context) {
+
+	c.Set(AdminTokenScopes, []string{})
+
+	c.Set(UserTokenScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostPullRequestReopen(c)
+}
+
+// PostPullRequestReview operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestReview(c *gin.Context) {
+
+	c.Set(AdminTokenScopes, []string{})
+
+	c.Set(UserTokenScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostPullRequestReview(c)
+}
+
+// GetStatsGet operation middleware
+func (siw *ServerInterfaceWrapper) GetStatsGet(c *gin.Context) {
+
+	c.Set(AdminTokenScopes, []string{})
+
+	c.Set(UserTokenScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetStatsGet(c)
+}
+
+// PostTeamAdd operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamAdd(c *gin.Context) {
+
+	c.Set(AdminTokenScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostTeamAdd(c)
+}
+
+// PostTeamAddMembers operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamAddMembers(c *gin.Context) {
+
+	c.Set(AdminTokenScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostTeamAddMembers(c)
+}
+
+// PostTeamDeactivateUsers operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamDeactivateUsers(c *gin.Context) {
+
+	c.Set(AdminTokenScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostTeamDeactivateUsers(c)
+}
+
+// PostTeamDelete operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamDelete(c *gin.Context) {
+
+	c.Set(AdminTokenScopes, []string{})
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -58,11 +589,35 @@ func (siw *ServerInterfaceWrapper) PostPullRequestCreate(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostPullRequestCreate(c)
+	siw.Handler.PostTeamDelete(c)
 }
 
-// PostPullRequestMerge operation middleware
-func (siw *ServerInterfaceWrapper) PostPullRequestMerge(c *gin.Context) {
+// GetTeamGet operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamGet(c *gin.Context) {
+
+	var err error
+
+	c.Set(AdminTokenScopes, []string{})
+
+	c.Set(UserTokenScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamGetParams
+
+	// ------------- Required query parameter "team_name" -------------
+
+	if paramValue := c.Query("team_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument team_name is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", c.Request.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter team_name: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -71,11 +626,13 @@ func (siw *ServerInterfaceWrapper) PostPullRequestMerge(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostPullRequestMerge(c)
+	siw.Handler.GetTeamGet(c, params)
 }
 
-// PostPullRequestReassign operation middleware
-func (siw *ServerInterfaceWrapper) PostPullRequestReassign(c *gin.Context) {
+// PostTeamMoveMember operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamMoveMember(c *gin.Context) {
+
+	c.Set(AdminTokenScopes, []string{})
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -84,11 +641,35 @@ func (siw *ServerInterfaceWrapper) PostPullRequestReassign(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostPullRequestReassign(c)
+	siw.Handler.PostTeamMoveMember(c)
 }
 
-// GetStatsGet operation middleware
-func (siw *ServerInterfaceWrapper) GetStatsGet(c *gin.Context) {
+// GetTeamOwners operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamOwners(c *gin.Context) {
+
+	var err error
+
+	c.Set(AdminTokenScopes, []string{})
+
+	c.Set(UserTokenScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamOwnersParams
+
+	// ------------- Required query parameter "team_name" -------------
+
+	if paramValue := c.Query("team_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument team_name is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", c.Request.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter team_name: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -97,11 +678,13 @@ func (siw *ServerInterfaceWrapper) GetStatsGet(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetStatsGet(c)
+	siw.Handler.GetTeamOwners(c, params)
 }
 
-// PostTeamAdd operation middleware
-func (siw *ServerInterfaceWrapper) PostTeamAdd(c *gin.Context) {
+// PostTeamOwners operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamOwners(c *gin.Context) {
+
+	c.Set(AdminTokenScopes, []string{})
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -110,29 +693,108 @@ func (siw *ServerInterfaceWrapper) PostTeamAdd(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostTeamAdd(c)
+	siw.Handler.PostTeamOwners(c)
 }
 
-// GetTeamGet operation middleware
-func (siw *ServerInterfaceWrapper) GetTeamGet(c *gin.Context) {
+// PostTeamRemoveMember operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamRemoveMember(c *gin.Context) {
+
+	c.Set(AdminTokenScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostTeamRemoveMember(c)
+}
+
+// PostTeamRename operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamRename(c *gin.Context) {
+
+	c.Set(AdminTokenScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostTeamRename(c)
+}
+
+// PostTeamSetFallbacks operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamSetFallbacks(c *gin.Context) {
+
+	c.Set(AdminTokenScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostTeamSetFallbacks(c)
+}
+
+// PostTeamSetMemberRole operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamSetMemberRole(c *gin.Context) {
+
+	c.Set(AdminTokenScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostTeamSetMemberRole(c)
+}
+
+// PostTeamSetSettings operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamSetSettings(c *gin.Context) {
+
+	c.Set(AdminTokenScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostTeamSetSettings(c)
+}
+
+// GetUsersAvailability operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersAvailability(c *gin.Context) {
 
 	var err error
 
+	c.Set(AdminTokenScopes, []string{})
+
+	c.Set(UserTokenScopes, []string{})
+
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetTeamGetParams
+	var params GetUsersAvailabilityParams
 
-	// ------------- Required query parameter "team_name" -------------
+	// ------------- Required query parameter "user_id" -------------
 
-	if paramValue := c.Query("team_name"); paramValue != "" {
+	if paramValue := c.Query("user_id"); paramValue != "" {
 
 	} else {
-		siw.ErrorHandler(c, fmt.Errorf("Query argument team_name is required, but not found"), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Query argument user_id is required, but not found"), http.StatusBadRequest)
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "team_name", c.Request.URL.Query(), &params.TeamName)
+	err = runtime.BindQueryParameter("form", true, true, "user_id", c.Request.URL.Query(), &params.UserId)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter team_name: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -143,7 +805,41 @@ func (siw *ServerInterfaceWrapper) GetTeamGet(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetTeamGet(c, params)
+	siw.Handler.GetUsersAvailability(c, params)
+}
+
+// PostUsersAvailability operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersAvailability(c *gin.Context) {
+
+	c.Set(AdminTokenScopes, []string{})
+
+	c.Set(UserTokenScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostUsersAvailability(c)
+}
+
+// PostUsersAvailabilityDelete operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersAvailabilityDelete(c *gin.Context) {
+
+	c.Set(AdminTokenScopes, []string{})
+
+	c.Set(UserTokenScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostUsersAvailabilityDelete(c)
 }
 
 // GetUsersGetReview operation middleware
@@ -151,6 +847,10 @@ func (siw *ServerInterfaceWrapper) GetUsersGetReview(c *gin.Context) {
 
 	var err error
 
+	c.Set(AdminTokenScopes, []string{})
+
+	c.Set(UserTokenScopes, []string{})
+
 	// Parameter object where we will unmarshal all parameters from the context
 	var params GetUsersGetReviewParams
 
@@ -169,6 +869,30 @@ func (siw *ServerInterfaceWrapper) GetUsersGetReview(c *gin.Context) {
 		return
 	}
 
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", c.Request.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", c.Request.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cursor: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -182,6 +906,10 @@ func (siw *ServerInterfaceWrapper) GetUsersGetReview(c *gin.Context) {
 // PostUsersSetIsActive operation middleware
 func (siw *ServerInterfaceWrapper) PostUsersSetIsActive(c *gin.Context) {
 
+	c.Set(AdminTokenScopes, []string{})
+
+	c.Set(UserTokenScopes, []string{})
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -192,6 +920,119 @@ func (siw *ServerInterfaceWrapper) PostUsersSetIsActive(c *gin.Context) {
 	siw.Handler.PostUsersSetIsActive(c)
 }
 
+// PostUsersSetWorkingHours operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersSetWorkingHours(c *gin.Context) {
+
+	c.Set(AdminTokenScopes, []string{})
+
+	c.Set(UserTokenScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostUsersSetWorkingHours(c)
+}
+
+// PostWebhooksDelete operation middleware
+func (siw *ServerInterfaceWrapper) PostWebhooksDelete(c *gin.Context) {
+
+	c.Set(AdminTokenScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostWebhooksDelete(c)
+}
+
+// GetWebhooksDeliveries operation middleware
+func (siw *ServerInterfaceWrapper) GetWebhooksDeliveries(c *gin.Context) {
+
+	var err error
+
+	c.Set(AdminTokenScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetWebhooksDeliveriesParams
+
+	// ------------- Required query parameter "subscription_id" -------------
+
+	if paramValue := c.Query("subscription_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument subscription_id is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "subscription_id", c.Request.URL.Query(), &params.SubscriptionId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter subscription_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", c.Request.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetWebhooksDeliveries(c, params)
+}
+
+// GetWebhooksList operation middleware
+func (siw *ServerInterfaceWrapper) GetWebhooksList(c *gin.Context) {
+
+	c.Set(AdminTokenScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetWebhooksList(c)
+}
+
+// PostWebhooksSubscribe operation middleware
+func (siw *ServerInterfaceWrapper) PostWebhooksSubscribe(c *gin.Context) {
+
+	c.Set(AdminTokenScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostWebhooksSubscribe(c)
+}
+
 // GinServerOptions provides options for the Gin server.
 type GinServerOptions struct {
 	BaseURL      string
@@ -219,12 +1060,39 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 		ErrorHandler:       errorHandler,
 	}
 
+	router.GET(options.BaseURL+"/audit", wrapper.GetAudit)
+	router.GET(options.BaseURL+"/events/stream", wrapper.GetEventsStream)
+	router.POST(options.BaseURL+"/pullRequest/close", wrapper.PostPullRequestClose)
 	router.POST(options.BaseURL+"/pullRequest/create", wrapper.PostPullRequestCreate)
+	router.GET(options.BaseURL+"/pullRequest/list", wrapper.GetPullRequestList)
+	router.POST(options.BaseURL+"/pullRequest/markReady", wrapper.PostPullRequestMarkReady)
 	router.POST(options.BaseURL+"/pullRequest/merge", wrapper.PostPullRequestMerge)
+	router.GET(options.BaseURL+"/pullRequest/overdue", wrapper.GetPullRequestOverdue)
 	router.POST(options.BaseURL+"/pullRequest/reassign", wrapper.PostPullRequestReassign)
+	router.POST(options.BaseURL+"/pullRequest/reopen", wrapper.PostPullRequestReopen)
+	router.POST(options.BaseURL+"/pullRequest/review", wrapper.PostPullRequestReview)
 	router.GET(options.BaseURL+"/stats/get", wrapper.GetStatsGet)
 	router.POST(options.BaseURL+"/team/add", wrapper.PostTeamAdd)
+	router.POST(options.BaseURL+"/team/addMembers", wrapper.PostTeamAddMembers)
+	router.POST(options.BaseURL+"/team/deactivateUsers", wrapper.PostTeamDeactivateUsers)
+	router.POST(options.BaseURL+"/team/delete", wrapper.PostTeamDelete)
 	router.GET(options.BaseURL+"/team/get", wrapper.GetTeamGet)
+	router.POST(options.BaseURL+"/team/moveMember", wrapper.PostTeamMoveMember)
+	router.GET(options.BaseURL+"/team/owners", wrapper.GetTeamOwners)
+	router.POST(options.BaseURL+"/team/owners", wrapper.PostTeamOwners)
+	router.POST(options.BaseURL+"/team/removeMember", wrapper.PostTeamRemoveMember)
+	router.POST(options.BaseURL+"/team/rename", wrapper.PostTeamRename)
+	router.POST(options.BaseURL+"/team/setFallbacks", wrapper.PostTeamSetFallbacks)
+	router.POST(options.BaseURL+"/team/setMemberRole", wrapper.PostTeamSetMemberRole)
+	router.POST(options.BaseURL+"/team/setSettings", wrapper.PostTeamSetSettings)
+	router.GET(options.BaseURL+"/users/availability", wrapper.GetUsersAvailability)
+	router.POST(options.BaseURL+"/users/availability", wrapper.PostUsersAvailability)
+	router.POST(options.BaseURL+"/users/availability/delete", wrapper.PostUsersAvailabilityDelete)
 	router.GET(options.BaseURL+"/users/getReview", wrapper.GetUsersGetReview)
 	router.POST(options.BaseURL+"/users/setIsActive", wrapper.PostUsersSetIsActive)
+	router.POST(options.BaseURL+"/users/setWorkingHours", wrapper.PostUsersSetWorkingHours)
+	router.POST(options.BaseURL+"/webhooks/delete", wrapper.PostWebhooksDelete)
+	router.GET(options.BaseURL+"/webhooks/deliveries", wrapper.GetWebhooksDeliveries)
+	router.GET(options.BaseURL+"/webhooks/list", wrapper.GetWebhooksList)
+	router.POST(options.BaseURL+"/webhooks/subscribe", wrapper.PostWebhooksSubscribe)
 }
@@ -7,30 +7,207 @@ import (
 	"time"
 )
 
+const (
+	AdminTokenScopes = "adminToken.Scopes"
+	UserTokenScopes  = "userToken.Scopes"
+)
+
+// Defines values for AuditAction.
+const (
+	AuditActionPullRequestChangeStatus AuditAction = "pull_request.change_status"
+	AuditActionPullRequestCreate       AuditAction = "pull_request.create"
+	AuditActionPullRequestMerge        AuditAction = "pull_request.merge"
+	AuditActionPullRequestReassign     AuditAction = "pull_request.reassign"
+	AuditActionPullRequestReview       AuditAction = "pull_request.review"
+	AuditActionTeamAddMembers          AuditAction = "team.add_members"
+	AuditActionTeamCreate              AuditAction = "team.create"
+	AuditActionTeamDelete              AuditAction = "team.delete"
+	AuditActionTeamMoveMember          AuditAction = "team.move_member"
+	AuditActionTeamRemoveMember        AuditAction = "team.remove_member"
+	AuditActionTeamRename              AuditAction = "team.rename"
+	AuditActionTeamSetFallbacks        AuditAction = "team.set_fallbacks"
+	AuditActionTeamSetMemberRole       AuditAction = "team.set_member_role"
+	AuditActionTeamSetOwners           AuditAction = "team.set_owners"
+	AuditActionTeamUpdateSettings      AuditAction = "team.update_settings"
+	AuditActionUserChangeStatus        AuditAction = "user.change_status"
+	AuditActionWebhookCreate           AuditAction = "webhook.create"
+	AuditActionWebhookDelete           AuditAction = "webhook.delete"
+)
+
+// Defines values for AuditTargetType.
+const (
+	AuditTargetTypePullRequest AuditTargetType = "pull_request"
+	AuditTargetTypeTeam        AuditTargetType = "team"
+	AuditTargetTypeUser        AuditTargetType = "user"
+	AuditTargetTypeWebhook     AuditTargetType = "webhook"
+)
+
 // Defines values for ErrorCode.
 const (
-	INTERNALERR   ErrorCode = "INTERNAL_ERR"
-	NOCANDIDATE   ErrorCode = "NO_CANDIDATE"
-	NOTASSIGNED   ErrorCode = "NOT_ASSIGNED"
-	NOTFOUND      ErrorCode = "NOT_FOUND"
-	NOTINTEAM     ErrorCode = "NOT_IN_TEAM"
-	PREXISTS      ErrorCode = "PR_EXISTS"
-	PRMERGED      ErrorCode = "PR_MERGED"
-	TEAMEXISTS    ErrorCode = "TEAM_EXISTS"
-	VALIDATIONERR ErrorCode = "VALIDATION_ERR"
+	ALREADYINTEAM       ErrorCode = "ALREADY_IN_TEAM"
+	AMBIGUOUSTEAM       ErrorCode = "AMBIGUOUS_TEAM"
+	FORBIDDEN           ErrorCode = "FORBIDDEN"
+	INTERNALERR         ErrorCode = "INTERNAL_ERR"
+	INVALIDTRANSITION   ErrorCode = "INVALID_TRANSITION"
+	NOCANDIDATE         ErrorCode = "NO_CANDIDATE"
+	NOTASSIGNED         ErrorCode = "NOT_ASSIGNED"
+	NOTENOUGHAPPROVALS  ErrorCode = "NOT_ENOUGH_APPROVALS"
+	NOTFOUND            ErrorCode = "NOT_FOUND"
+	NOTINTEAM           ErrorCode = "NOT_IN_TEAM"
+	PREXISTS            ErrorCode = "PR_EXISTS"
+	PRMERGED            ErrorCode = "PR_MERGED"
+	PRNOTACTIVE         ErrorCode = "PR_NOT_ACTIVE"
+	REVIEWERSATCAPACITY ErrorCode = "REVIEWERS_AT_CAPACITY"
+	TEAMEXISTS          ErrorCode = "TEAM_EXISTS"
+	TEAMNOTEMPTY        ErrorCode = "TEAM_NOT_EMPTY"
+	UNAUTHORIZED        ErrorCode = "UNAUTHORIZED"
+	VALIDATIONERR       ErrorCode = "VALIDATION_ERR"
 )
 
 // Defines values for PullRequestStatus.
 const (
-	MERGED PullRequestStatus = "MERGED"
-	OPEN   PullRequestStatus = "OPEN"
+	CLOSED   PullRequestStatus = "CLOSED"
+	DRAFT    PullRequestStatus = "DRAFT"
+	MERGED   PullRequestStatus = "MERGED"
+	OPEN     PullRequestStatus = "OPEN"
+	REOPENED PullRequestStatus = "REOPENED"
+)
+
+// Defines values for ReviewVerdict.
+const (
+	APPROVE        ReviewVerdict = "APPROVE"
+	COMMENT        ReviewVerdict = "COMMENT"
+	REQUESTCHANGES ReviewVerdict = "REQUEST_CHANGES"
+)
+
+// Defines values for ReviewerStrategy.
+const (
+	LeastLoaded           ReviewerStrategy = "least_loaded"
+	LeastRecentlyAssigned ReviewerStrategy = "least_recently_assigned"
+	Random                ReviewerStrategy = "random"
+	RoundRobin            ReviewerStrategy = "round_robin"
+)
+
+// Defines values for TeamRole.
+const (
+	Lead       TeamRole = "lead"
+	Maintainer TeamRole = "maintainer"
+	Member     TeamRole = "member"
+)
+
+// Defines values for WebhookDeliveryStatus.
+const (
+	Delivered WebhookDeliveryStatus = "delivered"
+	Failed    WebhookDeliveryStatus = "failed"
+	Pending   WebhookDeliveryStatus = "pending"
+)
+
+// Defines values for WebhookEventType.
+const (
+	PullRequestMerged  WebhookEventType = "pull_request.merged"
+	ReviewerReassigned WebhookEventType = "reviewer.reassigned"
+	ReviewersAssigned  WebhookEventType = "reviewers.assigned"
 )
 
+// AddTeamMembersRequest defines model for AddTeamMembersRequest.
+type AddTeamMembersRequest struct {
+	Members  []TeamMember `json:"members"`
+	TeamName string       `json:"team_name"`
+}
+
+// AuditAction defines model for AuditAction.
+type AuditAction string
+
+// AuditEntry defines model for AuditEntry.
+type AuditEntry struct {
+	Action AuditAction `json:"action"`
+
+	// Actor Пользователь, выполнивший операцию (заголовок X-Actor-ID); нет, если операцию выполнил сервис
+	Actor *string `json:"actor,omitempty"`
+
+	// After Состояние объекта после операции
+	After interface{} `json:"after"`
+
+	// Before Состояние объекта до операции; null, если объекта не было
+	Before    interface{} `json:"before"`
+	CreatedAt time.Time   `json:"created_at"`
+	Id        int64       `json:"id"`
+
+	// RequestId Идентификатор запроса (заголовок X-Request-ID)
+	RequestId *string `json:"request_id,omitempty"`
+
+	// TargetId user_id, pull_request_id, имя команды или id подписки на вебхуки
+	TargetId   string          `json:"target_id"`
+	TargetType AuditTargetType `json:"target_type"`
+}
+
+// AuditLogResponse defines model for AuditLogResponse.
+type AuditLogResponse struct {
+	Entries []AuditEntry `json:"entries"`
+
+	// NextCursor Курсор следующей страницы; нет, если страница последняя
+	NextCursor *int64 `json:"next_cursor,omitempty"`
+}
+
+// AuditTargetType defines model for AuditTargetType.
+type AuditTargetType string
+
 // CreatePullRequestResponse defines model for CreatePullRequestResponse.
 type CreatePullRequestResponse struct {
 	Pr PullRequest `json:"pr"`
 }
 
+// CreateUnavailabilityRequest defines model for CreateUnavailabilityRequest.
+type CreateUnavailabilityRequest struct {
+	// EndsAt Должно быть позже starts_at
+	EndsAt   time.Time `json:"ends_at"`
+	Reason   *string   `json:"reason,omitempty"`
+	StartsAt time.Time `json:"starts_at"`
+	UserId   string    `json:"user_id"`
+}
+
+// CreateWebhookSubscriptionRequest defines model for CreateWebhookSubscriptionRequest.
+type CreateWebhookSubscriptionRequest struct {
+	// Events Фильтр событий; если не задан - все события
+	Events *[]WebhookEventType `json:"events,omitempty"`
+
+	// Secret Ключ HMAC-SHA256 для подписи тела запроса, в ответах не возвращается
+	Secret string `json:"secret"`
+
+	// Url Адрес http(s), на который отправляются события
+	Url string `json:"url"`
+}
+
+// DeactivateUsersRequest defines model for DeactivateUsersRequest.
+type DeactivateUsersRequest struct {
+	TeamName string   `json:"team_name"`
+	UserIds  []string `json:"user_ids"`
+}
+
+// DeactivateUsersResponse defines model for DeactivateUsersResponse.
+type DeactivateUsersResponse struct {
+	// DeactivatedUsersCount Сколько пользователей было активно до запроса
+	DeactivatedUsersCount   int                   `json:"deactivated_users_count"`
+	NoCandidatePullRequests []UnreplacedReviewer  `json:"no_candidate_pull_requests"`
+	ReassignedPullRequests  []ReviewerReplacement `json:"reassigned_pull_requests"`
+}
+
+// DeleteTeamRequest defines model for DeleteTeamRequest.
+type DeleteTeamRequest struct {
+	TeamName string `json:"team_name"`
+}
+
+// DeleteUnavailabilityRequest defines model for DeleteUnavailabilityRequest.
+type DeleteUnavailabilityRequest struct {
+	Id     int64  `json:"id"`
+	UserId string `json:"user_id"`
+}
+
+// DeleteWebhookSubscriptionRequest defines model for DeleteWebhookSubscriptionRequest.
+type DeleteWebhookSubscriptionRequest struct {
+	Id int64 `json:"id"`
+}
+
 // Error defines model for Error.
 type Error struct {
 	Code    ErrorCode `json:"code"`
@@ -50,27 +227,96 @@ type MergePullRequestResponse struct {
 	Pr PullRequest `json:"pr"`
 }
 
+// MoveTeamMemberRequest defines model for MoveTeamMemberRequest.
+type MoveTeamMemberRequest struct {
+	// FromTeamName Команда, из которой переносится пользователь; обязательна, если он состоит в нескольких командах
+	FromTeamName *string `json:"from_team_name,omitempty"`
+
+	// ReassignReviews Заменить пользователя в его PR в статусах OPEN и REOPENED коллегами по прежней команде
+	ReassignReviews *bool `json:"reassign_reviews,omitempty"`
+
+	// TeamName Команда, в которую переносится пользователь
+	TeamName string `json:"team_name"`
+	UserId   string `json:"user_id"`
+}
+
+// OverduePullRequestsResponse defines model for OverduePullRequestsResponse.
+type OverduePullRequestsResponse struct {
+	// PullRequests Просроченные PR в порядке создания; просроченные ревьюверы отмечены в reviewer_pools
+	PullRequests []PullRequest `json:"pull_requests"`
+}
+
+// OwnerRule defines model for OwnerRule.
+type OwnerRule struct {
+	// Owners user_id владельцев или имена команд с префиксом @
+	Owners []string `json:"owners"`
+
+	// Pattern Шаблон пути в синтаксисе CODEOWNERS (например, /internal/storage/ или *.sql)
+	Pattern string `json:"pattern"`
+}
+
 // PullRequest defines model for PullRequest.
 type PullRequest struct {
-	// AssignedReviewers user_id назначенных ревьюверов (0..2)
-	AssignedReviewers []string    `json:"assigned_reviewers"`
-	AuthorId          string      `json:"author_id"`
-	CreatedAt         *time.Time  `json:"createdAt"`
-	MergedAt          *time.Time  `json:"mergedAt"`
-	PullRequestId     string      `json:"pull_request_id"`
-	PullRequestName   string      `json:"pull_request_name"`
-	Status            interface{} `json:"status"`
+	// AssignedReviewers user_id назначенных ревьюверов (0..max_reviewers команды)
+	AssignedReviewers []string   `json:"assigned_reviewers"`
+	AuthorId          string     `json:"author_id"`
+	CreatedAt         *time.Time `json:"createdAt"`
+
+	// IsOverdue PR в статусе OPEN или REOPENED, и хотя бы один его ревьювер держит его дольше review_sla_hours команды PR. Время считается в рабочих часах ревьювера: по будням его часового пояса и в пределах его рабочих часов, если они заданы
+	IsOverdue bool       `json:"is_overdue"`
+	MergedAt  *time.Time `json:"mergedAt"`
+
+	// NeedsMoreReviewers При создании в команде не хватило активных участников до min_reviewers
+	NeedsMoreReviewers bool   `json:"needs_more_reviewers"`
+	PullRequestId      string `json:"pull_request_id"`
+	PullRequestName    string `json:"pull_request_name"`
+
+	// ReviewerPools Из какой команды выбран каждый ревьювер
+	ReviewerPools []ReviewerPool `json:"reviewer_pools"`
+	Status        interface{}    `json:"status"`
+
+	// TeamName Команда, для которой создан PR и из которой выбираются ревьюверы
+	TeamName *string `json:"team_name,omitempty"`
+}
+
+// PullRequestListResponse defines model for PullRequestListResponse.
+type PullRequestListResponse struct {
+	// NextCursor Курсор следующей страницы; нет, если страница последняя
+	NextCursor *string `json:"next_cursor,omitempty"`
+
+	// PullRequests PR от новых к старым
+	PullRequests []PullRequest `json:"pull_requests"`
+}
+
+// PullRequestResponse defines model for PullRequestResponse.
+type PullRequestResponse struct {
+	Pr PullRequest `json:"pr"`
+}
+
+// PullRequestReview defines model for PullRequestReview.
+type PullRequestReview struct {
+	Comment       string    `json:"comment"`
+	PullRequestId string    `json:"pull_request_id"`
+	SubmittedAt   time.Time `json:"submitted_at"`
+	UserId        string    `json:"user_id"`
+
+	// Verdict Решение ревьювера. COMMENT не меняет предыдущее решение: при подсчёте одобрений учитывается последний APPROVE или REQUEST_CHANGES.
+	Verdict ReviewVerdict `json:"verdict"`
 }
 
 // PullRequestShort defines model for PullRequestShort.
 type PullRequestShort struct {
-	AuthorId        string            `json:"author_id"`
-	PullRequestId   string            `json:"pull_request_id"`
-	PullRequestName string            `json:"pull_request_name"`
-	Status          PullRequestStatus `json:"status"`
+	// AssignedAt С какого момента PR ждёт ревью пользователя (время назначения ревьювером)
+	AssignedAt      *time.Time `json:"assigned_at,omitempty"`
+	AuthorId        string     `json:"author_id"`
+	PullRequestId   string     `json:"pull_request_id"`
+	PullRequestName string     `json:"pull_request_name"`
+
+	// Status Допустимые переходы: DRAFT -> OPEN (markReady), DRAFT/OPEN/REOPENED -> CLOSED, OPEN/REOPENED -> MERGED, CLOSED -> REOPENED.
+	Status PullRequestStatus `json:"status"`
 }
 
-// PullRequestStatus defines model for PullRequestStatus.
+// PullRequestStatus Допустимые переходы: DRAFT -> OPEN (markReady), DRAFT/OPEN/REOPENED -> CLOSED, OPEN/REOPENED -> MERGED, CLOSED -> REOPENED.
 type PullRequestStatus string
 
 // PullRequestsStats defines model for PullRequestsStats.
@@ -87,9 +333,87 @@ type ReassignPullRequestResponse struct {
 	ReplacedBy string `json:"replaced_by"`
 }
 
+// RemoveTeamMemberRequest defines model for RemoveTeamMemberRequest.
+type RemoveTeamMemberRequest struct {
+	TeamName string `json:"team_name"`
+	UserId   string `json:"user_id"`
+}
+
+// RenameTeamRequest defines model for RenameTeamRequest.
+type RenameTeamRequest struct {
+	NewTeamName string `json:"new_team_name"`
+	TeamName    string `json:"team_name"`
+}
+
+// ReviewStatusCounts Сколько PR пользователь ревьюит в каждом статусе, без учёта фильтра status
+type ReviewStatusCounts struct {
+	CLOSED   int `json:"CLOSED"`
+	DRAFT    int `json:"DRAFT"`
+	MERGED   int `json:"MERGED"`
+	OPEN     int `json:"OPEN"`
+	REOPENED int `json:"REOPENED"`
+}
+
+// ReviewVerdict Решение ревьювера. COMMENT не меняет предыдущее решение: при подсчёте одобрений учитывается последний APPROVE или REQUEST_CHANGES.
+type ReviewVerdict string
+
+// ReviewerPool defines model for ReviewerPool.
+type ReviewerPool struct {
+	// AssignedAt Время назначения ревьювера; при переназначении отсчёт SLA начинается заново
+	AssignedAt *time.Time `json:"assigned_at,omitempty"`
+
+	// IsFallback Ревьювер выбран из резервной команды
+	IsFallback bool `json:"is_fallback"`
+
+	// IsOverdue Ревьювер держит PR дольше SLA команды PR
+	IsOverdue *bool `json:"is_overdue,omitempty"`
+
+	// IsOwner Ревьювер назначен как владелец изменённых файлов
+	IsOwner bool `json:"is_owner"`
+
+	// TeamName Команда, из которой выбран ревьювер
+	TeamName string `json:"team_name"`
+	UserId   string `json:"user_id"`
+}
+
+// ReviewerReplacement defines model for ReviewerReplacement.
+type ReviewerReplacement struct {
+	OldUserId     string `json:"old_user_id"`
+	PullRequestId string `json:"pull_request_id"`
+
+	// ReplacedBy user_id нового ревьювера
+	ReplacedBy string `json:"replaced_by"`
+}
+
+// ReviewerStrategy Стратегия выбора ревьюверов. Если не задана, используется стратегия по умолчанию из конфигурации сервиса.
+type ReviewerStrategy string
+
 // SetIsActiveResponse defines model for SetIsActiveResponse.
 type SetIsActiveResponse struct {
-	User User `json:"user"`
+	// NoCandidatePullRequests PR в статусах OPEN и REOPENED, где замены не нашлось
+	NoCandidatePullRequests []UnreplacedReviewer `json:"no_candidate_pull_requests"`
+
+	// ReassignedPullRequests PR в статусах OPEN и REOPENED, где деактивированный пользователь заменён
+	ReassignedPullRequests []ReviewerReplacement `json:"reassigned_pull_requests"`
+	User                   User                  `json:"user"`
+}
+
+// SetTeamMemberRoleRequest defines model for SetTeamMemberRoleRequest.
+type SetTeamMemberRoleRequest struct {
+	// Role Роль пользователя в команде (по умолчанию member). maintainer может мержить PR команды, lead - ещё и переназначать ревьюверов в PR команды и менять флаг активности участников команды.
+	Role     TeamRole `json:"role"`
+	TeamName string   `json:"team_name"`
+	UserId   string   `json:"user_id"`
+}
+
+// SetWorkingHoursRequest defines model for SetWorkingHoursRequest.
+type SetWorkingHoursRequest struct {
+	// Timezone Часовой пояс из базы IANA, например Europe/Moscow
+	Timezone string `json:"timezone"`
+	UserId   string `json:"user_id"`
+
+	// WorkingHours Рабочие часы по часовому поясу пользователя; начало включается, конец - нет. Если end раньше start, интервал переходит через полночь
+	WorkingHours *WorkingHours `json:"working_hours,omitempty"`
 }
 
 // Stats defines model for Stats.
@@ -98,10 +422,37 @@ type Stats struct {
 	UserStats         []UserStats         `json:"user_stats"`
 }
 
+// SubmitReviewResponse defines model for SubmitReviewResponse.
+type SubmitReviewResponse struct {
+	// Approvals Число текущих ревьюверов, последнее решение которых - APPROVE
+	Approvals int               `json:"approvals"`
+	Review    PullRequestReview `json:"review"`
+}
+
 // Team defines model for Team.
 type Team struct {
-	Members  []TeamMember `json:"members"`
-	TeamName string       `json:"team_name"`
+	// FallbackTeams Резервные команды в порядке приоритета (задаются через /team/setFallbacks)
+	FallbackTeams *[]string `json:"fallback_teams,omitempty"`
+
+	// MaxReviewers Максимальное число ревьюверов PR (по умолчанию 2)
+	MaxReviewers *int         `json:"max_reviewers,omitempty"`
+	Members      []TeamMember `json:"members"`
+
+	// MinReviewers Минимальное число ревьюверов PR (по умолчанию 1)
+	MinReviewers *int `json:"min_reviewers,omitempty"`
+
+	// PreferWorkingHours Выбирать в первую очередь кандидатов, у которых сейчас рабочее время (по умолчанию false). Кандидаты вне рабочих часов назначаются, только если остальных не хватает
+	PreferWorkingHours *bool `json:"prefer_working_hours,omitempty"`
+
+	// RequiredApprovals Число одобрений текущих ревьюверов, необходимое для мержа (0 - мерж без одобрений)
+	RequiredApprovals *int `json:"required_approvals,omitempty"`
+
+	// ReviewSlaHours Сколько рабочих часов ревьювер может держать PR, прежде чем ревью считается просроченным (по умолчанию 0 - без ограничения)
+	ReviewSlaHours *int `json:"review_sla_hours,omitempty"`
+
+	// ReviewerStrategy Стратегия выбора ревьюверов. Если не задана, используется стратегия по умолчанию из конфигурации сервиса.
+	ReviewerStrategy *ReviewerStrategy `json:"reviewer_strategy,omitempty"`
+	TeamName         string            `json:"team_name"`
 }
 
 // TeamAddResponse defines model for TeamAddResponse.
@@ -109,19 +460,138 @@ type TeamAddResponse struct {
 	Team Team `json:"team"`
 }
 
+// TeamFallbacks defines model for TeamFallbacks.
+type TeamFallbacks struct {
+	// FallbackTeamNames Резервные команды в порядке приоритета. Из них добираются ревьюверы, если в команде автора не хватает активных кандидатов до min_reviewers. Пустой список удаляет резервные команды.
+	FallbackTeamNames []string `json:"fallback_team_names"`
+	TeamName          string   `json:"team_name"`
+}
+
+// TeamFallbacksResponse defines model for TeamFallbacksResponse.
+type TeamFallbacksResponse struct {
+	Fallbacks TeamFallbacks `json:"fallbacks"`
+}
+
 // TeamMember defines model for TeamMember.
 type TeamMember struct {
-	IsActive bool   `json:"is_active"`
-	UserId   string `json:"user_id"`
-	Username string `json:"username"`
+	IsActive bool `json:"is_active"`
+
+	// Role Роль пользователя в команде (по умолчанию member). maintainer может мержить PR команды, lead - ещё и переназначать ревьюверов в PR команды и менять флаг активности участников команды.
+	Role *TeamRole `json:"role,omitempty"`
+
+	// Teams Все команды пользователя в порядке вступления (только в ответах)
+	Teams    *[]string `json:"teams,omitempty"`
+	UserId   string    `json:"user_id"`
+	Username string    `json:"username"`
+}
+
+// TeamMemberResponse defines model for TeamMemberResponse.
+type TeamMemberResponse struct {
+	NoCandidatePullRequests []UnreplacedReviewer  `json:"no_candidate_pull_requests"`
+	ReassignedPullRequests  []ReviewerReplacement `json:"reassigned_pull_requests"`
+	User                    User                  `json:"user"`
+}
+
+// TeamOwners defines model for TeamOwners.
+type TeamOwners struct {
+	// Rules Правила владения; для каждого файла применяется последнее подходящее правило
+	Rules    []OwnerRule `json:"rules"`
+	TeamName string      `json:"team_name"`
+}
+
+// TeamOwnersResponse defines model for TeamOwnersResponse.
+type TeamOwnersResponse struct {
+	Owners TeamOwners `json:"owners"`
+}
+
+// TeamResponse defines model for TeamResponse.
+type TeamResponse struct {
+	Team Team `json:"team"`
+}
+
+// TeamRole Роль пользователя в команде (по умолчанию member). maintainer может мержить PR команды, lead - ещё и переназначать ревьюверов в PR команды и менять флаг активности участников команды.
+type TeamRole string
+
+// TeamSettings defines model for TeamSettings.
+type TeamSettings struct {
+	MaxReviewers int `json:"max_reviewers"`
+	MinReviewers int `json:"min_reviewers"`
+
+	// PreferWorkingHours Выбирать в первую очередь кандидатов, у которых сейчас рабочее время
+	PreferWorkingHours *bool `json:"prefer_working_hours,omitempty"`
+
+	// RequiredApprovals Число одобрений, необходимое для мержа, не больше max_reviewers (0 - мерж без одобрений)
+	RequiredApprovals *int `json:"required_approvals,omitempty"`
+
+	// ReviewSlaHours SLA ревью в рабочих часах ревьювера (0 - без ограничения)
+	ReviewSlaHours *int `json:"review_sla_hours,omitempty"`
+
+	// ReviewerStrategy Стратегия выбора ревьюверов. Если не задана, используется стратегия по умолчанию из конфигурации сервиса.
+	ReviewerStrategy *ReviewerStrategy `json:"reviewer_strategy,omitempty"`
+	TeamName         string            `json:"team_name"`
+}
+
+// TeamSettingsResponse defines model for TeamSettingsResponse.
+type TeamSettingsResponse struct {
+	Settings TeamSettings `json:"settings"`
+}
+
+// UnavailabilityWindow defines model for UnavailabilityWindow.
+type UnavailabilityWindow struct {
+	EndsAt time.Time `json:"ends_at"`
+	Id     int64     `json:"id"`
+
+	// Reason Причина отсутствия, например отпуск
+	Reason   *string   `json:"reason,omitempty"`
+	StartsAt time.Time `json:"starts_at"`
+	UserId   string    `json:"user_id"`
+}
+
+// UnavailabilityWindowResponse defines model for UnavailabilityWindowResponse.
+type UnavailabilityWindowResponse struct {
+	Window UnavailabilityWindow `json:"window"`
+}
+
+// UnreplacedReviewer defines model for UnreplacedReviewer.
+type UnreplacedReviewer struct {
+	PullRequestId string `json:"pull_request_id"`
+
+	// UserId Ревьювер, для которого не нашлось замены; остаётся назначенным в PR
+	UserId string `json:"user_id"`
 }
 
 // User defines model for User.
 type User struct {
-	IsActive bool   `json:"is_active"`
+	IsActive bool `json:"is_active"`
+
+	// TeamName Первая команда пользователя по времени вступления; пустая строка, если он не состоит в командах
 	TeamName string `json:"team_name"`
-	UserId   string `json:"user_id"`
-	Username string `json:"username"`
+
+	// Teams Все команды пользователя в порядке вступления
+	Teams []UserTeam `json:"teams"`
+
+	// Timezone Часовой пояс пользователя из базы IANA, например Europe/Moscow
+	Timezone *string `json:"timezone,omitempty"`
+	UserId   string  `json:"user_id"`
+	Username string  `json:"username"`
+
+	// WorkingHours Рабочие часы по часовому поясу пользователя; начало включается, конец - нет. Если end раньше start, интервал переходит через полночь
+	WorkingHours *WorkingHours `json:"working_hours,omitempty"`
+}
+
+// UserAvailabilityResponse defines model for UserAvailabilityResponse.
+type UserAvailabilityResponse struct {
+	// IsAvailable Пользователь активен и сейчас не попадает ни в одно окно отсутствия, то есть может быть назначен ревьювером
+	IsAvailable bool   `json:"is_available"`
+	UserId      string `json:"user_id"`
+
+	// Windows Текущие и будущие окна отсутствия в порядке начала
+	Windows []UnavailabilityWindow `json:"windows"`
+}
+
+// UserResponse defines model for UserResponse.
+type UserResponse struct {
+	User User `json:"user"`
 }
 
 // UserStats defines model for UserStats.
@@ -131,10 +601,85 @@ type UserStats struct {
 	UserId             string `json:"user_id"`
 }
 
+// UserTeam defines model for UserTeam.
+type UserTeam struct {
+	// Role Роль пользователя в команде (по умолчанию member). maintainer может мержить PR команды, lead - ещё и переназначать ревьюверов в PR команды и менять флаг активности участников команды.
+	Role     TeamRole `json:"role"`
+	TeamName string   `json:"team_name"`
+}
+
 // UsersGetReviewResponse defines model for UsersGetReviewResponse.
 type UsersGetReviewResponse struct {
+	// NextCursor Курсор следующей страницы; нет, если страница последняя
+	NextCursor *string `json:"next_cursor,omitempty"`
+
+	// PullRequests PR от дольше всех ждущих ревью пользователя к недавно назначенным
 	PullRequests []PullRequestShort `json:"pull_requests"`
-	UserId       string             `json:"user_id"`
+
+	// StatusCounts Сколько PR пользователь ревьюит в каждом статусе, без учёта фильтра status
+	StatusCounts ReviewStatusCounts `json:"status_counts"`
+
+	// Total Сколько PR подходит под фильтр status на всех страницах
+	Total  int    `json:"total"`
+	UserId string `json:"user_id"`
+}
+
+// WebhookDeliveriesResponse defines model for WebhookDeliveriesResponse.
+type WebhookDeliveriesResponse struct {
+	Deliveries []WebhookDelivery `json:"deliveries"`
+}
+
+// WebhookDelivery defines model for WebhookDelivery.
+type WebhookDelivery struct {
+	Attempts    int        `json:"attempts"`
+	CreatedAt   time.Time  `json:"created_at"`
+	DeliveredAt *time.Time `json:"delivered_at,omitempty"`
+
+	// EventId Идентификатор события, совпадает с заголовком X-Webhook-Delivery
+	EventId   string           `json:"event_id"`
+	EventType WebhookEventType `json:"event_type"`
+	Id        int64            `json:"id"`
+	LastError *string          `json:"last_error,omitempty"`
+
+	// LastStatusCode HTTP-код ответа получателя на последнюю попытку
+	LastStatusCode *int `json:"last_status_code,omitempty"`
+
+	// NextAttemptAt Время следующей попытки, если доставка ещё не завершена
+	NextAttemptAt  *time.Time            `json:"next_attempt_at,omitempty"`
+	Status         WebhookDeliveryStatus `json:"status"`
+	SubscriptionId int64                 `json:"subscription_id"`
+}
+
+// WebhookDeliveryStatus defines model for WebhookDeliveryStatus.
+type WebhookDeliveryStatus string
+
+// WebhookEventType defines model for WebhookEventType.
+type WebhookEventType string
+
+// WebhookSubscription defines model for WebhookSubscription.
+type WebhookSubscription struct {
+	CreatedAt time.Time `json:"created_at"`
+
+	// Events События, на которые оформлена подписка; пустой список - все события
+	Events []WebhookEventType `json:"events"`
+	Id     int64              `json:"id"`
+	Url    string             `json:"url"`
+}
+
+// WebhookSubscriptionResponse defines model for WebhookSubscriptionResponse.
+type WebhookSubscriptionResponse struct {
+	Subscription WebhookSubscription `json:"subscription"`
+}
+
+// WebhookSubscriptionsResponse defines model for WebhookSubscriptionsResponse.
+type WebhookSubscriptionsResponse struct {
+	Subscriptions []WebhookSubscription `json:"subscriptions"`
+}
+
+// WorkingHours Рабочие часы по часовому поясу пользователя; начало включается, конец - нет. Если end раньше start, интервал переходит через полночь
+type WorkingHours struct {
+	End   string `json:"end"`
+	Start string `json:"start"`
 }
 
 // TeamNameQuery defines model for TeamNameQuery.
@@ -143,11 +688,88 @@ type TeamNameQuery = string
 // UserIdQuery defines model for UserIdQuery.
 type UserIdQuery = string
 
+// Forbidden defines model for Forbidden.
+type Forbidden = ErrorResponse
+
+// Unauthorized defines model for Unauthorized.
+type Unauthorized = ErrorResponse
+
+// GetAuditParams defines parameters for GetAudit.
+type GetAuditParams struct {
+	TargetType *AuditTargetType `form:"target_type,omitempty" json:"target_type,omitempty"`
+	TargetId   *string          `form:"target_id,omitempty" json:"target_id,omitempty"`
+	Actor      *string          `form:"actor,omitempty" json:"actor,omitempty"`
+
+	// From Записи не раньше этого момента
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Записи раньше этого момента
+	To     *time.Time `form:"to,omitempty" json:"to,omitempty"`
+	Cursor *int64     `form:"cursor,omitempty" json:"cursor,omitempty"`
+	Limit  *int       `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetEventsStreamParams defines parameters for GetEventsStream.
+type GetEventsStreamParams struct {
+	// UserId Только события, которые касаются пользователя (автор или ревьювер)
+	UserId *string `form:"user_id,omitempty" json:"user_id,omitempty"`
+
+	// TeamName Только события PR команды
+	TeamName *string `form:"team_name,omitempty" json:"team_name,omitempty"`
+
+	// LastEventID Продолжить поток после события с этим id
+	LastEventID *int64 `json:"Last-Event-ID,omitempty"`
+}
+
+// PostPullRequestCloseJSONBody defines parameters for PostPullRequestClose.
+type PostPullRequestCloseJSONBody struct {
+	PullRequestId string `json:"pull_request_id"`
+}
+
 // PostPullRequestCreateJSONBody defines parameters for PostPullRequestCreate.
 type PostPullRequestCreateJSONBody struct {
-	AuthorId        string `json:"author_id"`
+	AuthorId string `json:"author_id"`
+
+	// ChangedFiles Пути изменённых файлов. Владельцы путей по правилам команды PR (см. /team/owners) назначаются ревьюверами в первую очередь.
+	ChangedFiles *[]string `json:"changed_files,omitempty"`
+
+	// Draft Создать PR черновиком (DRAFT). Ревьюверы назначаются после /pullRequest/markReady.
+	Draft           *bool  `json:"draft,omitempty"`
 	PullRequestId   string `json:"pull_request_id"`
 	PullRequestName string `json:"pull_request_name"`
+
+	// TeamName Команда, для которой создаётся PR; автор должен в ней состоять. Обязательна, если автор состоит в нескольких командах.
+	TeamName *string `json:"team_name,omitempty"`
+}
+
+// GetPullRequestListParams defines parameters for GetPullRequestList.
+type GetPullRequestListParams struct {
+	// Status Только PR в этих статусах; параметр можно повторить
+	Status   *[]PullRequestStatus `form:"status,omitempty" json:"status,omitempty"`
+	AuthorId *string              `form:"author_id,omitempty" json:"author_id,omitempty"`
+
+	// ReviewerId Только PR, где пользователь - текущий ревьювер
+	ReviewerId *string `form:"reviewer_id,omitempty" json:"reviewer_id,omitempty"`
+	TeamName   *string `form:"team_name,omitempty" json:"team_name,omitempty"`
+
+	// CreatedFrom PR, созданные не раньше этого момента
+	CreatedFrom *time.Time `form:"created_from,omitempty" json:"created_from,omitempty"`
+
+	// CreatedTo PR, созданные раньше этого момента
+	CreatedTo *time.Time `form:"created_to,omitempty" json:"created_to,omitempty"`
+
+	// MergedFrom PR, смерженные не раньше этого момента
+	MergedFrom *time.Time `form:"merged_from,omitempty" json:"merged_from,omitempty"`
+
+	// MergedTo PR, смерженные раньше этого момента
+	MergedTo *time.Time `form:"merged_to,omitempty" json:"merged_to,omitempty"`
+	Cursor   *string    `form:"cursor,omitempty" json:"cursor,omitempty"`
+	Limit    *int       `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// PostPullRequestMarkReadyJSONBody defines parameters for PostPullRequestMarkReady.
+type PostPullRequestMarkReadyJSONBody struct {
+	PullRequestId string `json:"pull_request_id"`
 }
 
 // PostPullRequestMergeJSONBody defines parameters for PostPullRequestMerge.
@@ -155,22 +777,60 @@ type PostPullRequestMergeJSONBody struct {
 	PullRequestId string `json:"pull_request_id"`
 }
 
+// GetPullRequestOverdueParams defines parameters for GetPullRequestOverdue.
+type GetPullRequestOverdueParams struct {
+	// TeamName Только PR этой команды
+	TeamName *string `form:"team_name,omitempty" json:"team_name,omitempty"`
+}
+
 // PostPullRequestReassignJSONBody defines parameters for PostPullRequestReassign.
 type PostPullRequestReassignJSONBody struct {
 	OldUserId     string `json:"old_user_id"`
 	PullRequestId string `json:"pull_request_id"`
 }
 
+// PostPullRequestReopenJSONBody defines parameters for PostPullRequestReopen.
+type PostPullRequestReopenJSONBody struct {
+	PullRequestId string `json:"pull_request_id"`
+}
+
+// PostPullRequestReviewJSONBody defines parameters for PostPullRequestReview.
+type PostPullRequestReviewJSONBody struct {
+	Comment       *string `json:"comment,omitempty"`
+	PullRequestId string  `json:"pull_request_id"`
+	UserId        string  `json:"user_id"`
+
+	// Verdict Решение ревьювера. COMMENT не меняет предыдущее решение: при подсчёте одобрений учитывается последний APPROVE или REQUEST_CHANGES.
+	Verdict ReviewVerdict `json:"verdict"`
+}
+
 // GetTeamGetParams defines parameters for GetTeamGet.
 type GetTeamGetParams struct {
 	// TeamName Уникальное имя команды
 	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
 }
 
+// GetTeamOwnersParams defines parameters for GetTeamOwners.
+type GetTeamOwnersParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+}
+
+// GetUsersAvailabilityParams defines parameters for GetUsersAvailability.
+type GetUsersAvailabilityParams struct {
+	// UserId Идентификатор пользователя
+	UserId UserIdQuery `form:"user_id" json:"user_id"`
+}
+
 // GetUsersGetReviewParams defines parameters for GetUsersGetReview.
 type GetUsersGetReviewParams struct {
 	// UserId Идентификатор пользователя
 	UserId UserIdQuery `form:"user_id" json:"user_id"`
+
+	// Status Только PR в этих статусах; параметр можно повторить. По умолчанию - OPEN и REOPENED, то есть PR, которые ждут ревью
+	Status *[]PullRequestStatus `form:"status,omitempty" json:"status,omitempty"`
+	Cursor *string              `form:"cursor,omitempty" json:"cursor,omitempty"`
+	Limit  *int                 `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
 // PostUsersSetIsActiveJSONBody defines parameters for PostUsersSetIsActive.
@@ -179,17 +839,81 @@ type PostUsersSetIsActiveJSONBody struct {
 	UserId   string `json:"user_id"`
 }
 
+// GetWebhooksDeliveriesParams defines parameters for GetWebhooksDeliveries.
+type GetWebhooksDeliveriesParams struct {
+	SubscriptionId int64                  `form:"subscription_id" json:"subscription_id"`
+	Status         *WebhookDeliveryStatus `form:"status,omitempty" json:"status,omitempty"`
+	Limit          *int                   `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// PostPullRequestCloseJSONRequestBody defines body for PostPullRequestClose for application/json ContentType.
+type PostPullRequestCloseJSONRequestBody PostPullRequestCloseJSONBody
+
 // PostPullRequestCreateJSONRequestBody defines body for PostPullRequestCreate for application/json ContentType.
 type PostPullRequestCreateJSONRequestBody PostPullRequestCreateJSONBody
 
+// PostPullRequestMarkReadyJSONRequestBody defines body for PostPullRequestMarkReady for application/json ContentType.
+type PostPullRequestMarkReadyJSONRequestBody PostPullRequestMarkReadyJSONBody
+
 // PostPullRequestMergeJSONRequestBody defines body for PostPullRequestMerge for application/json ContentType.
 type PostPullRequestMergeJSONRequestBody PostPullRequestMergeJSONBody
 
 // PostPullRequestReassignJSONRequestBody defines body for PostPullRequestReassign for application/json ContentType.
 type PostPullRequestReassignJSONRequestBody PostPullRequestReassignJSONBody
 
+// PostPullRequestReopenJSONRequestBody defines body for PostPullRequestReopen for application/json ContentType.
+type PostPullRequestReopenJSONRequestBody PostPullRequestReopenJSONBody
+
+// PostPullRequestReviewJSONRequestBody defines body for PostPullRequestReview for application/json ContentType.
+type PostPullRequestReviewJSONRequestBody PostPullRequestReviewJSONBody
+
 // PostTeamAddJSONRequestBody defines body for PostTeamAdd for application/json ContentType.
 type PostTeamAddJSONRequestBody = Team
 
+// PostTeamAddMembersJSONRequestBody defines body for PostTeamAddMembers for application/json ContentType.
+type PostTeamAddMembersJSONRequestBody = AddTeamMembersRequest
+
+// PostTeamDeactivateUsersJSONRequestBody defines body for PostTeamDeactivateUsers for application/json ContentType.
+type PostTeamDeactivateUsersJSONRequestBody = DeactivateUsersRequest
+
+// PostTeamDeleteJSONRequestBody defines body for PostTeamDelete for application/json ContentType.
+type PostTeamDeleteJSONRequestBody = DeleteTeamRequest
+
+// PostTeamMoveMemberJSONRequestBody defines body for PostTeamMoveMember for application/json ContentType.
+type PostTeamMoveMemberJSONRequestBody = MoveTeamMemberRequest
+
+// PostTeamOwnersJSONRequestBody defines body for PostTeamOwners for application/json ContentType.
+type PostTeamOwnersJSONRequestBody = TeamOwners
+
+// PostTeamRemoveMemberJSONRequestBody defines body for PostTeamRemoveMember for application/json ContentType.
+type PostTeamRemoveMemberJSONRequestBody = RemoveTeamMemberRequest
+
+// PostTeamRenameJSONRequestBody defines body for PostTeamRename for application/json ContentType.
+type PostTeamRenameJSONRequestBody = RenameTeamRequest
+
+// PostTeamSetFallbacksJSONRequestBody defines body for PostTeamSetFallbacks for application/json ContentType.
+type PostTeamSetFallbacksJSONRequestBody = TeamFallbacks
+
+// PostTeamSetMemberRoleJSONRequestBody defines body for PostTeamSetMemberRole for application/json ContentType.
+type PostTeamSetMemberRoleJSONRequestBody = SetTeamMemberRoleRequest
+
+// PostTeamSetSettingsJSONRequestBody defines body for PostTeamSetSettings for application/json ContentType.
+type PostTeamSetSettingsJSONRequestBody = TeamSettings
+
+// PostUsersAvailabilityJSONRequestBody defines body for PostUsersAvailability for application/json ContentType.
+type PostUsersAvailabilityJSONRequestBody = CreateUnavailabilityRequest
+
+// PostUsersAvailabilityDeleteJSONRequestBody defines body for PostUsersAvailabilityDelete for application/json ContentType.
+type PostUsersAvailabilityDeleteJSONRequestBody = DeleteUnavailabilityRequest
+
 // PostUsersSetIsActiveJSONRequestBody defines body for PostUsersSetIsActive for application/json ContentType.
 type PostUsersSetIsActiveJSONRequestBody PostUsersSetIsActiveJSONBody
+
+// PostUsersSetWorkingHoursJSONRequestBody defines body for PostUsersSetWorkingHours for application/json ContentType.
+type PostUsersSetWorkingHoursJSONRequestBody = SetWorkingHoursRequest
+
+// PostWebhooksDeleteJSONRequestBody defines body for PostWebhooksDelete for application/json ContentType.
+type PostWebhooksDeleteJSONRequestBody = DeleteWebhookSubscriptionRequest
+
+// PostWebhooksSubscribeJSONRequestBody defines body for PostWebhooksSubscribe for application/json ContentType.
+type PostWebhooksSubscribeJSONRequestBody = CreateWebhookSubscriptionRequest
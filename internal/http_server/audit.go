@@ -0,0 +1,45 @@
+package http_server
+
+import (
+	"net/http"
+
+	"pr-manager-service/internal/domain"
+	"pr-manager-service/internal/generated/api"
+
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+const defaultAuditLogLimit = 50
+
+// Журнал аудита изменяющих операций
+// (GET /audit)
+func (h *HttpServer) GetAudit(c *gin.Context, params api.GetAuditParams) {
+	domainRequest := domain.GetAuditLogRequest{
+		TargetType: domain.AuditTargetType(lo.FromPtr(params.TargetType)),
+		TargetID:   lo.FromPtr(params.TargetId),
+		Actor:      lo.FromPtr(params.Actor),
+		From:       params.From,
+		To:         params.To,
+		Cursor:     lo.FromPtr(params.Cursor),
+		Limit:      lo.FromPtrOr(params.Limit, defaultAuditLogLimit),
+	}
+
+	if err := h.validator.Struct(domainRequest); err != nil {
+		handleValidationError(c, err, WithRequest(params))
+		return
+	}
+
+	page, err := h.usecases.GetAuditLog(c.Request.Context(), domainRequest)
+	if err != nil {
+		handleUsecaseError(c, err, WithRequest(params))
+		return
+	}
+
+	c.JSON(http.StatusOK, api.AuditLogResponse{
+		Entries: lo.Map(page.Entries, func(entry domain.AuditEntry, _ int) api.AuditEntry {
+			return domain.ConvertAuditEntry(entry)
+		}),
+		NextCursor: lo.EmptyableToPtr(page.NextCursor),
+	})
+}
@@ -0,0 +1,93 @@
+package http_server
+
+import (
+	"net/http"
+
+	"pr-manager-service/internal/domain"
+	"pr-manager-service/internal/generated/api"
+
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+// Получить окна отсутствия пользователя
+// (GET /users/availability)
+func (h *HttpServer) GetUsersAvailability(c *gin.Context, params api.GetUsersAvailabilityParams) {
+	if err := h.validator.Var(params.UserId, idValidationRules); err != nil {
+		handleValidationError(c, err, WithUserID(params.UserId))
+		return
+	}
+
+	available, windows, err := h.usecases.GetUserAvailability(c.Request.Context(), params.UserId)
+	if err != nil {
+		handleUsecaseError(c, err, WithUserID(params.UserId))
+		return
+	}
+
+	c.JSON(http.StatusOK, api.UserAvailabilityResponse{
+		UserId:      params.UserId,
+		IsAvailable: available,
+		Windows: lo.Map(windows, func(window domain.Unavailability, _ int) api.UnavailabilityWindow {
+			return domain.ConvertUnavailability(window)
+		}),
+	})
+}
+
+// Добавить окно отсутствия пользователя
+// (POST /users/availability)
+func (h *HttpServer) PostUsersAvailability(c *gin.Context) {
+	apiRequest := api.CreateUnavailabilityRequest{}
+	if err := c.ShouldBindJSON(&apiRequest); err != nil {
+		handleParsingError(c, err)
+		return
+	}
+
+	domainRequest := domain.CreateUnavailabilityRequest{
+		UserID:   apiRequest.UserId,
+		StartsAt: apiRequest.StartsAt,
+		EndsAt:   apiRequest.EndsAt,
+		Reason:   lo.FromPtr(apiRequest.Reason),
+	}
+
+	if err := h.validator.Struct(domainRequest); err != nil {
+		handleValidationError(c, err, WithRequest(apiRequest))
+		return
+	}
+
+	window, err := h.usecases.CreateUnavailability(c.Request.Context(), domainRequest)
+	if err != nil {
+		handleUsecaseError(c, err, WithRequest(apiRequest))
+		return
+	}
+
+	c.JSON(http.StatusCreated, api.UnavailabilityWindowResponse{
+		Window: domain.ConvertUnavailability(window),
+	})
+}
+
+// Удалить окно отсутствия пользователя
+// (POST /users/availability/delete)
+func (h *HttpServer) PostUsersAvailabilityDelete(c *gin.Context) {
+	apiRequest := api.DeleteUnavailabilityRequest{}
+	if err := c.ShouldBindJSON(&apiRequest); err != nil {
+		handleParsingError(c, err)
+		return
+	}
+
+	domainRequest := domain.DeleteUnavailabilityRequest{
+		UserID: apiRequest.UserId,
+		ID:     apiRequest.Id,
+	}
+
+	if err := h.validator.Struct(domainRequest); err != nil {
+		handleValidationError(c, err, WithRequest(apiRequest))
+		return
+	}
+
+	if err := h.usecases.DeleteUnavailability(c.Request.Context(), domainRequest); err != nil {
+		handleUsecaseError(c, err, WithRequest(apiRequest))
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,97 @@
+package http_server
+
+import (
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"pr-manager-service/internal/domain"
+	"pr-manager-service/internal/generated/api"
+
+	"github.com/gin-contrib/sse"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+// heartbeatComment - SSE-комментарий: клиенты его игнорируют, а прокси не закрывают соединение по простою
+const heartbeatComment = ": heartbeat\n\n"
+
+// Поток событий назначений (Server-Sent Events)
+// (GET /events/stream)
+func (h *HttpServer) GetEventsStream(c *gin.Context, params api.GetEventsStreamParams) {
+	request := domain.OpenEventStreamRequest{
+		Filter: domain.EventStreamFilter{
+			UserID:   lo.FromPtr(params.UserId),
+			TeamName: lo.FromPtr(params.TeamName),
+		},
+		LastEventID: params.LastEventID,
+	}
+
+	if err := h.validator.Struct(request); err != nil {
+		handleValidationError(c, err, WithRequest(request))
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	afterSeq, err := h.usecases.OpenEventStream(ctx, request)
+	if err != nil {
+		handleUsecaseError(c, err, WithRequest(request))
+		return
+	}
+
+	c.Header("Content-Type", sse.ContentType)
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	// NOTE: отключает буферизацию ответа в nginx
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+	c.Writer.Flush()
+
+	poll := time.NewTicker(h.streamPollInterval)
+	defer poll.Stop()
+
+	heartbeat := time.NewTicker(h.streamHeartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-h.streamsClosed:
+			return
+		case <-heartbeat.C:
+			if _, err := io.WriteString(c.Writer, heartbeatComment); err != nil {
+				return
+			}
+			c.Writer.Flush()
+		case <-poll.C:
+			// NOTE: за один тик отдаются все накопившиеся события, а не одна порция
+			for {
+				events, err := h.usecases.GetStreamEvents(ctx, request.Filter, afterSeq)
+				if err != nil {
+					if ctx.Err() == nil {
+						slog.Error("event stream", joinAttrs(err, WithRequest(request), slog.Int64("after_seq", afterSeq))...)
+					}
+					return
+				}
+
+				if len(events) == 0 {
+					break
+				}
+
+				for _, event := range events {
+					c.Render(-1, sse.Event{
+						Id:    strconv.FormatInt(event.Seq, 10),
+						Event: string(event.Event.Type),
+						Data:  event.Event,
+					})
+					afterSeq = event.Seq
+				}
+				c.Writer.Flush()
+			}
+		}
+	}
+}
@@ -27,6 +27,15 @@ func WithUserID(userID string) slog.Attr {
 	return slog.String("user_id", userID)
 }
 
+func WithPullRequestID(prID string) slog.Attr {
+	return slog.String("pull_request_id", prID)
+}
+
+// WithWebhookURL логирует адрес подписки без секрета из тела запроса.
+func WithWebhookURL(url string) slog.Attr {
+	return slog.String("webhook_url", url)
+}
+
 func joinAttrs(err error, logAttrs ...slog.Attr) []any {
 	attrs := make([]any, 0, len(logAttrs)+1)
 
@@ -74,6 +83,21 @@ func validationErrorToText(fe validator.FieldError) string {
 	case "max":
 		return fmt.Sprintf("field %s must be at most %s", field, fe.Param())
 
+	case "gtefield":
+		return fmt.Sprintf("field %s must be greater than or equal to %s", field, fe.Param())
+
+	case "ltefield":
+		return fmt.Sprintf("field %s must be less than or equal to %s", field, fe.Param())
+
+	case "nefield":
+		return fmt.Sprintf("field %s must differ from %s", field, fe.Param())
+
+	case "unique":
+		return fmt.Sprintf("field %s must contain unique values", field)
+
+	case "oneof":
+		return fmt.Sprintf("field %s must be one of: %s", field, fe.Param())
+
 	default:
 		return fmt.Sprintf("field %s is invalid", field)
 	}
@@ -115,6 +139,16 @@ func handleUsecaseError(c *gin.Context, err error, logAttrs ...slog.Attr) {
 		httpCode = http.StatusNotFound
 		errorResp = errorResponse(api.NOTFOUND, domain.ErrUserNotFound.Error())
 
+	case errors.Is(err, domain.ErrUnavailabilityNotFound):
+		logMessage = "unavailability window not found"
+		httpCode = http.StatusNotFound
+		errorResp = errorResponse(api.NOTFOUND, domain.ErrUnavailabilityNotFound.Error())
+
+	case errors.Is(err, domain.ErrWebhookSubscriptionNotFound):
+		logMessage = "webhook subscription not found"
+		httpCode = http.StatusNotFound
+		errorResp = errorResponse(api.NOTFOUND, domain.ErrWebhookSubscriptionNotFound.Error())
+
 	case errors.Is(err, domain.ErrPullRequestNotFound):
 		logMessage = "PR not found"
 		httpCode = http.StatusNotFound
@@ -130,6 +164,36 @@ func handleUsecaseError(c *gin.Context, err error, logAttrs ...slog.Attr) {
 		httpCode = http.StatusConflict
 		errorResp = errorResponse(api.PRMERGED, domain.ErrPRMerged.Error())
 
+	case errors.Is(err, domain.ErrPRNotActive):
+		logMessage = "PR is draft or closed"
+		httpCode = http.StatusConflict
+		errorResp = errorResponse(api.PRNOTACTIVE, domain.ErrPRNotActive.Error())
+
+	case errors.Is(err, domain.ErrInvalidTransition):
+		logMessage = "invalid PR status transition"
+		httpCode = http.StatusConflict
+		errorResp = errorResponse(api.INVALIDTRANSITION, domain.ErrInvalidTransition.Error())
+
+	case errors.Is(err, domain.ErrNotEnoughApprovals):
+		logMessage = "not enough approvals to merge"
+		httpCode = http.StatusConflict
+		errorResp = errorResponse(api.NOTENOUGHAPPROVALS, domain.ErrNotEnoughApprovals.Error())
+
+	case errors.Is(err, domain.ErrAlreadyInTeam):
+		logMessage = "user is already a member of this team"
+		httpCode = http.StatusConflict
+		errorResp = errorResponse(api.ALREADYINTEAM, domain.ErrAlreadyInTeam.Error())
+
+	case errors.Is(err, domain.ErrAmbiguousTeam):
+		logMessage = "team is ambiguous"
+		httpCode = http.StatusBadRequest
+		errorResp = errorResponse(api.AMBIGUOUSTEAM, domain.ErrAmbiguousTeam.Error())
+
+	case errors.Is(err, domain.ErrTeamNotEmpty):
+		logMessage = "team has members"
+		httpCode = http.StatusConflict
+		errorResp = errorResponse(api.TEAMNOTEMPTY, domain.ErrTeamNotEmpty.Error())
+
 	case errors.Is(err, domain.ErrNotAssigned):
 		logMessage = "reviewer not assigned"
 		httpCode = http.StatusConflict
@@ -140,6 +204,31 @@ func handleUsecaseError(c *gin.Context, err error, logAttrs ...slog.Attr) {
 		httpCode = http.StatusConflict
 		errorResp = errorResponse(api.NOCANDIDATE, domain.ErrNoCandidate.Error())
 
+	case errors.Is(err, domain.ErrReviewersAtCapacity):
+		logMessage = "all candidates reached open reviews limit"
+		httpCode = http.StatusConflict
+		errorResp = errorResponse(api.REVIEWERSATCAPACITY, domain.ErrReviewersAtCapacity.Error())
+
+	case errors.Is(err, domain.ErrSelfFallback):
+		logMessage = "team cannot be its own fallback"
+		httpCode = http.StatusBadRequest
+		errorResp = errorResponse(api.VALIDATIONERR, domain.ErrSelfFallback.Error())
+
+	case errors.Is(err, domain.ErrApprovalsExceedReviewers):
+		logMessage = "required approvals exceed max reviewers"
+		httpCode = http.StatusBadRequest
+		errorResp = errorResponse(api.VALIDATIONERR, domain.ErrApprovalsExceedReviewers.Error())
+
+	case errors.Is(err, domain.ErrInvalidCursor):
+		logMessage = "invalid cursor"
+		httpCode = http.StatusBadRequest
+		errorResp = errorResponse(api.VALIDATIONERR, domain.ErrInvalidCursor.Error())
+
+	case errors.Is(err, domain.ErrInvalidOwnerPattern):
+		logMessage = "invalid owner rule pattern"
+		httpCode = http.StatusBadRequest
+		errorResp = errorResponse(api.VALIDATIONERR, domain.ErrInvalidOwnerPattern.Error())
+
 	case errors.As(err, &errNotInTeam):
 		logMessage = "users not in team"
 		httpCode = 400
@@ -150,6 +239,11 @@ func handleUsecaseError(c *gin.Context, err error, logAttrs ...slog.Attr) {
 			slog.Any("team_name", errNotInTeam.TeamName),
 		)
 
+	case errors.Is(err, domain.ErrForbidden):
+		logMessage = "operation is not allowed for caller"
+		httpCode = http.StatusForbidden
+		errorResp = errorResponse(api.FORBIDDEN, domain.ErrForbidden.Error())
+
 	case errors.Is(err, domain.ErrUserInactive):
 		logMessage = "inactive user cannot create a pull request"
 		httpCode = http.StatusForbidden
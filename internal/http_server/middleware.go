@@ -0,0 +1,85 @@
+package http_server
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"pr-manager-service/internal/domain"
+	"pr-manager-service/internal/generated/api"
+	"pr-manager-service/internal/reqctx"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+const (
+	// RequestIDHeader - идентификатор запроса; если клиент его не передал, сервис генерирует новый
+	RequestIDHeader = "X-Request-ID"
+
+	maxRequestIDLength = 255
+
+	bearerPrefix = "Bearer "
+)
+
+var errMissingToken = errors.New("missing bearer token")
+
+type authenticator interface {
+	Authenticate(token string) (domain.Caller, error)
+}
+
+// RequestContext кладёт в контекст запроса его идентификатор, по нему записи аудита связываются
+// с запросом. Идентификатор запроса возвращается в ответе.
+func RequestContext() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		requestID := c.GetHeader(RequestIDHeader)
+		if requestID == "" || len(requestID) > maxRequestIDLength {
+			requestID = uuid.NewString()
+		}
+
+		c.Request = c.Request.WithContext(reqctx.WithRequestID(c.Request.Context(), requestID))
+		c.Header(RequestIDHeader, requestID)
+
+		c.Next()
+	}
+}
+
+// Authenticate проверяет bearer-токен из заголовка Authorization и кладёт вызывающего в контекст запроса.
+// Какие токены принимает операция, задаёт security в OpenAPI: сгенерированный сервер отмечает
+// допустимые схемы в контексте gin до вызова middleware.
+func Authenticate(authenticator authenticator) api.MiddlewareFunc {
+	return func(c *gin.Context) {
+		_, adminAllowed := c.Get(api.AdminTokenScopes)
+		_, userAllowed := c.Get(api.UserTokenScopes)
+
+		if !adminAllowed && !userAllowed {
+			return
+		}
+
+		token, ok := strings.CutPrefix(c.GetHeader("Authorization"), bearerPrefix)
+		if !ok || token == "" {
+			abortUnauthorized(c, errMissingToken)
+			return
+		}
+
+		caller, err := authenticator.Authenticate(token)
+		if err != nil {
+			abortUnauthorized(c, err)
+			return
+		}
+
+		if (caller.Admin && !adminAllowed) || (!caller.Admin && !userAllowed) {
+			slog.Info("forbidden", slog.String("actor", caller.Actor()), slog.String("path", c.FullPath()))
+			c.AbortWithStatusJSON(http.StatusForbidden, errorResponse(api.FORBIDDEN, "operation requires admin token"))
+			return
+		}
+
+		c.Request = c.Request.WithContext(reqctx.WithCaller(c.Request.Context(), caller))
+	}
+}
+
+func abortUnauthorized(c *gin.Context, err error) {
+	slog.Info("unauthorized", slog.String("path", c.FullPath()), slog.Any("error", err))
+	c.AbortWithStatusJSON(http.StatusUnauthorized, errorResponse(api.UNAUTHORIZED, err.Error()))
+}
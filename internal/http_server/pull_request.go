@@ -1,6 +1,7 @@
 package http_server
 
 import (
+	"context"
 	"errors"
 	"net/http"
 
@@ -8,14 +9,17 @@ import (
 	"pr-manager-service/internal/generated/api"
 
 	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
 )
 
 const (
 	idValidationRules   = "required,min=1,max=36"
 	nameValidationRules = "required,min=2,max=50"
+
+	defaultPullRequestListLimit = 50
 )
 
-// Создать PR и автоматически назначить до 2 ревьюверов из команды автора
+// Создать PR и автоматически назначить ревьюверов из команды автора
 // (POST /pullRequest/create)
 func (h *HttpServer) PostPullRequestCreate(c *gin.Context) {
 	apiRequest := api.PostPullRequestCreateJSONBody{}
@@ -28,6 +32,9 @@ func (h *HttpServer) PostPullRequestCreate(c *gin.Context) {
 		AuthorUserID: apiRequest.AuthorId,
 		Name:         apiRequest.PullRequestName,
 		ID:           apiRequest.PullRequestId,
+		TeamName:     lo.FromPtr(apiRequest.TeamName),
+		ChangedFiles: lo.FromPtr(apiRequest.ChangedFiles),
+		Draft:        lo.FromPtr(apiRequest.Draft),
 	}
 
 	if err := h.validator.Struct(domainRequest); err != nil {
@@ -71,6 +78,96 @@ func (h *HttpServer) PostPullRequestMerge(c *gin.Context) {
 	})
 }
 
+// Закрыть PR без мержа (DRAFT/OPEN/REOPENED -> CLOSED)
+// (POST /pullRequest/close)
+func (h *HttpServer) PostPullRequestClose(c *gin.Context) {
+	request := api.PostPullRequestCloseJSONBody{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		handleParsingError(c, err)
+		return
+	}
+
+	h.changePullRequestStatus(c, request.PullRequestId, h.usecases.ClosePullRequest)
+}
+
+// Переоткрыть закрытый PR (CLOSED -> REOPENED)
+// (POST /pullRequest/reopen)
+func (h *HttpServer) PostPullRequestReopen(c *gin.Context) {
+	request := api.PostPullRequestReopenJSONBody{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		handleParsingError(c, err)
+		return
+	}
+
+	h.changePullRequestStatus(c, request.PullRequestId, h.usecases.ReopenPullRequest)
+}
+
+// Перевести черновик в работу и назначить ревьюверов (DRAFT -> OPEN)
+// (POST /pullRequest/markReady)
+func (h *HttpServer) PostPullRequestMarkReady(c *gin.Context) {
+	request := api.PostPullRequestMarkReadyJSONBody{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		handleParsingError(c, err)
+		return
+	}
+
+	h.changePullRequestStatus(c, request.PullRequestId, h.usecases.MarkPullRequestReady)
+}
+
+func (h *HttpServer) changePullRequestStatus(
+	c *gin.Context,
+	prID string,
+	change func(ctx context.Context, prID string) (domain.PullRequest, error),
+) {
+	if err := h.validator.Var(prID, idValidationRules); err != nil {
+		handleValidationError(c, err, WithPullRequestID(prID))
+		return
+	}
+
+	pullRequestDomain, err := change(c.Request.Context(), prID)
+	if err != nil {
+		handleUsecaseError(c, err, WithPullRequestID(prID))
+		return
+	}
+
+	c.JSON(http.StatusOK, api.PullRequestResponse{
+		Pr: domain.ConvertPullRequest(pullRequestDomain),
+	})
+}
+
+// Оставить решение ревьювера по PR
+// (POST /pullRequest/review)
+func (h *HttpServer) PostPullRequestReview(c *gin.Context) {
+	apiRequest := api.PostPullRequestReviewJSONBody{}
+	if err := c.ShouldBindJSON(&apiRequest); err != nil {
+		handleParsingError(c, err)
+		return
+	}
+
+	domainRequest := domain.SubmitReviewRequest{
+		PullRequestID: apiRequest.PullRequestId,
+		UserID:        apiRequest.UserId,
+		Verdict:       domain.ReviewVerdict(apiRequest.Verdict),
+		Comment:       lo.FromPtr(apiRequest.Comment),
+	}
+
+	if err := h.validator.Struct(domainRequest); err != nil {
+		handleValidationError(c, err, WithRequest(apiRequest))
+		return
+	}
+
+	review, approvals, err := h.usecases.SubmitReview(c.Request.Context(), domainRequest)
+	if err != nil {
+		handleUsecaseError(c, err, WithRequest(apiRequest))
+		return
+	}
+
+	c.JSON(http.StatusOK, api.SubmitReviewResponse{
+		Review:    domain.ConvertReview(review),
+		Approvals: approvals,
+	})
+}
+
 // Переназначить конкретного ревьювера на другого из его команды
 // (POST /pullRequest/reassign)
 func (h *HttpServer) PostPullRequestReassign(c *gin.Context) {
@@ -103,3 +200,62 @@ func (h *HttpServer) PostPullRequestReassign(c *gin.Context) {
 		ReplacedBy: newReviewerID,
 	})
 }
+
+// Получить PR, ревью которых просрочено
+// (GET /pullRequest/overdue)
+func (h *HttpServer) GetPullRequestOverdue(c *gin.Context, params api.GetPullRequestOverdueParams) {
+	teamName := lo.FromPtr(params.TeamName)
+	if teamName != "" {
+		if err := h.validator.Var(teamName, nameValidationRules); err != nil {
+			handleValidationError(c, err, WithTeamName(teamName))
+			return
+		}
+	}
+
+	pullRequests, err := h.usecases.GetOverduePullRequests(c.Request.Context(), teamName)
+	if err != nil {
+		handleUsecaseError(c, err, WithTeamName(teamName))
+		return
+	}
+
+	c.JSON(http.StatusOK, api.OverduePullRequestsResponse{
+		PullRequests: lo.Map(pullRequests, func(pr domain.PullRequest, _ int) api.PullRequest {
+			return domain.ConvertPullRequest(pr)
+		}),
+	})
+}
+
+// Получить список PR с фильтрами
+// (GET /pullRequest/list)
+func (h *HttpServer) GetPullRequestList(c *gin.Context, params api.GetPullRequestListParams) {
+	domainRequest := domain.ListPullRequestsRequest{
+		Statuses:    lo.FromPtr(params.Status),
+		AuthorID:    lo.FromPtr(params.AuthorId),
+		ReviewerID:  lo.FromPtr(params.ReviewerId),
+		TeamName:    lo.FromPtr(params.TeamName),
+		CreatedFrom: params.CreatedFrom,
+		CreatedTo:   params.CreatedTo,
+		MergedFrom:  params.MergedFrom,
+		MergedTo:    params.MergedTo,
+		Cursor:      lo.FromPtr(params.Cursor),
+		Limit:       lo.FromPtrOr(params.Limit, defaultPullRequestListLimit),
+	}
+
+	if err := h.validator.Struct(domainRequest); err != nil {
+		handleValidationError(c, err, WithRequest(params))
+		return
+	}
+
+	page, err := h.usecases.ListPullRequests(c.Request.Context(), domainRequest)
+	if err != nil {
+		handleUsecaseError(c, err, WithRequest(params))
+		return
+	}
+
+	c.JSON(http.StatusOK, api.PullRequestListResponse{
+		PullRequests: lo.Map(page.PullRequests, func(pr domain.PullRequest, _ int) api.PullRequest {
+			return domain.ConvertPullRequest(pr)
+		}),
+		NextCursor: lo.EmptyableToPtr(page.NextCursor),
+	})
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"reflect"
 	"strings"
+	"sync"
+	"time"
 
 	"pr-manager-service/internal/domain"
 	"pr-manager-service/internal/generated/api"
@@ -12,21 +14,71 @@ import (
 )
 
 type usecases interface {
-	GetPullRequestsByReviewer(ctx context.Context, userID string) ([]domain.PullRequest, error)
-	UpdateUserStatus(ctx context.Context, userID string, isActive bool) (domain.User, domain.Team, error)
+	GetUserReviews(ctx context.Context, request domain.GetUserReviewsRequest) (domain.UserReviewsPage, error)
+	UpdateUserStatus(ctx context.Context, userID string, isActive bool) (
+		domain.User,
+		domain.ReassignmentResult,
+		error,
+	)
+	SetUserWorkingHours(ctx context.Context, request domain.SetWorkingHoursRequest) (domain.User, error)
+	CreateUnavailability(ctx context.Context, request domain.CreateUnavailabilityRequest) (domain.Unavailability, error)
+	GetUserAvailability(ctx context.Context, userID string) (bool, []domain.Unavailability, error)
+	DeleteUnavailability(ctx context.Context, request domain.DeleteUnavailabilityRequest) error
 
-	CreateTeam(ctx context.Context, team domain.CreateTeamRequest) error
+	CreateTeam(ctx context.Context, team domain.CreateTeamRequest) (domain.Team, []domain.User, error)
 	GetTeamFullByName(ctx context.Context, teamName string) (domain.Team, []domain.User, error)
+	UpdateTeamSettings(ctx context.Context, request domain.UpdateTeamSettingsRequest) (domain.Team, error)
+	SetTeamFallbacks(ctx context.Context, request domain.SetTeamFallbacksRequest) ([]domain.Team, error)
+	GetTeamFallbacks(ctx context.Context, teamID string) ([]domain.Team, error)
+	SetTeamOwners(ctx context.Context, request domain.SetTeamOwnersRequest) ([]domain.OwnerRule, error)
+	GetTeamOwners(ctx context.Context, teamName string) ([]domain.OwnerRule, error)
+	DeactivateTeamUsers(ctx context.Context, request domain.DeactivateUsersRequest) (domain.DeactivateUsersResult, error)
+	AddTeamMembers(ctx context.Context, request domain.AddTeamMembersRequest) (domain.Team, []domain.User, error)
+	RemoveTeamMember(ctx context.Context, request domain.RemoveTeamMemberRequest) (
+		domain.User,
+		domain.ReassignmentResult,
+		error,
+	)
+	MoveTeamMember(ctx context.Context, request domain.MoveTeamMemberRequest) (
+		domain.User,
+		domain.ReassignmentResult,
+		error,
+	)
+	SetTeamMemberRole(ctx context.Context, request domain.SetTeamMemberRoleRequest) (domain.Team, []domain.User, error)
+	RenameTeam(ctx context.Context, request domain.RenameTeamRequest) (domain.Team, []domain.User, error)
+	DeleteTeam(ctx context.Context, request domain.DeleteTeamRequest) error
 
 	CreatePullRequest(ctx context.Context, pr domain.CreatePullRequestRequest) (domain.PullRequest, error)
 	MergePullRequest(ctx context.Context, prID string) (domain.PullRequest, error)
+	MarkPullRequestReady(ctx context.Context, prID string) (domain.PullRequest, error)
+	ClosePullRequest(ctx context.Context, prID string) (domain.PullRequest, error)
+	ReopenPullRequest(ctx context.Context, prID string) (domain.PullRequest, error)
+	GetOverduePullRequests(ctx context.Context, teamName string) ([]domain.PullRequest, error)
+	ListPullRequests(ctx context.Context, request domain.ListPullRequestsRequest) (domain.PullRequestPage, error)
 	ReassignPullRequest(ctx context.Context, prID, oldUserID string) (
 		pr domain.PullRequest,
 		newReviewerID string,
 		err error,
 	)
+	SubmitReview(ctx context.Context, request domain.SubmitReviewRequest) (domain.Review, int, error)
 
 	GetStats(ctx context.Context) ([]domain.UserStats, []domain.PullRequestStats, error)
+
+	CreateWebhookSubscription(
+		ctx context.Context,
+		request domain.CreateWebhookSubscriptionRequest,
+	) (domain.WebhookSubscription, error)
+	GetWebhookSubscriptions(ctx context.Context) ([]domain.WebhookSubscription, error)
+	DeleteWebhookSubscription(ctx context.Context, request domain.DeleteWebhookSubscriptionRequest) error
+	GetWebhookDeliveries(
+		ctx context.Context,
+		request domain.GetWebhookDeliveriesRequest,
+	) ([]domain.WebhookDelivery, error)
+
+	OpenEventStream(ctx context.Context, request domain.OpenEventStreamRequest) (int64, error)
+	GetStreamEvents(ctx context.Context, filter domain.EventStreamFilter, afterSeq int64) ([]domain.StreamEvent, error)
+
+	GetAuditLog(ctx context.Context, request domain.GetAuditLogRequest) (domain.AuditLogPage, error)
 }
 
 var _ api.ServerInterface = (*HttpServer)(nil)
@@ -34,15 +86,53 @@ var _ api.ServerInterface = (*HttpServer)(nil)
 type HttpServer struct {
 	usecases  usecases
 	validator *validator.Validate
+
+	streamPollInterval      time.Duration
+	streamHeartbeatInterval time.Duration
+	// streamsClosed закрывается при остановке сервера, чтобы завершить открытые потоки событий
+	streamsClosed chan struct{}
+	closeOnce     sync.Once
 }
 
-func NewHttpServer(usecases usecases) *HttpServer {
-	return &HttpServer{
-		usecases:  usecases,
-		validator: NewValidator(),
+type Option func(h *HttpServer)
+
+// WithEventStreamIntervals задаёт, как часто поток событий проверяет новые события и отправляет heartbeat.
+// Неположительный интервал оставляет значение по умолчанию.
+func WithEventStreamIntervals(poll, heartbeat time.Duration) Option {
+	return func(h *HttpServer) {
+		if poll > 0 {
+			h.streamPollInterval = poll
+		}
+		if heartbeat > 0 {
+			h.streamHeartbeatInterval = heartbeat
+		}
 	}
 }
 
+func NewHttpServer(usecases usecases, opts ...Option) *HttpServer {
+	h := &HttpServer{
+		usecases:                usecases,
+		validator:               NewValidator(),
+		streamPollInterval:      time.Second,
+		streamHeartbeatInterval: 15 * time.Second,
+		streamsClosed:           make(chan struct{}),
+	}
+
+	for _, opt := range opts {
+		opt(h)
+	}
+
+	return h
+}
+
+// CloseStreams завершает открытые потоки событий. http.Server.Shutdown не прерывает активные запросы,
+// поэтому без этого остановка сервера ждала бы, пока клиенты потоков отключатся сами.
+func (h *HttpServer) CloseStreams() {
+	h.closeOnce.Do(func() {
+		close(h.streamsClosed)
+	})
+}
+
 func NewValidator() *validator.Validate {
 	v := validator.New()
 
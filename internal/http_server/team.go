@@ -1,11 +1,13 @@
 package http_server
 
 import (
+	"context"
 	"net/http"
 	"pr-manager-service/internal/domain"
 	"pr-manager-service/internal/generated/api"
 
 	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
 )
 
 // Создать команду с участниками (создаёт/обновляет пользователей)
@@ -18,8 +20,14 @@ func (h *HttpServer) PostTeamAdd(c *gin.Context) {
 	}
 
 	domainRequest := domain.CreateTeamRequest{
-		Name:    apiRequest.TeamName,
-		Members: make([]domain.CreateUserRequest, 0, len(apiRequest.Members)),
+		Name:               apiRequest.TeamName,
+		Members:            make([]domain.CreateUserRequest, 0, len(apiRequest.Members)),
+		ReviewerStrategy:   domain.ConvertReviewerStrategyToDomain(apiRequest.ReviewerStrategy),
+		MinReviewers:       lo.FromPtrOr(apiRequest.MinReviewers, domain.DefaultMinReviewers),
+		MaxReviewers:       lo.FromPtrOr(apiRequest.MaxReviewers, domain.DefaultMaxReviewers),
+		RequiredApprovals:  lo.FromPtr(apiRequest.RequiredApprovals),
+		PreferWorkingHours: lo.FromPtr(apiRequest.PreferWorkingHours),
+		ReviewSLAHours:     lo.FromPtr(apiRequest.ReviewSlaHours),
 	}
 
 	for _, member := range apiRequest.Members {
@@ -27,6 +35,7 @@ func (h *HttpServer) PostTeamAdd(c *gin.Context) {
 			ID:       member.UserId,
 			Name:     member.Username,
 			IsActive: member.IsActive,
+			Role:     domain.ConvertTeamRoleToDomain(member.Role),
 		})
 	}
 
@@ -35,13 +44,21 @@ func (h *HttpServer) PostTeamAdd(c *gin.Context) {
 		return
 	}
 
-	if err := h.usecases.CreateTeam(c.Request.Context(), domainRequest); err != nil {
+	team, users, err := h.usecases.CreateTeam(c.Request.Context(), domainRequest)
+	if err != nil {
+		handleUsecaseError(c, err, WithRequest(apiRequest))
+		return
+	}
+
+	// NOTE: в ответе команда из базы, чтобы клиент видел применённые настройки по умолчанию
+	response, err := h.convertTeam(c.Request.Context(), team, users)
+	if err != nil {
 		handleUsecaseError(c, err, WithRequest(apiRequest))
 		return
 	}
 
 	c.JSON(http.StatusCreated, api.TeamAddResponse{
-		Team: apiRequest,
+		Team: response,
 	})
 }
 
@@ -59,18 +76,220 @@ func (h *HttpServer) GetTeamGet(c *gin.Context, params api.GetTeamGetParams) {
 		return
 	}
 
+	response, err := h.convertTeam(c.Request.Context(), team, users)
+	if err != nil {
+		handleUsecaseError(c, err, WithTeamName(params.TeamName))
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// convertTeam собирает команду с участниками и резервными командами для ответа.
+func (h *HttpServer) convertTeam(ctx context.Context, team domain.Team, users []domain.User) (api.Team, error) {
 	response := api.Team{
-		TeamName: team.Name,
-		Members:  make([]api.TeamMember, 0, len(users)),
+		TeamName:           team.Name,
+		Members:            make([]api.TeamMember, 0, len(users)),
+		ReviewerStrategy:   domain.ConvertReviewerStrategyToApi(team.ReviewerStrategy),
+		MinReviewers:       lo.ToPtr(team.MinReviewers),
+		MaxReviewers:       lo.ToPtr(team.MaxReviewers),
+		RequiredApprovals:  lo.ToPtr(team.RequiredApprovals),
+		PreferWorkingHours: lo.ToPtr(team.PreferWorkingHours),
+		ReviewSlaHours:     lo.ToPtr(team.ReviewSLAHours),
 	}
 
 	for _, user := range users {
+		membership, _ := user.Membership(team.ID)
+
 		response.Members = append(response.Members, api.TeamMember{
 			IsActive: user.IsActive,
 			UserId:   user.ID,
 			Username: user.Name,
+			Role:     domain.ConvertTeamRoleToApi(membership.Role),
+			Teams:    lo.ToPtr(user.TeamNames()),
 		})
 	}
 
-	c.JSON(http.StatusOK, response)
+	fallbacks, err := h.usecases.GetTeamFallbacks(ctx, team.ID)
+	if err != nil {
+		return api.Team{}, err
+	}
+
+	if len(fallbacks) > 0 {
+		response.FallbackTeams = lo.ToPtr(teamsNames(fallbacks))
+	}
+
+	return response, nil
+}
+
+// Изменить настройки выбора ревьюверов команды
+// (POST /team/setSettings)
+func (h *HttpServer) PostTeamSetSettings(c *gin.Context) {
+	apiRequest := api.TeamSettings{}
+	if err := c.ShouldBindJSON(&apiRequest); err != nil {
+		handleParsingError(c, err)
+		return
+	}
+
+	domainRequest := domain.UpdateTeamSettingsRequest{
+		TeamName:           apiRequest.TeamName,
+		MinReviewers:       apiRequest.MinReviewers,
+		MaxReviewers:       apiRequest.MaxReviewers,
+		RequiredApprovals:  apiRequest.RequiredApprovals,
+		PreferWorkingHours: apiRequest.PreferWorkingHours,
+		ReviewSLAHours:     apiRequest.ReviewSlaHours,
+	}
+
+	if apiRequest.ReviewerStrategy != nil {
+		domainRequest.ReviewerStrategy = lo.ToPtr(domain.ConvertReviewerStrategyToDomain(apiRequest.ReviewerStrategy))
+	}
+
+	if err := h.validator.Struct(domainRequest); err != nil {
+		handleValidationError(c, err, WithRequest(apiRequest))
+		return
+	}
+
+	team, err := h.usecases.UpdateTeamSettings(c.Request.Context(), domainRequest)
+	if err != nil {
+		handleUsecaseError(c, err, WithRequest(apiRequest))
+		return
+	}
+
+	c.JSON(http.StatusOK, api.TeamSettingsResponse{
+		Settings: api.TeamSettings{
+			TeamName:           team.Name,
+			ReviewerStrategy:   domain.ConvertReviewerStrategyToApi(team.ReviewerStrategy),
+			MinReviewers:       team.MinReviewers,
+			MaxReviewers:       team.MaxReviewers,
+			RequiredApprovals:  lo.ToPtr(team.RequiredApprovals),
+			PreferWorkingHours: lo.ToPtr(team.PreferWorkingHours),
+			ReviewSlaHours:     lo.ToPtr(team.ReviewSLAHours),
+		},
+	})
+}
+
+// Массово деактивировать пользователей команды и переназначить их открытые ревью
+// (POST /team/deactivateUsers)
+func (h *HttpServer) PostTeamDeactivateUsers(c *gin.Context) {
+	apiRequest := api.DeactivateUsersRequest{}
+	if err := c.ShouldBindJSON(&apiRequest); err != nil {
+		handleParsingError(c, err)
+		return
+	}
+
+	domainRequest := domain.DeactivateUsersRequest{
+		TeamName: apiRequest.TeamName,
+		UserIDs:  apiRequest.UserIds,
+	}
+
+	if err := h.validator.Struct(domainRequest); err != nil {
+		handleValidationError(c, err, WithRequest(apiRequest))
+		return
+	}
+
+	result, err := h.usecases.DeactivateTeamUsers(c.Request.Context(), domainRequest)
+	if err != nil {
+		handleUsecaseError(c, err, WithRequest(apiRequest))
+		return
+	}
+
+	c.JSON(http.StatusOK, api.DeactivateUsersResponse{
+		DeactivatedUsersCount:   len(result.DeactivatedUserIDs),
+		ReassignedPullRequests:  domain.ConvertReviewerReplacements(result.Reassigned),
+		NoCandidatePullRequests: domain.ConvertUnreplacedReviewers(result.NoCandidate),
+	})
+}
+
+// Задать резервные команды для выбора ревьюверов
+// (POST /team/setFallbacks)
+func (h *HttpServer) PostTeamSetFallbacks(c *gin.Context) {
+	apiRequest := api.TeamFallbacks{}
+	if err := c.ShouldBindJSON(&apiRequest); err != nil {
+		handleParsingError(c, err)
+		return
+	}
+
+	domainRequest := domain.SetTeamFallbacksRequest{
+		TeamName:          apiRequest.TeamName,
+		FallbackTeamNames: apiRequest.FallbackTeamNames,
+	}
+
+	if err := h.validator.Struct(domainRequest); err != nil {
+		handleValidationError(c, err, WithRequest(apiRequest))
+		return
+	}
+
+	fallbacks, err := h.usecases.SetTeamFallbacks(c.Request.Context(), domainRequest)
+	if err != nil {
+		handleUsecaseError(c, err, WithRequest(apiRequest))
+		return
+	}
+
+	c.JSON(http.StatusOK, api.TeamFallbacksResponse{
+		Fallbacks: api.TeamFallbacks{
+			TeamName:          apiRequest.TeamName,
+			FallbackTeamNames: teamsNames(fallbacks),
+		},
+	})
+}
+
+// Получить правила владения путями команды
+// (GET /team/owners)
+func (h *HttpServer) GetTeamOwners(c *gin.Context, params api.GetTeamOwnersParams) {
+	if err := h.validator.Var(params.TeamName, nameValidationRules); err != nil {
+		handleValidationError(c, err, WithTeamName(params.TeamName))
+		return
+	}
+
+	rules, err := h.usecases.GetTeamOwners(c.Request.Context(), params.TeamName)
+	if err != nil {
+		handleUsecaseError(c, err, WithTeamName(params.TeamName))
+		return
+	}
+
+	c.JSON(http.StatusOK, api.TeamOwnersResponse{
+		Owners: api.TeamOwners{
+			TeamName: params.TeamName,
+			Rules:    domain.ConvertOwnerRulesToApi(rules),
+		},
+	})
+}
+
+// Заменить правила владения путями команды
+// (POST /team/owners)
+func (h *HttpServer) PostTeamOwners(c *gin.Context) {
+	apiRequest := api.TeamOwners{}
+	if err := c.ShouldBindJSON(&apiRequest); err != nil {
+		handleParsingError(c, err)
+		return
+	}
+
+	domainRequest := domain.SetTeamOwnersRequest{
+		TeamName: apiRequest.TeamName,
+		Rules:    domain.ConvertOwnerRulesToDomain(apiRequest.Rules),
+	}
+
+	if err := h.validator.Struct(domainRequest); err != nil {
+		handleValidationError(c, err, WithRequest(apiRequest))
+		return
+	}
+
+	rules, err := h.usecases.SetTeamOwners(c.Request.Context(), domainRequest)
+	if err != nil {
+		handleUsecaseError(c, err, WithRequest(apiRequest))
+		return
+	}
+
+	c.JSON(http.StatusOK, api.TeamOwnersResponse{
+		Owners: api.TeamOwners{
+			TeamName: apiRequest.TeamName,
+			Rules:    domain.ConvertOwnerRulesToApi(rules),
+		},
+	})
+}
+
+func teamsNames(teams []domain.Team) []string {
+	return lo.Map(teams, func(team domain.Team, _ int) string {
+		return team.Name
+	})
 }
@@ -0,0 +1,209 @@
+package http_server
+
+import (
+	"net/http"
+
+	"pr-manager-service/internal/domain"
+	"pr-manager-service/internal/generated/api"
+
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+// Добавить участников в существующую команду
+// (POST /team/addMembers)
+func (h *HttpServer) PostTeamAddMembers(c *gin.Context) {
+	apiRequest := api.AddTeamMembersRequest{}
+	if err := c.ShouldBindJSON(&apiRequest); err != nil {
+		handleParsingError(c, err)
+		return
+	}
+
+	domainRequest := domain.AddTeamMembersRequest{
+		TeamName: apiRequest.TeamName,
+		Members:  make([]domain.CreateUserRequest, 0, len(apiRequest.Members)),
+	}
+
+	for _, member := range apiRequest.Members {
+		domainRequest.Members = append(domainRequest.Members, domain.CreateUserRequest{
+			ID:       member.UserId,
+			Name:     member.Username,
+			IsActive: member.IsActive,
+			Role:     domain.ConvertTeamRoleToDomain(member.Role),
+		})
+	}
+
+	if err := h.validator.Struct(domainRequest); err != nil {
+		handleValidationError(c, err, WithRequest(apiRequest))
+		return
+	}
+
+	team, users, err := h.usecases.AddTeamMembers(c.Request.Context(), domainRequest)
+	if err != nil {
+		handleUsecaseError(c, err, WithRequest(apiRequest))
+		return
+	}
+
+	h.respondTeam(c, team, users)
+}
+
+// Удалить участника из команды
+// (POST /team/removeMember)
+func (h *HttpServer) PostTeamRemoveMember(c *gin.Context) {
+	apiRequest := api.RemoveTeamMemberRequest{}
+	if err := c.ShouldBindJSON(&apiRequest); err != nil {
+		handleParsingError(c, err)
+		return
+	}
+
+	domainRequest := domain.RemoveTeamMemberRequest{
+		TeamName: apiRequest.TeamName,
+		UserID:   apiRequest.UserId,
+	}
+
+	if err := h.validator.Struct(domainRequest); err != nil {
+		handleValidationError(c, err, WithRequest(apiRequest))
+		return
+	}
+
+	user, reassignment, err := h.usecases.RemoveTeamMember(c.Request.Context(), domainRequest)
+	if err != nil {
+		handleUsecaseError(c, err, WithRequest(apiRequest))
+		return
+	}
+
+	c.JSON(http.StatusOK, teamMemberResponse(user, reassignment))
+}
+
+// Перенести пользователя в другую команду
+// (POST /team/moveMember)
+func (h *HttpServer) PostTeamMoveMember(c *gin.Context) {
+	apiRequest := api.MoveTeamMemberRequest{}
+	if err := c.ShouldBindJSON(&apiRequest); err != nil {
+		handleParsingError(c, err)
+		return
+	}
+
+	domainRequest := domain.MoveTeamMemberRequest{
+		UserID:          apiRequest.UserId,
+		TeamName:        apiRequest.TeamName,
+		FromTeamName:    lo.FromPtr(apiRequest.FromTeamName),
+		ReassignReviews: lo.FromPtr(apiRequest.ReassignReviews),
+	}
+
+	if err := h.validator.Struct(domainRequest); err != nil {
+		handleValidationError(c, err, WithRequest(apiRequest))
+		return
+	}
+
+	user, reassignment, err := h.usecases.MoveTeamMember(c.Request.Context(), domainRequest)
+	if err != nil {
+		handleUsecaseError(c, err, WithRequest(apiRequest))
+		return
+	}
+
+	c.JSON(http.StatusOK, teamMemberResponse(user, reassignment))
+}
+
+// Изменить роль участника команды
+// (POST /team/setMemberRole)
+func (h *HttpServer) PostTeamSetMemberRole(c *gin.Context) {
+	apiRequest := api.SetTeamMemberRoleRequest{}
+	if err := c.ShouldBindJSON(&apiRequest); err != nil {
+		handleParsingError(c, err)
+		return
+	}
+
+	domainRequest := domain.SetTeamMemberRoleRequest{
+		TeamName: apiRequest.TeamName,
+		UserID:   apiRequest.UserId,
+		Role:     domain.TeamRole(apiRequest.Role),
+	}
+
+	if err := h.validator.Struct(domainRequest); err != nil {
+		handleValidationError(c, err, WithRequest(apiRequest))
+		return
+	}
+
+	team, users, err := h.usecases.SetTeamMemberRole(c.Request.Context(), domainRequest)
+	if err != nil {
+		handleUsecaseError(c, err, WithRequest(apiRequest))
+		return
+	}
+
+	h.respondTeam(c, team, users)
+}
+
+// Переименовать команду
+// (POST /team/rename)
+func (h *HttpServer) PostTeamRename(c *gin.Context) {
+	apiRequest := api.RenameTeamRequest{}
+	if err := c.ShouldBindJSON(&apiRequest); err != nil {
+		handleParsingError(c, err)
+		return
+	}
+
+	domainRequest := domain.RenameTeamRequest{
+		TeamName:    apiRequest.TeamName,
+		NewTeamName: apiRequest.NewTeamName,
+	}
+
+	if err := h.validator.Struct(domainRequest); err != nil {
+		handleValidationError(c, err, WithRequest(apiRequest))
+		return
+	}
+
+	team, users, err := h.usecases.RenameTeam(c.Request.Context(), domainRequest)
+	if err != nil {
+		handleUsecaseError(c, err, WithRequest(apiRequest))
+		return
+	}
+
+	h.respondTeam(c, team, users)
+}
+
+// Удалить команду без участников
+// (POST /team/delete)
+func (h *HttpServer) PostTeamDelete(c *gin.Context) {
+	apiRequest := api.DeleteTeamRequest{}
+	if err := c.ShouldBindJSON(&apiRequest); err != nil {
+		handleParsingError(c, err)
+		return
+	}
+
+	domainRequest := domain.DeleteTeamRequest{
+		TeamName: apiRequest.TeamName,
+	}
+
+	if err := h.validator.Struct(domainRequest); err != nil {
+		handleValidationError(c, err, WithRequest(apiRequest))
+		return
+	}
+
+	if err := h.usecases.DeleteTeam(c.Request.Context(), domainRequest); err != nil {
+		handleUsecaseError(c, err, WithRequest(apiRequest))
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (h *HttpServer) respondTeam(c *gin.Context, team domain.Team, users []domain.User) {
+	response, err := h.convertTeam(c.Request.Context(), team, users)
+	if err != nil {
+		handleUsecaseError(c, err, WithTeamName(team.Name))
+		return
+	}
+
+	c.JSON(http.StatusOK, api.TeamResponse{
+		Team: response,
+	})
+}
+
+func teamMemberResponse(user domain.User, reassignment domain.ReassignmentResult) api.TeamMemberResponse {
+	return api.TeamMemberResponse{
+		User:                    domain.ConvertUser(user),
+		ReassignedPullRequests:  domain.ConvertReviewerReplacements(reassignment.Reassigned),
+		NoCandidatePullRequests: domain.ConvertUnreplacedReviewers(reassignment.NoCandidate),
+	}
+}
@@ -6,36 +6,49 @@ import (
 	"pr-manager-service/internal/generated/api"
 
 	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+// defaultUserReviewsLimit - размер страницы PR ревьювера, если limit не передан
+const defaultUserReviewsLimit = 50
+
+// defaultUserReviewsStatuses - статусы PR ревьювера, если status не передан: PR, которые ждут ревью
+var defaultUserReviewsStatuses = lo.Map(
+	domain.ActivePullRequestStatuses,
+	func(status domain.PullRequestStatus, _ int) api.PullRequestStatus {
+		return domain.ConvertPullRequestStatusToApi(status)
+	},
 )
 
 // Получить PR'ы, где пользователь назначен ревьювером
 // (GET /users/getReview)
 func (h *HttpServer) GetUsersGetReview(c *gin.Context, params api.GetUsersGetReviewParams) {
-	if err := h.validator.Var(params.UserId, idValidationRules); err != nil {
+	domainRequest := domain.GetUserReviewsRequest{
+		UserID:   params.UserId,
+		Statuses: lo.FromPtrOr(params.Status, defaultUserReviewsStatuses),
+		Cursor:   lo.FromPtr(params.Cursor),
+		Limit:    lo.FromPtrOr(params.Limit, defaultUserReviewsLimit),
+	}
+
+	if err := h.validator.Struct(domainRequest); err != nil {
 		handleValidationError(c, err, WithUserID(params.UserId))
 		return
 	}
 
-	prs, err := h.usecases.GetPullRequestsByReviewer(c.Request.Context(), params.UserId)
+	page, err := h.usecases.GetUserReviews(c.Request.Context(), domainRequest)
 	if err != nil {
 		handleUsecaseError(c, err, WithUserID(params.UserId))
 		return
 	}
 
-	response := make([]api.PullRequestShort, 0, len(prs))
-
-	for _, pr := range prs {
-		response = append(response, api.PullRequestShort{
-			AuthorId:        pr.AuthorUserID,
-			PullRequestId:   pr.ID,
-			PullRequestName: pr.Name,
-			Status:          domain.ConvertPullRequestStatusToApi(pr.Status),
-		})
-	}
-
 	c.JSON(http.StatusOK, api.UsersGetReviewResponse{
-		PullRequests: response,
-		UserId:       params.UserId,
+		UserId: params.UserId,
+		PullRequests: lo.Map(page.Reviews, func(review domain.AssignedReview, _ int) api.PullRequestShort {
+			return domain.ConvertAssignedReview(review)
+		}),
+		Total:        page.Total,
+		StatusCounts: domain.ConvertReviewStatusCounts(page.StatusCounts),
+		NextCursor:   lo.EmptyableToPtr(page.NextCursor),
 	})
 }
 
@@ -55,7 +68,7 @@ func (h *HttpServer) PostUsersSetIsActive(c *gin.Context) {
 
 	ctx := c.Request.Context()
 
-	user, team, err := h.usecases.UpdateUserStatus(
+	user, reassignment, err := h.usecases.UpdateUserStatus(
 		ctx,
 		request.UserId,
 		request.IsActive,
@@ -65,14 +78,44 @@ func (h *HttpServer) PostUsersSetIsActive(c *gin.Context) {
 		return
 	}
 
-	response := api.User{
-		UserId:   user.ID,
-		Username: user.Name,
-		TeamName: team.Name,
-		IsActive: user.IsActive,
+	c.JSON(http.StatusOK, api.SetIsActiveResponse{
+		User:                    domain.ConvertUser(user),
+		ReassignedPullRequests:  domain.ConvertReviewerReplacements(reassignment.Reassigned),
+		NoCandidatePullRequests: domain.ConvertUnreplacedReviewers(reassignment.NoCandidate),
+	})
+}
+
+// Задать часовой пояс и рабочие часы пользователя
+// (POST /users/setWorkingHours)
+func (h *HttpServer) PostUsersSetWorkingHours(c *gin.Context) {
+	apiRequest := api.SetWorkingHoursRequest{}
+	if err := c.ShouldBindJSON(&apiRequest); err != nil {
+		handleParsingError(c, err)
+		return
+	}
+
+	domainRequest := domain.SetWorkingHoursRequest{
+		UserID:   apiRequest.UserId,
+		Timezone: apiRequest.Timezone,
 	}
 
-	c.JSON(http.StatusOK, api.SetIsActiveResponse{
-		User: response,
+	if apiRequest.WorkingHours != nil {
+		domainRequest.Start = apiRequest.WorkingHours.Start
+		domainRequest.End = apiRequest.WorkingHours.End
+	}
+
+	if err := h.validator.Struct(domainRequest); err != nil {
+		handleValidationError(c, err, WithRequest(apiRequest))
+		return
+	}
+
+	user, err := h.usecases.SetUserWorkingHours(c.Request.Context(), domainRequest)
+	if err != nil {
+		handleUsecaseError(c, err, WithRequest(apiRequest))
+		return
+	}
+
+	c.JSON(http.StatusOK, api.UserResponse{
+		User: domain.ConvertUser(user),
 	})
 }
@@ -0,0 +1,115 @@
+package http_server
+
+import (
+	"net/http"
+
+	"pr-manager-service/internal/domain"
+	"pr-manager-service/internal/generated/api"
+
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+const defaultWebhookDeliveriesLimit = 50
+
+// Подписаться на события
+// (POST /webhooks/subscribe)
+func (h *HttpServer) PostWebhooksSubscribe(c *gin.Context) {
+	apiRequest := api.CreateWebhookSubscriptionRequest{}
+	if err := c.ShouldBindJSON(&apiRequest); err != nil {
+		handleParsingError(c, err)
+		return
+	}
+
+	domainRequest := domain.CreateWebhookSubscriptionRequest{
+		URL: apiRequest.Url,
+		EventTypes: lo.Map(lo.FromPtr(apiRequest.Events), func(eventType api.WebhookEventType, _ int) domain.EventType {
+			return domain.EventType(eventType)
+		}),
+		Secret: apiRequest.Secret,
+	}
+
+	if err := h.validator.Struct(domainRequest); err != nil {
+		handleValidationError(c, err, WithWebhookURL(apiRequest.Url))
+		return
+	}
+
+	subscription, err := h.usecases.CreateWebhookSubscription(c.Request.Context(), domainRequest)
+	if err != nil {
+		handleUsecaseError(c, err, WithWebhookURL(apiRequest.Url))
+		return
+	}
+
+	c.JSON(http.StatusCreated, api.WebhookSubscriptionResponse{
+		Subscription: domain.ConvertWebhookSubscription(subscription),
+	})
+}
+
+// Получить подписки на события
+// (GET /webhooks/list)
+func (h *HttpServer) GetWebhooksList(c *gin.Context) {
+	subscriptions, err := h.usecases.GetWebhookSubscriptions(c.Request.Context())
+	if err != nil {
+		handleUsecaseError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, api.WebhookSubscriptionsResponse{
+		Subscriptions: lo.Map(subscriptions, func(subscription domain.WebhookSubscription, _ int) api.WebhookSubscription {
+			return domain.ConvertWebhookSubscription(subscription)
+		}),
+	})
+}
+
+// Удалить подписку вместе с журналом её доставок
+// (POST /webhooks/delete)
+func (h *HttpServer) PostWebhooksDelete(c *gin.Context) {
+	apiRequest := api.DeleteWebhookSubscriptionRequest{}
+	if err := c.ShouldBindJSON(&apiRequest); err != nil {
+		handleParsingError(c, err)
+		return
+	}
+
+	domainRequest := domain.DeleteWebhookSubscriptionRequest{
+		ID: apiRequest.Id,
+	}
+
+	if err := h.validator.Struct(domainRequest); err != nil {
+		handleValidationError(c, err, WithRequest(apiRequest))
+		return
+	}
+
+	if err := h.usecases.DeleteWebhookSubscription(c.Request.Context(), domainRequest); err != nil {
+		handleUsecaseError(c, err, WithRequest(apiRequest))
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// Журнал доставок подписки
+// (GET /webhooks/deliveries)
+func (h *HttpServer) GetWebhooksDeliveries(c *gin.Context, params api.GetWebhooksDeliveriesParams) {
+	domainRequest := domain.GetWebhookDeliveriesRequest{
+		SubscriptionID: params.SubscriptionId,
+		Status:         domain.WebhookDeliveryStatus(lo.FromPtr(params.Status)),
+		Limit:          lo.FromPtrOr(params.Limit, defaultWebhookDeliveriesLimit),
+	}
+
+	if err := h.validator.Struct(domainRequest); err != nil {
+		handleValidationError(c, err, WithRequest(params))
+		return
+	}
+
+	deliveries, err := h.usecases.GetWebhookDeliveries(c.Request.Context(), domainRequest)
+	if err != nil {
+		handleUsecaseError(c, err, WithRequest(params))
+		return
+	}
+
+	c.JSON(http.StatusOK, api.WebhookDeliveriesResponse{
+		Deliveries: lo.Map(deliveries, func(delivery domain.WebhookDelivery, _ int) api.WebhookDelivery {
+			return domain.ConvertWebhookDelivery(delivery)
+		}),
+	})
+}
@@ -0,0 +1,32 @@
+package outbox
+
+import (
+	"context"
+	"log/slog"
+
+	"pr-manager-service/internal/domain"
+)
+
+// LogSink пишет каждое событие в лог.
+type LogSink struct {
+	logger *slog.Logger
+}
+
+func NewLogSink(logger *slog.Logger) *LogSink {
+	return &LogSink{logger: logger}
+}
+
+func (s *LogSink) Publish(ctx context.Context, events []domain.Event) error {
+	for _, event := range events {
+		s.logger.InfoContext(
+			ctx,
+			"domain event",
+			slog.String("event_id", event.ID),
+			slog.String("event_type", string(event.Type)),
+			slog.Time("occurred_at", event.OccurredAt),
+			slog.Any("data", event.Data),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package outbox
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"pr-manager-service/internal/domain"
+)
+
+// MemorySink запоминает полученные события, например чтобы проверить их в тестах.
+type MemorySink struct {
+	mu     sync.Mutex
+	events []domain.Event
+}
+
+func NewMemorySink() *MemorySink {
+	return &MemorySink{}
+}
+
+func (s *MemorySink) Publish(_ context.Context, events []domain.Event) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.events = append(s.events, events...)
+
+	return nil
+}
+
+// Events возвращает копию полученных событий в порядке получения.
+func (s *MemorySink) Events() []domain.Event {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return slices.Clone(s.events)
+}
+
+// Reset забывает полученные события.
+func (s *MemorySink) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.events = nil
+}
@@ -0,0 +1,62 @@
+package outbox
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"testing"
+	"time"
+
+	"pr-manager-service/internal/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testEvents = []domain.Event{
+	{
+		ID:         "1",
+		Type:       domain.EventPullRequestMerged,
+		OccurredAt: time.Date(2025, time.November, 12, 12, 0, 0, 0, time.UTC),
+		Data:       domain.PullRequestMergedEvent{PullRequestID: "100", AuthorID: "101"},
+	},
+	{
+		ID:         "2",
+		Type:       domain.EventReviewerReassigned,
+		OccurredAt: time.Date(2025, time.November, 12, 12, 5, 0, 0, time.UTC),
+		Data: domain.ReviewerReassignedEvent{
+			PullRequestID: "102",
+			OldReviewerID: "101",
+			NewReviewerID: "103",
+			Reason:        domain.AssignmentReasonReassign,
+		},
+	},
+}
+
+func TestMemorySink(t *testing.T) {
+	sink := NewMemorySink()
+
+	require.NoError(t, sink.Publish(context.Background(), testEvents[:1]))
+	require.NoError(t, sink.Publish(context.Background(), testEvents[1:]))
+	assert.Equal(t, testEvents, sink.Events())
+
+	sink.Reset()
+	assert.Empty(t, sink.Events())
+}
+
+func TestLogSink(t *testing.T) {
+	var buf bytes.Buffer
+	sink := NewLogSink(slog.New(slog.NewJSONHandler(&buf, nil)))
+
+	require.NoError(t, sink.Publish(context.Background(), testEvents))
+
+	decoder := json.NewDecoder(&buf)
+	for _, event := range testEvents {
+		var record map[string]any
+		require.NoError(t, decoder.Decode(&record))
+
+		assert.Equal(t, event.ID, record["event_id"])
+		assert.Equal(t, string(event.Type), record["event_type"])
+	}
+}
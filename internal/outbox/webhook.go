@@ -0,0 +1,30 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+
+	"pr-manager-service/internal/domain"
+)
+
+type webhookDeliveryStorage interface {
+	CreateWebhookDeliveries(ctx context.Context, events []domain.Event) error
+}
+
+// WebhookSink ставит события в очередь доставки подписчикам вебхуков. Повторно переданное событие
+// новых доставок не создаёт.
+type WebhookSink struct {
+	storage webhookDeliveryStorage
+}
+
+func NewWebhookSink(storage webhookDeliveryStorage) *WebhookSink {
+	return &WebhookSink{storage: storage}
+}
+
+func (s *WebhookSink) Publish(ctx context.Context, events []domain.Event) error {
+	if err := s.storage.CreateWebhookDeliveries(ctx, events); err != nil {
+		return fmt.Errorf("storage.CreateWebhookDeliveries: %w", err)
+	}
+
+	return nil
+}
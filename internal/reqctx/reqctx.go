@@ -0,0 +1,42 @@
+package reqctx
+
+import (
+	"context"
+
+	"pr-manager-service/internal/domain"
+)
+
+type contextKey int
+
+const (
+	requestIDKey contextKey = iota
+	callerKey
+)
+
+// WithRequestID сохраняет в контексте идентификатор запроса, по нему связываются записи аудита и логи.
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey, requestID)
+}
+
+// RequestID возвращает идентификатор запроса или пустую строку, если операция выполняется не по запросу.
+func RequestID(ctx context.Context) string {
+	requestID, _ := ctx.Value(requestIDKey).(string)
+	return requestID
+}
+
+// WithCaller сохраняет в контексте аутентифицированного вызывающего.
+func WithCaller(ctx context.Context, caller domain.Caller) context.Context {
+	return context.WithValue(ctx, callerKey, caller)
+}
+
+// Caller возвращает вызывающего; false - операцию выполняет сервис, например фоновый воркер.
+func Caller(ctx context.Context) (domain.Caller, bool) {
+	caller, ok := ctx.Value(callerKey).(domain.Caller)
+	return caller, ok
+}
+
+// Actor возвращает исполнителя операции для журнала аудита; пустая строка - операцию выполняет сервис.
+func Actor(ctx context.Context) string {
+	caller, _ := Caller(ctx)
+	return caller.Actor()
+}
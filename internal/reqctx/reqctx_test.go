@@ -0,0 +1,26 @@
+package reqctx
+
+import (
+	"context"
+	"testing"
+
+	"pr-manager-service/internal/domain"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContext(t *testing.T) {
+	ctx := context.Background()
+	assert.Empty(t, RequestID(ctx))
+	assert.Empty(t, Actor(ctx))
+
+	_, ok := Caller(ctx)
+	assert.False(t, ok)
+
+	ctx = WithCaller(WithRequestID(ctx, "req-1"), domain.Caller{UserID: "u1"})
+	assert.Equal(t, "req-1", RequestID(ctx))
+	assert.Equal(t, "u1", Actor(ctx))
+
+	ctx = WithCaller(ctx, domain.Caller{Admin: true})
+	assert.Equal(t, domain.AdminActor, Actor(ctx))
+}
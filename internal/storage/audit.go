@@ -0,0 +1,151 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"pr-manager-service/internal/domain"
+
+	"github.com/Masterminds/squirrel"
+)
+
+var auditLogColumns = []string{
+	"id",
+	"actor",
+	"action",
+	"target_type",
+	"target_id",
+	"before",
+	"after",
+	"request_id",
+	"created_at",
+}
+
+// CreateAuditEntries записывает операции в журнал аудита одним запросом. Вызывается в транзакции операций:
+// если транзакция откатится, записей не останется.
+func (s *Storage) CreateAuditEntries(ctx context.Context, entries []domain.AuditEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	timeNow := s.clock.Now()
+	builder := s.builder.Insert("audit_log").
+		Columns("actor", "action", "target_type", "target_id", "before", "after", "request_id", "created_at")
+
+	for _, entry := range entries {
+		builder = builder.Values(
+			nullString(entry.Actor),
+			entry.Action,
+			entry.TargetType,
+			entry.TargetID,
+			nullJSON(entry.Before),
+			nullJSON(entry.After),
+			nullString(entry.RequestID),
+			timeNow,
+		)
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("query builder: %w", err)
+	}
+
+	if _, err := s.querier.Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("conn.Exec: %w", err)
+	}
+
+	return nil
+}
+
+// GetAuditLog возвращает до limit записей журнала аудита от новых к старым, начиная после записи cursor.
+func (s *Storage) GetAuditLog(
+	ctx context.Context,
+	request domain.GetAuditLogRequest,
+	limit int,
+) ([]domain.AuditEntry, error) {
+	builder := s.builder.Select(auditLogColumns...).
+		From("audit_log").
+		OrderBy("id desc").
+		Limit(uint64(limit))
+
+	if request.TargetType != "" {
+		builder = builder.Where(squirrel.Eq{"target_type": request.TargetType})
+	}
+
+	if request.TargetID != "" {
+		builder = builder.Where(squirrel.Eq{"target_id": request.TargetID})
+	}
+
+	if request.Actor != "" {
+		builder = builder.Where(squirrel.Eq{"actor": request.Actor})
+	}
+
+	// NOTE: created_at хранит время UTC без пояса, поэтому границы переводятся в UTC
+	if request.From != nil {
+		builder = builder.Where(squirrel.GtOrEq{"created_at": request.From.UTC()})
+	}
+
+	if request.To != nil {
+		builder = builder.Where(squirrel.Lt{"created_at": request.To.UTC()})
+	}
+
+	if request.Cursor > 0 {
+		builder = builder.Where(squirrel.Lt{"id": request.Cursor})
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("query builder: %w", err)
+	}
+
+	rows, err := s.querier.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("conn.Query: %w", err)
+	}
+	defer rows.Close()
+
+	entries := []domain.AuditEntry{}
+	for rows.Next() {
+		var (
+			entry              domain.AuditEntry
+			actor, requestID   sql.NullString
+			action, targetType string
+			before, after      []byte
+		)
+
+		if err := rows.Scan(
+			&entry.ID,
+			&actor,
+			&action,
+			&targetType,
+			&entry.TargetID,
+			&before,
+			&after,
+			&requestID,
+			&entry.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("rows.Scan: %w", err)
+		}
+
+		entry.Actor = actor.String
+		entry.Action = domain.AuditAction(action)
+		entry.TargetType = domain.AuditTargetType(targetType)
+		entry.Before = before
+		entry.After = after
+		entry.RequestID = requestID.String
+		entries = append(entries, entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows.Err: %w", err)
+	}
+
+	return entries, nil
+}
+
+// nullJSON возвращает JSON строкой для колонки jsonb или NULL, если JSON пустой.
+func nullJSON(raw json.RawMessage) sql.NullString {
+	return sql.NullString{String: string(raw), Valid: len(raw) > 0}
+}
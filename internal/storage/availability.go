@@ -0,0 +1,121 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"pr-manager-service/internal/domain"
+
+	"github.com/Masterminds/squirrel"
+)
+
+// availableCondition отбирает пользователей u, у которых в момент ? нет окна отсутствия.
+const availableCondition = `not exists (
+	select 1 from user_unavailability ua
+	where ua.user_id = u.id and ua.starts_at <= ? and ua.ends_at > ?
+)`
+
+// CreateUnavailability добавляет окно отсутствия пользователя.
+func (s *Storage) CreateUnavailability(
+	ctx context.Context,
+	request domain.CreateUnavailabilityRequest,
+) (domain.Unavailability, error) {
+	window := domain.Unavailability{
+		UserID:   request.UserID,
+		StartsAt: request.StartsAt,
+		EndsAt:   request.EndsAt,
+		Reason:   request.Reason,
+	}
+
+	query, args, err := s.builder.Insert("user_unavailability").
+		Columns("user_id", "starts_at", "ends_at", "reason", "created_at").
+		Values(window.UserID, window.StartsAt, window.EndsAt, nullString(window.Reason), s.clock.Now()).
+		Suffix("returning id").
+		ToSql()
+	if err != nil {
+		return domain.Unavailability{}, fmt.Errorf("query builder: %w", err)
+	}
+
+	if err := s.querier.QueryRow(ctx, query, args...).Scan(&window.ID); err != nil {
+		return domain.Unavailability{}, fmt.Errorf("conn.QueryRow: %w", err)
+	}
+
+	return window, nil
+}
+
+// GetUserUnavailability возвращает окна отсутствия пользователя, которые ещё не закончились к моменту now,
+// в порядке начала.
+func (s *Storage) GetUserUnavailability(
+	ctx context.Context,
+	userID string,
+	now time.Time,
+) ([]domain.Unavailability, error) {
+	query, args, err := s.builder.Select("id", "user_id", "starts_at", "ends_at", "reason").
+		From("user_unavailability").
+		Where(squirrel.Eq{"user_id": userID}).
+		Where(squirrel.Gt{"ends_at": now}).
+		OrderBy("starts_at", "id").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("query builder: %w", err)
+	}
+
+	rows, err := s.querier.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("conn.Query: %w", err)
+	}
+	defer rows.Close()
+
+	windows := []domain.Unavailability{}
+	for rows.Next() {
+		var (
+			window domain.Unavailability
+			reason sql.NullString
+		)
+
+		if err := rows.Scan(
+			&window.ID,
+			&window.UserID,
+			&window.StartsAt,
+			&window.EndsAt,
+			&reason,
+		); err != nil {
+			return nil, fmt.Errorf("rows.Scan: %w", err)
+		}
+
+		window.Reason = reason.String
+		windows = append(windows, window)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows.Err: %w", err)
+	}
+
+	return windows, nil
+}
+
+// DeleteUnavailability удаляет окно отсутствия пользователя. Если окна нет, возвращает ErrUnavailabilityNotFound.
+func (s *Storage) DeleteUnavailability(ctx context.Context, userID string, windowID int64) error {
+	query, args, err := s.builder.Delete("user_unavailability").
+		Where(squirrel.Eq{
+			"id":      windowID,
+			"user_id": userID,
+		}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("query builder: %w", err)
+	}
+
+	tag, err := s.querier.Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("conn.Exec: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return domain.ErrUnavailabilityNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,198 @@
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"pr-manager-service/internal/domain"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/samber/lo"
+)
+
+// CreateOutboxEvents записывает события в outbox. Вызывается в той же транзакции, что и изменение,
+// которое описывают события: если транзакция откатится, события не будут переданы получателям.
+func (s *Storage) CreateOutboxEvents(ctx context.Context, events []domain.Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	builder := s.builder.Insert("outbox").
+		Columns("event_id", "event_type", "payload", "occurred_at", "pull_request_id", "user_ids")
+
+	for _, event := range events {
+		payload, err := json.Marshal(event)
+		if err != nil {
+			return fmt.Errorf("json.Marshal: %w", err)
+		}
+
+		builder = builder.Values(
+			event.ID,
+			event.Type,
+			string(payload),
+			event.OccurredAt,
+			event.Data.PullRequest(),
+			lo.Uniq(lo.Compact(event.Data.Users())),
+		)
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("query builder: %w", err)
+	}
+
+	if _, err := s.querier.Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("conn.Exec: %w", err)
+	}
+
+	return nil
+}
+
+// LockOutboxEvents возвращает до limit ещё не переданных событий в порядке записи и блокирует их
+// до конца транзакции. Строки не пропускаются, а ожидаются: реплики релея обрабатывают outbox по очереди,
+// поэтому порядок событий сохраняется.
+func (s *Storage) LockOutboxEvents(ctx context.Context, limit int) ([]domain.Event, error) {
+	query, args, err := s.builder.Select("payload").
+		From("outbox").
+		Where(squirrel.Eq{"dispatched_at": nil}).
+		OrderBy("id").
+		Limit(uint64(limit)).
+		Suffix("for update").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("query builder: %w", err)
+	}
+
+	rows, err := s.querier.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("conn.Query: %w", err)
+	}
+	defer rows.Close()
+
+	events := []domain.Event{}
+	for rows.Next() {
+		var payload []byte
+		if err := rows.Scan(&payload); err != nil {
+			return nil, fmt.Errorf("rows.Scan: %w", err)
+		}
+
+		var event domain.Event
+		if err := json.Unmarshal(payload, &event); err != nil {
+			return nil, fmt.Errorf("json.Unmarshal: %w", err)
+		}
+
+		events = append(events, event)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows.Err: %w", err)
+	}
+
+	return events, nil
+}
+
+// MarkOutboxEventsDispatched отмечает события переданными получателям и присваивает им позиции
+// в потоке событий в порядке eventIDs, следом за уже переданными событиями. Вызывается в транзакции
+// LockOutboxEvents: релеи передают события по очереди, поэтому позиции не пересекаются и не пропускаются.
+func (s *Storage) MarkOutboxEventsDispatched(ctx context.Context, eventIDs []string) error {
+	if len(eventIDs) == 0 {
+		return nil
+	}
+
+	query, args, err := s.builder.Update("outbox").
+		Set("dispatched_at", s.clock.Now()).
+		Set("dispatch_seq", squirrel.Expr(
+			"(select coalesce(max(dispatch_seq), 0) from outbox) + array_position(?::varchar[], event_id)",
+			eventIDs,
+		)).
+		Where(squirrel.Eq{"event_id": eventIDs}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("query builder: %w", err)
+	}
+
+	if _, err := s.querier.Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("conn.Exec: %w", err)
+	}
+
+	return nil
+}
+
+// GetLastOutboxEventSeq возвращает позицию последнего переданного события или 0, если событий нет.
+func (s *Storage) GetLastOutboxEventSeq(ctx context.Context) (int64, error) {
+	query, args, err := s.builder.Select("coalesce(max(dispatch_seq), 0)").
+		From("outbox").
+		ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("query builder: %w", err)
+	}
+
+	var seq int64
+	if err := s.querier.QueryRow(ctx, query, args...).Scan(&seq); err != nil {
+		return 0, fmt.Errorf("conn.QueryRow: %w", err)
+	}
+
+	return seq, nil
+}
+
+// GetDispatchedOutboxEvents возвращает до limit переданных событий после позиции afterSeq по порядку позиций.
+// Фильтр по команде проверяет текущую команду PR события.
+func (s *Storage) GetDispatchedOutboxEvents(
+	ctx context.Context,
+	filter domain.EventStreamFilter,
+	afterSeq int64,
+	limit int,
+) ([]domain.StreamEvent, error) {
+	builder := s.builder.Select("o.dispatch_seq", "o.payload").
+		From("outbox o").
+		Where(squirrel.Gt{"o.dispatch_seq": afterSeq}).
+		OrderBy("o.dispatch_seq").
+		Limit(uint64(limit))
+
+	if filter.UserID != "" {
+		builder = builder.Where("? = any(o.user_ids)", filter.UserID)
+	}
+
+	if filter.TeamName != "" {
+		builder = builder.Where(
+			"o.pull_request_id in (select p.id from pull_requests p join teams t on t.id = p.team_id where t.name = ?)",
+			filter.TeamName,
+		)
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("query builder: %w", err)
+	}
+
+	rows, err := s.querier.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("conn.Query: %w", err)
+	}
+	defer rows.Close()
+
+	events := []domain.StreamEvent{}
+	for rows.Next() {
+		var (
+			event   domain.StreamEvent
+			payload []byte
+		)
+
+		if err := rows.Scan(&event.Seq, &payload); err != nil {
+			return nil, fmt.Errorf("rows.Scan: %w", err)
+		}
+
+		if err := json.Unmarshal(payload, &event.Event); err != nil {
+			return nil, fmt.Errorf("json.Unmarshal: %w", err)
+		}
+
+		events = append(events, event)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows.Err: %w", err)
+	}
+
+	return events, nil
+}
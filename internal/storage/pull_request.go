@@ -2,6 +2,7 @@ package storage
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
 	"time"
@@ -10,11 +11,13 @@ import (
 
 	"github.com/Masterminds/squirrel"
 	"github.com/jackc/pgx/v4"
+	"github.com/samber/lo"
 )
 
-func (s *Storage) UpdatePullRequestReviewersIDs(ctx context.Context, prID string, reviewersIDs []string) error {
+// UpdatePullRequestNeedsMoreReviewers обновляет флаг нехватки ревьюверов после их назначения.
+func (s *Storage) UpdatePullRequestNeedsMoreReviewers(ctx context.Context, prID string, needsMoreReviewers bool) error {
 	query, args, err := s.builder.Update("pull_requests").
-		Set("reviewers_ids", reviewersIDs).
+		Set("needs_more_reviewers", needsMoreReviewers).
 		Where(squirrel.Eq{"id": prID}).
 		ToSql()
 
@@ -29,17 +32,356 @@ func (s *Storage) UpdatePullRequestReviewersIDs(ctx context.Context, prID string
 	return nil
 }
 
+// reviewersColumn собирает текущих ревьюверов PR в порядке назначения.
+const reviewersColumn = `coalesce((
+	select array_agg(r.user_id order by r.assigned_at, r.id)
+	from pull_request_reviewers r
+	where r.pull_request_id = pr.id and r.unassigned_at is null
+), '{}'::varchar[])`
+
+// reviewerPoolsColumn собирает команды текущих ревьюверов PR в объект
+// {user_id: {team_name, is_fallback, is_owner, assigned_at, escalated}}.
+// NOTE: assigned_at, как и остальные колонки timestamp, хранит время UTC без пояса
+const reviewerPoolsColumn = `coalesce((
+	select jsonb_object_agg(r.user_id, jsonb_build_object(
+		'team_name', t.name,
+		'is_fallback', r.is_fallback,
+		'is_owner', r.is_owner,
+		'assigned_at', r.assigned_at at time zone 'UTC',
+		'escalated', r.escalated_at is not null
+	))
+	from pull_request_reviewers r
+		join teams t on t.id = r.team_id
+	where r.pull_request_id = pr.id and r.unassigned_at is null
+), '{}'::jsonb)`
+
+// AssignPullRequestReviewers добавляет ревьюверов PR в историю назначений.
+func (s *Storage) AssignPullRequestReviewers(
+	ctx context.Context,
+	prID string,
+	assignments []domain.ReviewerAssignment,
+	reason domain.AssignmentReason,
+) error {
+	if len(assignments) == 0 {
+		return nil
+	}
+
+	timeNow := s.clock.Now()
+
+	builder := s.builder.Insert("pull_request_reviewers").
+		Columns(
+			"pull_request_id",
+			"user_id",
+			"team_id",
+			"is_fallback",
+			"is_owner",
+			"reason",
+			"assigned_by",
+			"assigned_at",
+		)
+
+	for _, assignment := range assignments {
+		builder = builder.Values(
+			prID,
+			assignment.UserID,
+			assignment.TeamID,
+			assignment.IsFallback,
+			assignment.IsOwner,
+			reason,
+			nullString(assignment.AssignedBy),
+			timeNow,
+		)
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("query builder: %w", err)
+	}
+
+	if _, err := s.querier.Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("conn.Exec: %w", err)
+	}
+
+	return nil
+}
+
+// UnassignPullRequestReviewer снимает ревьювера с PR, сохраняя запись о назначении в истории.
+func (s *Storage) UnassignPullRequestReviewer(
+	ctx context.Context,
+	prID, userID string,
+	reason domain.AssignmentReason,
+) error {
+	query, args, err := s.builder.Update("pull_request_reviewers").
+		Set("unassigned_at", s.clock.Now()).
+		Set("unassign_reason", reason).
+		Where(squirrel.Eq{
+			"pull_request_id": prID,
+			"user_id":         userID,
+			"unassigned_at":   nil,
+		}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("query builder: %w", err)
+	}
+
+	if _, err := s.querier.Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("conn.Exec: %w", err)
+	}
+
+	return nil
+}
+
+// LockPullRequest блокирует строку PR до конца транзакции, чтобы смена статуса, мерж и решения ревьюверов
+// по одному PR выполнялись по очереди и проверяли его актуальное состояние.
+func (s *Storage) LockPullRequest(ctx context.Context, prID string) error {
+	query, args, err := s.builder.Select("id").
+		From("pull_requests").
+		Where(squirrel.Eq{"id": prID}).
+		Suffix("for update").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("query builder: %w", err)
+	}
+
+	var id string
+	if err := s.querier.QueryRow(ctx, query, args...).Scan(&id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.ErrPullRequestNotFound
+		}
+
+		return fmt.Errorf("conn.QueryRow: %w", err)
+	}
+
+	return nil
+}
+
+// LockReviewAssignment блокирует текущее назначение ревьювера до конца транзакции.
+// Возвращает false, если назначения нет, оно уже эскалировано или его обрабатывает другая транзакция.
+func (s *Storage) LockReviewAssignment(ctx context.Context, prID, userID string) (bool, error) {
+	query, args, err := s.builder.Select("id").
+		From("pull_request_reviewers").
+		Where(squirrel.Eq{
+			"pull_request_id": prID,
+			"user_id":         userID,
+			"unassigned_at":   nil,
+			"escalated_at":    nil,
+		}).
+		Suffix("for update skip locked").
+		ToSql()
+	if err != nil {
+		return false, fmt.Errorf("query builder: %w", err)
+	}
+
+	var id int64
+	if err := s.querier.QueryRow(ctx, query, args...).Scan(&id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("conn.QueryRow: %w", err)
+	}
+
+	return true, nil
+}
+
+// MarkReviewEscalated отмечает текущее назначение ревьювера как эскалированное.
+func (s *Storage) MarkReviewEscalated(ctx context.Context, prID, userID string) error {
+	query, args, err := s.builder.Update("pull_request_reviewers").
+		Set("escalated_at", s.clock.Now()).
+		Where(squirrel.Eq{
+			"pull_request_id": prID,
+			"user_id":         userID,
+			"unassigned_at":   nil,
+		}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("query builder: %w", err)
+	}
+
+	if _, err := s.querier.Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("conn.Exec: %w", err)
+	}
+
+	return nil
+}
+
+// ReplacePullRequestReviewers выполняет замены ревьюверов сразу в нескольких PR: снимает старых и назначает
+// новых. assignments[i] - назначение нового ревьювера replacements[i] с его командой и исполнителем.
+// Количество запросов не зависит от числа замен.
+func (s *Storage) ReplacePullRequestReviewers(
+	ctx context.Context,
+	replacements []domain.ReviewerReplacement,
+	assignments []domain.ReviewerAssignment,
+	reason domain.AssignmentReason,
+) error {
+	if len(replacements) == 0 {
+		return nil
+	}
+
+	if len(replacements) != len(assignments) {
+		return fmt.Errorf("got %d assignments for %d replacements", len(assignments), len(replacements))
+	}
+
+	var (
+		timeNow        = s.clock.Now()
+		pullRequestIDs = make([]string, 0, len(replacements))
+		oldReviewerIDs = make([]string, 0, len(replacements))
+		newReviewerIDs = make([]string, 0, len(replacements))
+		teamIDs        = make([]string, 0, len(replacements))
+		isFallbacks    = make([]bool, 0, len(replacements))
+		assignedBy     = make([]*string, 0, len(replacements))
+	)
+
+	for i, replacement := range replacements {
+		pullRequestIDs = append(pullRequestIDs, replacement.PullRequestID)
+		oldReviewerIDs = append(oldReviewerIDs, replacement.OldReviewerID)
+		newReviewerIDs = append(newReviewerIDs, assignments[i].UserID)
+		teamIDs = append(teamIDs, assignments[i].TeamID)
+		isFallbacks = append(isFallbacks, assignments[i].IsFallback)
+		// NOTE: пустой исполнитель записывается как null, как и в AssignPullRequestReviewers
+		assignedBy = append(assignedBy, lo.EmptyableToPtr(assignments[i].AssignedBy))
+	}
+
+	unassignQuery, unassignArgs, err := s.builder.Update("pull_request_reviewers r").
+		Set("unassigned_at", timeNow).
+		Set("unassign_reason", reason).
+		Where(squirrel.Eq{"r.unassigned_at": nil}).
+		Where(squirrel.Expr(
+			"(r.pull_request_id, r.user_id) in (select * from unnest(?::varchar[], ?::varchar[]))",
+			pullRequestIDs,
+			oldReviewerIDs,
+		)).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("unassign query builder: %w", err)
+	}
+
+	if _, err := s.querier.Exec(ctx, unassignQuery, unassignArgs...); err != nil {
+		return fmt.Errorf("unassign conn.Exec: %w", err)
+	}
+
+	assignQuery, assignArgs, err := s.builder.Insert("pull_request_reviewers").
+		Columns("pull_request_id", "user_id", "team_id", "is_fallback", "assigned_by", "reason", "assigned_at").
+		Select(
+			s.builder.Select(
+				"v.pull_request_id", "v.user_id", "v.team_id", "v.is_fallback", "v.assigned_by", "?", "?::timestamp",
+			).From(
+				"unnest(?::varchar[], ?::varchar[], ?::varchar[], ?::boolean[], ?::varchar[]) " +
+					"as v(pull_request_id, user_id, team_id, is_fallback, assigned_by)",
+			),
+		).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("assign query builder: %w", err)
+	}
+
+	// NOTE: плейсхолдеры в select идут раньше плейсхолдеров в from
+	assignArgs = append(
+		assignArgs,
+		reason,
+		timeNow,
+		pullRequestIDs,
+		newReviewerIDs,
+		teamIDs,
+		isFallbacks,
+		assignedBy,
+	)
+
+	if _, err := s.querier.Exec(ctx, assignQuery, assignArgs...); err != nil {
+		return fmt.Errorf("assign conn.Exec: %w", err)
+	}
+
+	return nil
+}
+
+// GetActivePullRequestsByReviewers возвращает PR в статусах OPEN и REOPENED,
+// где ревьювером назначен хотя бы один из пользователей.
+func (s *Storage) GetActivePullRequestsByReviewers(ctx context.Context, userIDs []string) ([]domain.PullRequest, error) {
+	if len(userIDs) == 0 {
+		return []domain.PullRequest{}, nil
+	}
+
+	query, args, err := s.builder.Select(
+		"pr.id",
+		"pr.author_id",
+		reviewersColumn,
+		"pr.status",
+		"t.id",
+		"t.name",
+		reviewerPoolsColumn,
+	).From("pull_requests pr").
+		LeftJoin("teams t on t.id = pr.team_id").
+		Where(squirrel.Eq{"pr.status": domain.ActivePullRequestStatuses}).
+		Where(squirrel.Expr(`exists (
+			select 1 from pull_request_reviewers r
+			where r.pull_request_id = pr.id and r.user_id = any(?::varchar[]) and r.unassigned_at is null
+		)`, userIDs)).
+		OrderBy("pr.created_at", "pr.id").
+		ToSql()
+
+	if err != nil {
+		return nil, fmt.Errorf("query builder: %w", err)
+	}
+
+	rows, err := s.querier.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("conn.Query: %w", err)
+	}
+
+	defer rows.Close()
+
+	pullRequests := []domain.PullRequest{}
+	for rows.Next() {
+		var (
+			pullRequest domain.PullRequest
+			teamID      sql.NullString
+			teamName    sql.NullString
+		)
+
+		if err := rows.Scan(
+			&pullRequest.ID,
+			&pullRequest.AuthorUserID,
+			&pullRequest.ReviewersUsersIDs,
+			&pullRequest.Status,
+			&teamID,
+			&teamName,
+			&pullRequest.ReviewerPools,
+		); err != nil {
+			return nil, fmt.Errorf("rows.Scan: %w", err)
+		}
+
+		pullRequest.TeamID = teamID.String
+		pullRequest.TeamName = teamName.String
+		pullRequests = append(pullRequests, pullRequest)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows.Err: %w", err)
+	}
+
+	return pullRequests, nil
+}
+
+// GetPullRequestsByReviewer возвращает PR всех статусов, где пользователь сейчас ревьювер.
 func (s *Storage) GetPullRequestsByReviewer(ctx context.Context, userID string) ([]domain.PullRequest, error) {
 	query, args, err := s.builder.Select(
-		"id",
-		"author_id",
-		"reviewers_ids",
-		"name",
-		"created_at",
-		"merged_at",
-		"status",
-	).From("pull_requests").
-		Where(squirrel.Expr("? = ANY(reviewers_ids)", userID)).
+		"pr.id",
+		"pr.author_id",
+		reviewersColumn,
+		"pr.name",
+		"pr.created_at",
+		"pr.merged_at",
+		"pr.status",
+		"pr.needs_more_reviewers",
+		"t.id",
+		"t.name",
+		reviewerPoolsColumn,
+	).From("pull_requests pr").
+		LeftJoin("teams t on t.id = pr.team_id").
+		Where(squirrel.Expr(`exists (
+			select 1 from pull_request_reviewers r
+			where r.pull_request_id = pr.id and r.user_id = ? and r.unassigned_at is null
+		)`, userID)).
 		ToSql()
 
 	if err != nil {
@@ -50,10 +392,15 @@ func (s *Storage) GetPullRequestsByReviewer(ctx context.Context, userID string)
 	if err != nil {
 		return nil, fmt.Errorf("conn.Query: %w", err)
 	}
+	defer rows.Close()
 
 	pullRequests := make([]domain.PullRequest, 0, 10)
 	for rows.Next() {
-		pullRequest := domain.PullRequest{}
+		var (
+			pullRequest domain.PullRequest
+			teamID      sql.NullString
+			teamName    sql.NullString
+		)
 
 		if err = rows.Scan(
 			&pullRequest.ID,
@@ -63,34 +410,164 @@ func (s *Storage) GetPullRequestsByReviewer(ctx context.Context, userID string)
 			&pullRequest.CreatedAt,
 			&pullRequest.MergedAt,
 			&pullRequest.Status,
+			&pullRequest.NeedsMoreReviewers,
+			&teamID,
+			&teamName,
+			&pullRequest.ReviewerPools,
 		); err != nil {
 			return nil, fmt.Errorf("rows.Scan: %w", err)
 		}
 
+		pullRequest.TeamID = teamID.String
+		pullRequest.TeamName = teamName.String
 		pullRequests = append(pullRequests, pullRequest)
 	}
 
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows.Err: %w", err)
+	}
+
 	return pullRequests, nil
 }
 
+// GetAssignedReviews возвращает до limit PR, где пользователь сейчас ревьювер, от дольше всех ждущих его ревью
+// к недавно назначенным: по времени назначения, затем по id PR. Страницы строятся по ключу сортировки (filter.After).
+func (s *Storage) GetAssignedReviews(
+	ctx context.Context,
+	filter domain.AssignedReviewFilter,
+	limit int,
+) ([]domain.AssignedReview, error) {
+	builder := s.builder.Select(
+		"pr.id",
+		"pr.author_id",
+		"pr.name",
+		"pr.status",
+		"r.assigned_at",
+	).From("pull_request_reviewers r").
+		Join("pull_requests pr on pr.id = r.pull_request_id").
+		Where(squirrel.Eq{
+			"r.user_id":       filter.UserID,
+			"r.unassigned_at": nil,
+			"pr.status":       filter.Statuses,
+		}).
+		OrderBy("r.assigned_at", "r.pull_request_id").
+		Limit(uint64(limit))
+
+	// NOTE: время курсора прочитано из assigned_at и передаётся обратно без перевода
+	if filter.After != nil {
+		builder = builder.Where(
+			squirrel.Expr("(r.assigned_at, r.pull_request_id) > (?::timestamp, ?)", filter.After.Time, filter.After.ID),
+		)
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("query builder: %w", err)
+	}
+
+	rows, err := s.querier.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("conn.Query: %w", err)
+	}
+	defer rows.Close()
+
+	reviews := []domain.AssignedReview{}
+	for rows.Next() {
+		var review domain.AssignedReview
+
+		if err := rows.Scan(
+			&review.PullRequest.ID,
+			&review.PullRequest.AuthorUserID,
+			&review.PullRequest.Name,
+			&review.PullRequest.Status,
+			&review.AssignedAt,
+		); err != nil {
+			return nil, fmt.Errorf("rows.Scan: %w", err)
+		}
+
+		reviews = append(reviews, review)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows.Err: %w", err)
+	}
+
+	return reviews, nil
+}
+
+// CountAssignedReviews считает PR, где пользователь сейчас ревьювер, по статусам.
+// Статусы без PR в результат не попадают.
+func (s *Storage) CountAssignedReviews(ctx context.Context, userID string) (map[domain.PullRequestStatus]int, error) {
+	query, args, err := s.builder.Select(
+		"pr.status",
+		"count(*)",
+	).From("pull_request_reviewers r").
+		Join("pull_requests pr on pr.id = r.pull_request_id").
+		Where(squirrel.Eq{
+			"r.user_id":       userID,
+			"r.unassigned_at": nil,
+		}).
+		GroupBy("pr.status").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("query builder: %w", err)
+	}
+
+	rows, err := s.querier.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("conn.Query: %w", err)
+	}
+	defer rows.Close()
+
+	counts := make(map[domain.PullRequestStatus]int)
+	for rows.Next() {
+		var (
+			status domain.PullRequestStatus
+			count  int
+		)
+
+		if err := rows.Scan(&status, &count); err != nil {
+			return nil, fmt.Errorf("rows.Scan: %w", err)
+		}
+
+		counts[status] = count
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows.Err: %w", err)
+	}
+
+	return counts, nil
+}
+
 func (s *Storage) GetPullRequestByID(ctx context.Context, prID string) (domain.PullRequest, error) {
 	query, args, err := s.builder.Select(
-		"id",
-		"author_id",
-		"reviewers_ids",
-		"name",
-		"created_at",
-		"merged_at",
-		"status",
-	).From("pull_requests").
-		Where(squirrel.Eq{"id": prID}).
+		"pr.id",
+		"pr.author_id",
+		reviewersColumn,
+		"pr.name",
+		"pr.created_at",
+		"pr.merged_at",
+		"pr.status",
+		"pr.needs_more_reviewers",
+		"pr.changed_files",
+		reviewerPoolsColumn,
+		"t.id",
+		"t.name",
+	).From("pull_requests pr").
+		LeftJoin("teams t on t.id = pr.team_id").
+		Where(squirrel.Eq{"pr.id": prID}).
 		ToSql()
 
 	if err != nil {
 		return domain.PullRequest{}, fmt.Errorf("query builder: %w", err)
 	}
 
-	pullRequest := domain.PullRequest{}
+	var (
+		pullRequest domain.PullRequest
+		teamID      sql.NullString
+		teamName    sql.NullString
+	)
 
 	if err := s.querier.QueryRow(ctx, query, args...).Scan(
 		&pullRequest.ID,
@@ -100,6 +577,11 @@ func (s *Storage) GetPullRequestByID(ctx context.Context, prID string) (domain.P
 		&pullRequest.CreatedAt,
 		&pullRequest.MergedAt,
 		&pullRequest.Status,
+		&pullRequest.NeedsMoreReviewers,
+		&pullRequest.ChangedFiles,
+		&pullRequest.ReviewerPools,
+		&teamID,
+		&teamName,
 	); err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return domain.PullRequest{}, domain.ErrPullRequestNotFound
@@ -108,40 +590,255 @@ func (s *Storage) GetPullRequestByID(ctx context.Context, prID string) (domain.P
 		return domain.PullRequest{}, fmt.Errorf("conn.QueryRow: %w", err)
 	}
 
+	pullRequest.TeamID = teamID.String
+	pullRequest.TeamName = teamName.String
+
 	return pullRequest, nil
 }
 
+// GetPullRequestsPastSLA возвращает PR в статусах OPEN и REOPENED, где хотя бы один ревьювер назначен раньше,
+// чем review_sla_hours команды PR до now. Это кандидаты в просроченные: рабочие часы ревьюверов здесь не учитываются.
+// Если teamID не пустой, возвращаются только PR этой команды.
+func (s *Storage) GetPullRequestsPastSLA(ctx context.Context, teamID string, now time.Time) ([]domain.PullRequest, error) {
+	builder := s.builder.Select(
+		"pr.id",
+		"pr.author_id",
+		reviewersColumn,
+		"pr.name",
+		"pr.created_at",
+		"pr.merged_at",
+		"pr.status",
+		"pr.needs_more_reviewers",
+		"pr.changed_files",
+		reviewerPoolsColumn,
+		"t.id",
+		"t.name",
+	).From("pull_requests pr").
+		Join("teams t on t.id = pr.team_id").
+		Where(squirrel.Eq{"pr.status": domain.ActivePullRequestStatuses}).
+		Where(squirrel.Gt{"t.review_sla_hours": 0}).
+		Where(squirrel.Expr(`exists (
+			select 1 from pull_request_reviewers r
+			where r.pull_request_id = pr.id and r.unassigned_at is null
+				and r.assigned_at <= ?::timestamp - make_interval(hours => t.review_sla_hours)
+		)`, now)).
+		OrderBy("pr.created_at", "pr.id")
+
+	if teamID != "" {
+		builder = builder.Where(squirrel.Eq{"pr.team_id": teamID})
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("query builder: %w", err)
+	}
+
+	rows, err := s.querier.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("conn.Query: %w", err)
+	}
+	defer rows.Close()
+
+	pullRequests := []domain.PullRequest{}
+	for rows.Next() {
+		var pullRequest domain.PullRequest
+
+		if err := rows.Scan(
+			&pullRequest.ID,
+			&pullRequest.AuthorUserID,
+			&pullRequest.ReviewersUsersIDs,
+			&pullRequest.Name,
+			&pullRequest.CreatedAt,
+			&pullRequest.MergedAt,
+			&pullRequest.Status,
+			&pullRequest.NeedsMoreReviewers,
+			&pullRequest.ChangedFiles,
+			&pullRequest.ReviewerPools,
+			&pullRequest.TeamID,
+			&pullRequest.TeamName,
+		); err != nil {
+			return nil, fmt.Errorf("rows.Scan: %w", err)
+		}
+
+		pullRequests = append(pullRequests, pullRequest)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows.Err: %w", err)
+	}
+
+	return pullRequests, nil
+}
+
+// ListPullRequests возвращает до limit PR, подходящих под filter, от новых к старым:
+// по времени создания, затем по id. Страницы строятся по ключу сортировки (filter.After),
+// поэтому PR, созданные во время листания, не сдвигают следующие страницы.
+func (s *Storage) ListPullRequests(
+	ctx context.Context,
+	filter domain.PullRequestFilter,
+	limit int,
+) ([]domain.PullRequest, error) {
+	builder := s.builder.Select(
+		"pr.id",
+		"pr.author_id",
+		reviewersColumn,
+		"pr.name",
+		"pr.created_at",
+		"pr.merged_at",
+		"pr.status",
+		"pr.needs_more_reviewers",
+		"pr.changed_files",
+		reviewerPoolsColumn,
+		"t.id",
+		"t.name",
+	).From("pull_requests pr").
+		LeftJoin("teams t on t.id = pr.team_id").
+		OrderBy("pr.created_at desc", "pr.id desc").
+		Limit(uint64(limit))
+
+	builder = wherePullRequestFilter(builder, filter)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("query builder: %w", err)
+	}
+
+	rows, err := s.querier.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("conn.Query: %w", err)
+	}
+	defer rows.Close()
+
+	pullRequests := []domain.PullRequest{}
+	for rows.Next() {
+		var (
+			pullRequest domain.PullRequest
+			teamID      sql.NullString
+			teamName    sql.NullString
+		)
+
+		if err := rows.Scan(
+			&pullRequest.ID,
+			&pullRequest.AuthorUserID,
+			&pullRequest.ReviewersUsersIDs,
+			&pullRequest.Name,
+			&pullRequest.CreatedAt,
+			&pullRequest.MergedAt,
+			&pullRequest.Status,
+			&pullRequest.NeedsMoreReviewers,
+			&pullRequest.ChangedFiles,
+			&pullRequest.ReviewerPools,
+			&teamID,
+			&teamName,
+		); err != nil {
+			return nil, fmt.Errorf("rows.Scan: %w", err)
+		}
+
+		pullRequest.TeamID = teamID.String
+		pullRequest.TeamName = teamName.String
+		pullRequests = append(pullRequests, pullRequest)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows.Err: %w", err)
+	}
+
+	return pullRequests, nil
+}
+
+// wherePullRequestFilter добавляет в выборку PR условия filter.
+func wherePullRequestFilter(builder squirrel.SelectBuilder, filter domain.PullRequestFilter) squirrel.SelectBuilder {
+	if len(filter.Statuses) > 0 {
+		builder = builder.Where(squirrel.Eq{"pr.status": filter.Statuses})
+	}
+
+	if filter.AuthorID != "" {
+		builder = builder.Where(squirrel.Eq{"pr.author_id": filter.AuthorID})
+	}
+
+	if filter.TeamID != "" {
+		builder = builder.Where(squirrel.Eq{"pr.team_id": filter.TeamID})
+	}
+
+	if filter.ReviewerID != "" {
+		builder = builder.Where(squirrel.Expr(`exists (
+			select 1 from pull_request_reviewers r
+			where r.pull_request_id = pr.id and r.user_id = ? and r.unassigned_at is null
+		)`, filter.ReviewerID))
+	}
+
+	// NOTE: created_at и merged_at хранят время UTC без пояса, поэтому границы переводятся в UTC
+	if filter.CreatedFrom != nil {
+		builder = builder.Where(squirrel.GtOrEq{"pr.created_at": filter.CreatedFrom.UTC()})
+	}
+
+	if filter.CreatedTo != nil {
+		builder = builder.Where(squirrel.Lt{"pr.created_at": filter.CreatedTo.UTC()})
+	}
+
+	if filter.MergedFrom != nil {
+		builder = builder.Where(squirrel.GtOrEq{"pr.merged_at": filter.MergedFrom.UTC()})
+	}
+
+	if filter.MergedTo != nil {
+		builder = builder.Where(squirrel.Lt{"pr.merged_at": filter.MergedTo.UTC()})
+	}
+
+	// NOTE: время курсора прочитано из created_at и передаётся обратно без перевода
+	if filter.After != nil {
+		builder = builder.Where(
+			squirrel.Expr("(pr.created_at, pr.id) < (?::timestamp, ?)", filter.After.Time, filter.After.ID),
+		)
+	}
+
+	return builder
+}
+
+// CreatePullRequest создаёт PR команды team без ревьюверов, они назначаются через AssignPullRequestReviewers.
 func (s *Storage) CreatePullRequest(
 	ctx context.Context,
 	request domain.CreatePullRequestRequest,
-	reviewersIDs []string,
+	team domain.Team,
+	needsMoreReviewers bool,
 ) (pr domain.PullRequest, err error) {
-	timeNow := time.Now()
+	timeNow := s.clock.Now()
 	pr.ID = request.ID
 	pr.AuthorUserID = request.AuthorUserID
-	pr.ReviewersUsersIDs = reviewersIDs
+	pr.TeamID = team.ID
+	pr.TeamName = team.Name
+	pr.ReviewersUsersIDs = []string{}
 	pr.Name = request.Name
 	pr.CreatedAt = &timeNow
 	pr.Status = domain.StatusOpen
+	pr.NeedsMoreReviewers = needsMoreReviewers
+	pr.ChangedFiles = lo.Ternary(request.ChangedFiles != nil, request.ChangedFiles, []string{})
+
+	if request.Draft {
+		pr.Status = domain.StatusDraft
+	}
 
 	query, args, err := s.builder.Insert("pull_requests").
 		Columns(
 			"id",
 			"author_id",
-			"reviewers_ids",
 			"name",
 			"created_at",
 			"merged_at",
 			"status",
+			"needs_more_reviewers",
+			"changed_files",
+			"team_id",
 		).
 		Values(
 			pr.ID,
 			pr.AuthorUserID,
-			pr.ReviewersUsersIDs,
 			pr.Name,
 			pr.CreatedAt,
 			pr.MergedAt,
 			pr.Status,
+			pr.NeedsMoreReviewers,
+			pr.ChangedFiles,
+			pr.TeamID,
 		).
 		ToSql()
 
@@ -166,7 +863,7 @@ func (s *Storage) UpdatePullRequestStatus(ctx context.Context, prID string, newS
 		Where(squirrel.Eq{"id": prID})
 
 	if newStatus == domain.StatusMerged {
-		builder = builder.Set("merged_at", time.Now())
+		builder = builder.Set("merged_at", s.clock.Now())
 	}
 
 	query, args, err := builder.ToSql()
@@ -181,3 +878,55 @@ func (s *Storage) UpdatePullRequestStatus(ctx context.Context, prID string, newS
 
 	return nil
 }
+
+// GetOpenReviewsCountByUsers считает активные (OPEN и REOPENED) PR, где каждый из пользователей назначен ревьювером.
+// Пользователи без открытых ревью в результат не попадают.
+func (s *Storage) GetOpenReviewsCountByUsers(ctx context.Context, userIDs []string) (map[string]int, error) {
+	counts := make(map[string]int, len(userIDs))
+	if len(userIDs) == 0 {
+		return counts, nil
+	}
+
+	query, args, err := s.builder.Select(
+		"r.user_id",
+		"count(*)",
+	).From("pull_request_reviewers r").
+		Join("pull_requests pr on pr.id = r.pull_request_id").
+		Where(squirrel.Eq{
+			"r.user_id":       userIDs,
+			"r.unassigned_at": nil,
+			"pr.status":       domain.ActivePullRequestStatuses,
+		}).
+		GroupBy("r.user_id").
+		ToSql()
+
+	if err != nil {
+		return nil, fmt.Errorf("query builder: %w", err)
+	}
+
+	rows, err := s.querier.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("conn.Query: %w", err)
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			userID string
+			count  int
+		)
+
+		if err := rows.Scan(&userID, &count); err != nil {
+			return nil, fmt.Errorf("rows.Scan: %w", err)
+		}
+
+		counts[userID] = count
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows.Err: %w", err)
+	}
+
+	return counts, nil
+}
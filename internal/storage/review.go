@@ -0,0 +1,64 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	"pr-manager-service/internal/domain"
+
+	"github.com/Masterminds/squirrel"
+)
+
+func (s *Storage) CreatePullRequestReview(ctx context.Context, request domain.SubmitReviewRequest) (domain.Review, error) {
+	review := domain.Review{
+		PullRequestID: request.PullRequestID,
+		UserID:        request.UserID,
+		Verdict:       request.Verdict,
+		Comment:       request.Comment,
+		SubmittedAt:   s.clock.Now(),
+	}
+
+	query, args, err := s.builder.Insert("pull_request_reviews").
+		Columns("pull_request_id", "user_id", "verdict", "comment", "submitted_at").
+		Values(review.PullRequestID, review.UserID, review.Verdict, review.Comment, review.SubmittedAt).
+		Suffix("returning id").
+		ToSql()
+	if err != nil {
+		return domain.Review{}, fmt.Errorf("query builder: %w", err)
+	}
+
+	if err := s.querier.QueryRow(ctx, query, args...).Scan(&review.ID); err != nil {
+		return domain.Review{}, fmt.Errorf("conn.QueryRow: %w", err)
+	}
+
+	return review, nil
+}
+
+// CountPullRequestApprovals считает текущих ревьюверов PR, последнее решение которых - APPROVE.
+// Комментарии решение не меняют, решения снятых с PR ревьюверов не учитываются.
+func (s *Storage) CountPullRequestApprovals(ctx context.Context, prID string) (int, error) {
+	lastVerdicts := s.builder.Select("distinct on (r.user_id) r.verdict").
+		From("pull_request_reviews r").
+		Join("pull_request_reviewers a on a.pull_request_id = r.pull_request_id and a.user_id = r.user_id").
+		Where(squirrel.Eq{
+			"r.pull_request_id": prID,
+			"a.unassigned_at":   nil,
+		}).
+		Where(squirrel.NotEq{"r.verdict": domain.VerdictComment}).
+		OrderBy("r.user_id", "r.id desc")
+
+	query, args, err := s.builder.Select("count(*)").
+		FromSelect(lastVerdicts, "v").
+		Where(squirrel.Eq{"v.verdict": domain.VerdictApprove}).
+		ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("query builder: %w", err)
+	}
+
+	var approvals int
+	if err := s.querier.QueryRow(ctx, query, args...).Scan(&approvals); err != nil {
+		return 0, fmt.Errorf("conn.QueryRow: %w", err)
+	}
+
+	return approvals, nil
+}
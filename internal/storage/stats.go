@@ -16,11 +16,12 @@ func (s *Storage) UserStatsCreateBatch(ctx context.Context, userIDs []string) er
 
 	builder := s.builder.
 		Insert("users_stats").
-		Columns("user_id").
+		Columns("user_id", "updated_at").
 		Suffix("on conflict (user_id) do nothing")
 
+	timeNow := s.clock.Now()
 	for _, id := range userIDs {
-		builder = builder.Values(id)
+		builder = builder.Values(id, timeNow)
 	}
 
 	query, args, err := builder.ToSql()
@@ -35,34 +36,16 @@ func (s *Storage) UserStatsCreateBatch(ctx context.Context, userIDs []string) er
 	return nil
 }
 
-func (s *Storage) PullRequestStatsCreate(ctx context.Context, pullRequestID string, assignmentsCount int) error {
-	query, args, err := s.builder.
-		Insert("pull_requests_stats").
-		Columns("pull_request_id", "assignments_count").
-		Values(pullRequestID, assignmentsCount).
-		Suffix("on conflict (pull_request_id) do nothing").ToSql()
-
-	if err != nil {
-		return fmt.Errorf("query builder: %w", err)
-	}
-
-	if _, err := s.querier.Exec(ctx, query, args...); err != nil {
-		return fmt.Errorf("Exec: %w", err)
-	}
-
-	return nil
-}
-
-func (s *Storage) UserAssignmentsIncrementBatch(ctx context.Context, userIDs []string) error {
+func (s *Storage) UserStatusChangesIncrementBatch(ctx context.Context, userIDs []string) error {
 	if len(userIDs) == 0 {
 		return nil
 	}
 
 	query, args, err := s.builder.
 		Update("users_stats").
-		Set("assignments_count", squirrel.Expr("assignments_count + 1")).
-		Set("updated_at", time.Now()).
-		Where(squirrel.Eq{"user_id": userIDs}). // user_id IN (...)
+		Set("status_changes_count", squirrel.Expr("status_changes_count + 1")).
+		Set("updated_at", s.clock.Now()).
+		Where(squirrel.Eq{"user_id": userIDs}). // IN (...)
 		ToSql()
 
 	if err != nil {
@@ -76,54 +59,93 @@ func (s *Storage) UserAssignmentsIncrementBatch(ctx context.Context, userIDs []s
 	return nil
 }
 
-func (s *Storage) UserStatusChangesIncrementBatch(ctx context.Context, userIDs []string) error {
+// GetUsersLastAssignedAt возвращает время последнего назначения ревьювером с учётом назначений до истории
+// назначений (users_stats.legacy_last_assigned_at). Пользователи, которых ещё ни разу не назначали,
+// в результат не попадают.
+func (s *Storage) GetUsersLastAssignedAt(ctx context.Context, userIDs []string) (map[string]time.Time, error) {
+	lastAssignedAt := make(map[string]time.Time, len(userIDs))
 	if len(userIDs) == 0 {
-		return nil
+		return lastAssignedAt, nil
 	}
 
-	query, args, err := s.builder.
-		Update("users_stats").
-		Set("status_changes_count", squirrel.Expr("status_changes_count + 1")).
-		Set("updated_at", time.Now()).
-		Where(squirrel.Eq{"user_id": userIDs}). // IN (...)
-		ToSql()
+	assignedQuery := s.builder.Select(
+		"user_id",
+		"max(assigned_at)",
+	).
+		From("pull_request_reviewers").
+		Where(squirrel.Eq{"user_id": userIDs}).
+		GroupBy("user_id")
 
-	if err != nil {
-		return fmt.Errorf("query builder: %w", err)
-	}
+	legacyQuery := s.builder.Select(
+		"user_id",
+		"legacy_last_assigned_at",
+	).
+		From("users_stats").
+		Where(squirrel.Eq{"user_id": userIDs}).
+		Where(squirrel.NotEq{"legacy_last_assigned_at": nil})
 
-	if _, err := s.querier.Exec(ctx, query, args...); err != nil {
-		return fmt.Errorf("Exec: %w", err)
+	for _, builder := range []squirrel.SelectBuilder{assignedQuery, legacyQuery} {
+		query, args, err := builder.ToSql()
+		if err != nil {
+			return nil, fmt.Errorf("query builder: %w", err)
+		}
+
+		if err := s.collectLastAssignedAt(ctx, query, args, lastAssignedAt); err != nil {
+			return nil, err
+		}
 	}
 
-	return nil
+	return lastAssignedAt, nil
 }
 
-func (s *Storage) PullRequestAssignmentsIncrement(ctx context.Context, pullRequestID string) error {
-	query, args, err := s.builder.Update("pull_requests_stats").
-		Set("assignments_count", squirrel.Expr("assignments_count + 1")).
-		Set("updated_at", time.Now()).
-		Where(squirrel.Eq{"pull_request_id": pullRequestID}).
-		ToSql()
-
+// collectLastAssignedAt дополняет lastAssignedAt парами (user_id, время назначения) из запроса,
+// оставляя для пользователя более позднее время.
+func (s *Storage) collectLastAssignedAt(
+	ctx context.Context,
+	query string,
+	args []any,
+	lastAssignedAt map[string]time.Time,
+) error {
+	rows, err := s.querier.Query(ctx, query, args...)
 	if err != nil {
-		return fmt.Errorf("query builder: %w", err)
+		return fmt.Errorf("Query: %w", err)
 	}
 
-	if _, err := s.querier.Exec(ctx, query, args...); err != nil {
-		return fmt.Errorf("Exec: %w", err)
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			userID     string
+			assignedAt time.Time
+		)
+
+		if err := rows.Scan(&userID, &assignedAt); err != nil {
+			return fmt.Errorf("Scan: %w", err)
+		}
+
+		if assignedAt.After(lastAssignedAt[userID]) {
+			lastAssignedAt[userID] = assignedAt
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("Err: %w", err)
 	}
 
 	return nil
 }
 
+// GetUsersStats считает назначения пользователей ревьюверами по истории назначений, включая снятые,
+// и назначениям до истории (legacy_assignments_count).
 func (s *Storage) GetUsersStats(ctx context.Context) (userStats []domain.UserStats, err error) {
 	userStatsQuery, userStatsArgs, err := s.builder.Select(
-		"user_id",
-		"assignments_count",
-		"status_changes_count",
+		"us.user_id",
+		"us.legacy_assignments_count + count(r.id)",
+		"us.status_changes_count",
 	).
-		From("users_stats").
+		From("users_stats us").
+		LeftJoin("pull_request_reviewers r on r.user_id = us.user_id").
+		GroupBy("us.user_id", "us.status_changes_count", "us.legacy_assignments_count").
 		ToSql()
 
 	if err != nil {
@@ -158,12 +180,16 @@ func (s *Storage) GetUsersStats(ctx context.Context) (userStats []domain.UserSta
 	return userStats, nil
 }
 
+// GetPullRequestsStats считает назначения ревьюверов в каждый PR по истории назначений, включая снятые,
+// и назначениям до истории (legacy_assignments_count).
 func (s *Storage) GetPullRequestsStats(ctx context.Context) (pullRequestsStats []domain.PullRequestStats, err error) {
 	pullRequestsStatsQuery, pullRequestsStatsArgs, err := s.builder.Select(
-		"pull_request_id",
-		"assignments_count",
+		"pr.id",
+		"pr.legacy_assignments_count + count(r.id)",
 	).
-		From("pull_requests_stats").
+		From("pull_requests pr").
+		LeftJoin("pull_request_reviewers r on r.pull_request_id = pr.id").
+		GroupBy("pr.id").
 		ToSql()
 
 	if err != nil {
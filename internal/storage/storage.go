@@ -2,8 +2,11 @@ package storage
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 
+	"pr-manager-service/internal/clock"
+
 	"github.com/Masterminds/squirrel"
 	"github.com/jackc/pgconn"
 	"github.com/jackc/pgx/v4"
@@ -12,12 +15,15 @@ import (
 type Storage struct {
 	querier querier
 	builder squirrel.StatementBuilderType
+	// clock - источник всех меток времени, которые записываются в БД
+	clock clock.Clock
 }
 
-func NewStorage(conn querier) *Storage {
+func NewStorage(conn querier, clock clock.Clock) *Storage {
 	return &Storage{
 		querier: conn,
 		builder: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+		clock:   clock,
 	}
 }
 
@@ -32,3 +38,10 @@ func isUniqueViolation(err error) bool {
 	var pgErr *pgconn.PgError
 	return errors.As(err, &pgErr) && pgErr.Code == "23505"
 }
+
+func nullString(value string) sql.NullString {
+	return sql.NullString{
+		String: value,
+		Valid:  value != "",
+	}
+}
@@ -13,16 +13,46 @@ import (
 )
 
 func (s *Storage) GetTeamByName(ctx context.Context, teamName string) (domain.Team, error) {
-	q, args, err := s.builder.Select("id", "name").
+	return s.getTeam(ctx, squirrel.Eq{"name": teamName})
+}
+
+func (s *Storage) GetTeamByID(ctx context.Context, teamID string) (domain.Team, error) {
+	return s.getTeam(ctx, squirrel.Eq{"id": teamID})
+}
+
+func (s *Storage) getTeam(ctx context.Context, where squirrel.Sqlizer) (domain.Team, error) {
+	q, args, err := s.builder.Select(
+		"id",
+		"name",
+		"reviewer_strategy",
+		"min_reviewers",
+		"max_reviewers",
+		"required_approvals",
+		"prefer_working_hours",
+		"review_sla_hours",
+	).
 		From("teams").
-		Where(squirrel.Eq{"name": teamName}).
+		Where(where).
 		ToSql()
 	if err != nil {
 		return domain.Team{}, fmt.Errorf("query builder: %w", err)
 	}
 
-	team := domain.Team{}
-	if err := s.querier.QueryRow(ctx, q, args...).Scan(&team.ID, &team.Name); err != nil {
+	var (
+		team             domain.Team
+		reviewerStrategy sql.NullString
+	)
+
+	if err := s.querier.QueryRow(ctx, q, args...).Scan(
+		&team.ID,
+		&team.Name,
+		&reviewerStrategy,
+		&team.MinReviewers,
+		&team.MaxReviewers,
+		&team.RequiredApprovals,
+		&team.PreferWorkingHours,
+		&team.ReviewSLAHours,
+	); err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return domain.Team{}, domain.ErrTeamNotFound
 		}
@@ -30,13 +60,73 @@ func (s *Storage) GetTeamByName(ctx context.Context, teamName string) (domain.Te
 		return domain.Team{}, fmt.Errorf("conn.QueryRow: %w", err)
 	}
 
+	team.ReviewerStrategy = domain.ReviewerStrategy(reviewerStrategy.String)
+
 	return team, nil
 }
 
+// GetTeamReviewerCursor возвращает идентификатор последнего назначенного по кругу ревьювера.
+// Строка команды блокируется до конца транзакции, чтобы параллельные назначения не выбрали одних и тех же людей.
+func (s *Storage) GetTeamReviewerCursor(ctx context.Context, teamID string) (string, error) {
+	q, args, err := s.builder.Select("reviewer_cursor").
+		From("teams").
+		Where(squirrel.Eq{"id": teamID}).
+		Suffix("for update").
+		ToSql()
+	if err != nil {
+		return "", fmt.Errorf("query builder: %w", err)
+	}
+
+	var cursor sql.NullString
+	if err := s.querier.QueryRow(ctx, q, args...).Scan(&cursor); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", domain.ErrTeamNotFound
+		}
+
+		return "", fmt.Errorf("conn.QueryRow: %w", err)
+	}
+
+	return cursor.String, nil
+}
+
+func (s *Storage) UpdateTeamReviewerCursor(ctx context.Context, teamID, userID string) error {
+	q, args, err := s.builder.Update("teams").
+		Set("reviewer_cursor", userID).
+		Where(squirrel.Eq{"id": teamID}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("query builder: %w", err)
+	}
+
+	if _, err := s.querier.Exec(ctx, q, args...); err != nil {
+		return fmt.Errorf("conn.Exec: %w", err)
+	}
+
+	return nil
+}
+
 func (s *Storage) CreateTeam(ctx context.Context, request domain.CreateTeamRequest, teamID string) error {
 	insertTeamsQuery, insertTeamsArgs, err := s.builder.Insert("teams").
-		Columns("id", "name").
-		Values(teamID, request.Name).
+		Columns(
+			"id",
+			"name",
+			"reviewer_strategy",
+			"min_reviewers",
+			"max_reviewers",
+			"required_approvals",
+			"prefer_working_hours",
+			"review_sla_hours",
+		).
+		Values(
+			teamID,
+			request.Name,
+			nullString(string(request.ReviewerStrategy)),
+			request.MinReviewers,
+			request.MaxReviewers,
+			request.RequiredApprovals,
+			request.PreferWorkingHours,
+			request.ReviewSLAHours,
+		).
 		ToSql()
 
 	if err != nil {
@@ -54,16 +144,68 @@ func (s *Storage) CreateTeam(ctx context.Context, request domain.CreateTeamReque
 	return nil
 }
 
+// UpdateTeamSettings записывает настройки команды из запроса; nil-поля запроса не меняются.
+func (s *Storage) UpdateTeamSettings(ctx context.Context, request domain.UpdateTeamSettingsRequest) error {
+	builder := s.builder.Update("teams").
+		Set("min_reviewers", request.MinReviewers).
+		Set("max_reviewers", request.MaxReviewers).
+		Where(squirrel.Eq{"name": request.TeamName})
+
+	if request.ReviewerStrategy != nil {
+		builder = builder.Set("reviewer_strategy", nullString(string(*request.ReviewerStrategy)))
+	}
+
+	if request.RequiredApprovals != nil {
+		builder = builder.Set("required_approvals", *request.RequiredApprovals)
+	}
+
+	if request.PreferWorkingHours != nil {
+		builder = builder.Set("prefer_working_hours", *request.PreferWorkingHours)
+	}
+
+	if request.ReviewSLAHours != nil {
+		builder = builder.Set("review_sla_hours", *request.ReviewSLAHours)
+	}
+
+	q, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("query builder: %w", err)
+	}
+
+	tag, err := s.querier.Exec(ctx, q, args...)
+	if err != nil {
+		return fmt.Errorf("conn.Exec: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return domain.ErrTeamNotFound
+	}
+
+	return nil
+}
+
 func (s *Storage) GetTeamFullByName(ctx context.Context, teamName string) (domain.Team, []domain.User, error) {
 	query, args, err := s.builder.Select(
 		"t.id as team_id",
 		"t.name as team_name",
+		"t.reviewer_strategy as reviewer_strategy",
+		"t.min_reviewers as min_reviewers",
+		"t.max_reviewers as max_reviewers",
+		"t.required_approvals as required_approvals",
+		"t.prefer_working_hours as prefer_working_hours",
+		"t.review_sla_hours as review_sla_hours",
 		"u.id as user_id",
 		"u.name as username",
 		"u.is_active as is_active",
+		membershipsColumn,
+		"u.timezone as timezone",
+		"u.work_start_minute as work_start_minute",
+		"u.work_end_minute as work_end_minute",
 	).From("teams t").
-		LeftJoin("users u on t.id = u.team_id").
+		LeftJoin("team_memberships tm on tm.team_id = t.id").
+		LeftJoin("users u on u.id = tm.user_id").
 		Where(squirrel.Eq{"t.name": teamName}).
+		OrderBy("tm.joined_at", "u.id").
 		ToSql()
 
 	if err != nil {
@@ -89,37 +231,64 @@ func (s *Storage) GetTeamFullByName(ctx context.Context, teamName string) (domai
 
 	for rows.Next() {
 		var (
-			teamID   string
-			teamName string
+			teamID             string
+			teamName           string
+			reviewerStrategy   sql.NullString
+			minReviewers       int
+			maxReviewers       int
+			requiredApprovals  int
+			preferWorkingHours bool
+			reviewSLAHours     int
 
-			userID   sql.NullString
-			username sql.NullString
-			isActive sql.NullBool
+			userID             sql.NullString
+			username           sql.NullString
+			isActive           sql.NullBool
+			teams              []domain.TeamMembership
+			timezone           sql.NullString
+			workStart, workEnd sql.NullInt16
 		)
 
 		if err := rows.Scan(
 			&teamID,
 			&teamName,
+			&reviewerStrategy,
+			&minReviewers,
+			&maxReviewers,
+			&requiredApprovals,
+			&preferWorkingHours,
+			&reviewSLAHours,
 			&userID,
 			&username,
 			&isActive,
+			&teams,
+			&timezone,
+			&workStart,
+			&workEnd,
 		); err != nil {
 			return domain.Team{}, []domain.User{}, fmt.Errorf("rows.Scan: %w", err)
 		}
 
 		if team == zeroValueTeam {
 			team = domain.Team{
-				ID:   teamID,
-				Name: teamName,
+				ID:                 teamID,
+				Name:               teamName,
+				ReviewerStrategy:   domain.ReviewerStrategy(reviewerStrategy.String),
+				MinReviewers:       minReviewers,
+				MaxReviewers:       maxReviewers,
+				RequiredApprovals:  requiredApprovals,
+				PreferWorkingHours: preferWorkingHours,
+				ReviewSLAHours:     reviewSLAHours,
 			}
 		}
 
 		if userID.Valid {
 			users = append(users, domain.User{
-				ID:       userID.String,
-				Name:     username.String,
-				IsActive: isActive.Bool,
-				TeamID:   teamID,
+				ID:           userID.String,
+				Name:         username.String,
+				IsActive:     isActive.Bool,
+				Teams:        teams,
+				Timezone:     timezone.String,
+				WorkingHours: workingHours(workStart, workEnd),
 			})
 		}
 	}
@@ -130,3 +299,255 @@ func (s *Storage) GetTeamFullByName(ctx context.Context, teamName string) (domai
 
 	return team, users, nil
 }
+
+// GetTeamFallbacks возвращает резервные команды в порядке приоритета.
+func (s *Storage) GetTeamFallbacks(ctx context.Context, teamID string) ([]domain.Team, error) {
+	query, args, err := s.builder.Select(
+		"t.id",
+		"t.name",
+		"t.reviewer_strategy",
+		"t.min_reviewers",
+		"t.max_reviewers",
+		"t.required_approvals",
+		"t.prefer_working_hours",
+		"t.review_sla_hours",
+	).From("team_fallbacks tf").
+		Join("teams t on t.id = tf.fallback_team_id").
+		Where(squirrel.Eq{"tf.team_id": teamID}).
+		OrderBy("tf.priority").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("query builder: %w", err)
+	}
+
+	rows, err := s.querier.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("conn.Query: %w", err)
+	}
+	defer rows.Close()
+
+	teams := []domain.Team{}
+	for rows.Next() {
+		var (
+			team             domain.Team
+			reviewerStrategy sql.NullString
+		)
+
+		if err := rows.Scan(
+			&team.ID,
+			&team.Name,
+			&reviewerStrategy,
+			&team.MinReviewers,
+			&team.MaxReviewers,
+			&team.RequiredApprovals,
+			&team.PreferWorkingHours,
+			&team.ReviewSLAHours,
+		); err != nil {
+			return nil, fmt.Errorf("rows.Scan: %w", err)
+		}
+
+		team.ReviewerStrategy = domain.ReviewerStrategy(reviewerStrategy.String)
+		teams = append(teams, team)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows.Err: %w", err)
+	}
+
+	return teams, nil
+}
+
+// SetTeamFallbacks заменяет список резервных команд; приоритет задаётся порядком fallbackTeamIDs.
+func (s *Storage) SetTeamFallbacks(ctx context.Context, teamID string, fallbackTeamIDs []string) error {
+	deleteQuery, deleteArgs, err := s.builder.Delete("team_fallbacks").
+		Where(squirrel.Eq{"team_id": teamID}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("delete query builder: %w", err)
+	}
+
+	if _, err := s.querier.Exec(ctx, deleteQuery, deleteArgs...); err != nil {
+		return fmt.Errorf("conn.Exec: %w", err)
+	}
+
+	if len(fallbackTeamIDs) == 0 {
+		return nil
+	}
+
+	builder := s.builder.Insert("team_fallbacks").
+		Columns("team_id", "fallback_team_id", "priority")
+
+	for priority, fallbackTeamID := range fallbackTeamIDs {
+		builder = builder.Values(teamID, fallbackTeamID, priority)
+	}
+
+	insertQuery, insertArgs, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("insert query builder: %w", err)
+	}
+
+	if _, err := s.querier.Exec(ctx, insertQuery, insertArgs...); err != nil {
+		return fmt.Errorf("conn.Exec: %w", err)
+	}
+
+	return nil
+}
+
+// GetTeamOwnerRules возвращает правила владения путями в порядке их задания.
+func (s *Storage) GetTeamOwnerRules(ctx context.Context, teamID string) ([]domain.OwnerRule, error) {
+	query, args, err := s.builder.Select("pattern", "owners").
+		From("team_owner_rules").
+		Where(squirrel.Eq{"team_id": teamID}).
+		OrderBy("position").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("query builder: %w", err)
+	}
+
+	rows, err := s.querier.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("conn.Query: %w", err)
+	}
+	defer rows.Close()
+
+	rules := []domain.OwnerRule{}
+	for rows.Next() {
+		var rule domain.OwnerRule
+
+		if err := rows.Scan(&rule.Pattern, &rule.Owners); err != nil {
+			return nil, fmt.Errorf("rows.Scan: %w", err)
+		}
+
+		rules = append(rules, rule)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows.Err: %w", err)
+	}
+
+	return rules, nil
+}
+
+// SetTeamOwnerRules заменяет правила владения путями команды.
+func (s *Storage) SetTeamOwnerRules(ctx context.Context, teamID string, rules []domain.OwnerRule) error {
+	deleteQuery, deleteArgs, err := s.builder.Delete("team_owner_rules").
+		Where(squirrel.Eq{"team_id": teamID}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("delete query builder: %w", err)
+	}
+
+	if _, err := s.querier.Exec(ctx, deleteQuery, deleteArgs...); err != nil {
+		return fmt.Errorf("conn.Exec: %w", err)
+	}
+
+	if len(rules) == 0 {
+		return nil
+	}
+
+	builder := s.builder.Insert("team_owner_rules").
+		Columns("team_id", "position", "pattern", "owners")
+
+	for position, rule := range rules {
+		builder = builder.Values(teamID, position, rule.Pattern, rule.Owners)
+	}
+
+	insertQuery, insertArgs, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("insert query builder: %w", err)
+	}
+
+	if _, err := s.querier.Exec(ctx, insertQuery, insertArgs...); err != nil {
+		return fmt.Errorf("conn.Exec: %w", err)
+	}
+
+	return nil
+}
+
+// RenameTeam меняет название команды и ссылки на неё в правилах владения путями других команд.
+func (s *Storage) RenameTeam(ctx context.Context, team domain.Team, newName string) error {
+	renameQuery, renameArgs, err := s.builder.Update("teams").
+		Set("name", newName).
+		Where(squirrel.Eq{"id": team.ID}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("rename query builder: %w", err)
+	}
+
+	tag, err := s.querier.Exec(ctx, renameQuery, renameArgs...)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return domain.ErrTeamExists
+		}
+
+		return fmt.Errorf("conn.Exec: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return domain.ErrTeamNotFound
+	}
+
+	oldOwner := domain.OwnerTeamPrefix + team.Name
+	newOwner := domain.OwnerTeamPrefix + newName
+
+	ownersQuery, ownersArgs, err := s.builder.Update("team_owner_rules").
+		Set("owners", squirrel.Expr("array_replace(owners, ?::varchar, ?::varchar)", oldOwner, newOwner)).
+		Where(squirrel.Expr("?::varchar = any(owners)", oldOwner)).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("owners query builder: %w", err)
+	}
+
+	if _, err := s.querier.Exec(ctx, ownersQuery, ownersArgs...); err != nil {
+		return fmt.Errorf("conn.Exec: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteTeam удаляет команду вместе с её резервными командами и правилами владения путями.
+// Команда удаляется и из списков резервных команд других команд.
+func (s *Storage) DeleteTeam(ctx context.Context, teamID string) error {
+	deleteFallbacksQuery, deleteFallbacksArgs, err := s.builder.Delete("team_fallbacks").
+		Where(squirrel.Or{
+			squirrel.Eq{"team_id": teamID},
+			squirrel.Eq{"fallback_team_id": teamID},
+		}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("delete fallbacks query builder: %w", err)
+	}
+
+	if _, err := s.querier.Exec(ctx, deleteFallbacksQuery, deleteFallbacksArgs...); err != nil {
+		return fmt.Errorf("conn.Exec: %w", err)
+	}
+
+	deleteRulesQuery, deleteRulesArgs, err := s.builder.Delete("team_owner_rules").
+		Where(squirrel.Eq{"team_id": teamID}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("delete owner rules query builder: %w", err)
+	}
+
+	if _, err := s.querier.Exec(ctx, deleteRulesQuery, deleteRulesArgs...); err != nil {
+		return fmt.Errorf("conn.Exec: %w", err)
+	}
+
+	deleteTeamQuery, deleteTeamArgs, err := s.builder.Delete("teams").
+		Where(squirrel.Eq{"id": teamID}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("delete team query builder: %w", err)
+	}
+
+	tag, err := s.querier.Exec(ctx, deleteTeamQuery, deleteTeamArgs...)
+	if err != nil {
+		return fmt.Errorf("conn.Exec: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return domain.ErrTeamNotFound
+	}
+
+	return nil
+}
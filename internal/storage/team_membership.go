@@ -0,0 +1,77 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	"pr-manager-service/internal/domain"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/samber/lo"
+)
+
+// CreateTeamMemberships добавляет пользователей в команду. Уже состоящие в ней пропускаются.
+func (s *Storage) CreateTeamMemberships(ctx context.Context, teamID string, members []domain.CreateUserRequest) error {
+	if len(members) == 0 {
+		return nil
+	}
+
+	builder := s.builder.Insert("team_memberships").
+		Columns("team_id", "user_id", "role", "joined_at").
+		Suffix("on conflict (team_id, user_id) do nothing")
+
+	timeNow := s.clock.Now()
+	for _, member := range members {
+		builder = builder.Values(teamID, member.ID, lo.CoalesceOrEmpty(member.Role, domain.TeamRoleMember), timeNow)
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("query builder: %w", err)
+	}
+
+	if _, err := s.querier.Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("conn.Exec: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteTeamMembership удаляет пользователя из команды.
+func (s *Storage) DeleteTeamMembership(ctx context.Context, teamID, userID string) error {
+	query, args, err := s.builder.Delete("team_memberships").
+		Where(squirrel.Eq{
+			"team_id": teamID,
+			"user_id": userID,
+		}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("query builder: %w", err)
+	}
+
+	if _, err := s.querier.Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("conn.Exec: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateTeamMemberRole меняет роль пользователя в команде.
+func (s *Storage) UpdateTeamMemberRole(ctx context.Context, teamID, userID string, role domain.TeamRole) error {
+	query, args, err := s.builder.Update("team_memberships").
+		Set("role", role).
+		Where(squirrel.Eq{
+			"team_id": teamID,
+			"user_id": userID,
+		}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("query builder: %w", err)
+	}
+
+	if _, err := s.querier.Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("conn.Exec: %w", err)
+	}
+
+	return nil
+}
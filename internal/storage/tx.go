@@ -10,6 +10,6 @@ import (
 
 func (s *Storage) UnitOfWork(ctx context.Context, do func(txs usecases.Storage) error) error {
 	return s.querier.BeginFunc(ctx, func(tx pgx.Tx) error {
-		return do(NewStorage(tx))
+		return do(NewStorage(tx, s.clock))
 	})
 }
@@ -30,62 +30,104 @@ func (s *Storage) UpdateUserStatus(ctx context.Context, userID string, isActive
 	return nil
 }
 
-func (s *Storage) GetUserFull(ctx context.Context, userID string) (domain.User, domain.Team, error) {
+func (s *Storage) UpdateUserName(ctx context.Context, userID, name string) error {
+	query, args, err := s.builder.Update("users").
+		Set("name", name).
+		Where(squirrel.Eq{"id": userID}).
+		ToSql()
+
+	if err != nil {
+		return fmt.Errorf("query builder: %w", err)
+	}
+
+	_, err = s.querier.Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("conn.Exec: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateUsersStatus меняет флаг активности нескольких пользователей одним запросом.
+func (s *Storage) UpdateUsersStatus(ctx context.Context, userIDs []string, isActive bool) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	query, args, err := s.builder.Update("users").
+		Set("is_active", isActive).
+		Where(squirrel.Eq{"id": userIDs}).
+		ToSql()
+
+	if err != nil {
+		return fmt.Errorf("query builder: %w", err)
+	}
+
+	if _, err := s.querier.Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("conn.Exec: %w", err)
+	}
+
+	return nil
+}
+
+// membershipsColumn собирает команды пользователя u в порядке вступления: [{team_id, team_name, role}].
+const membershipsColumn = `coalesce((
+	select jsonb_agg(jsonb_build_object(
+		'team_id', t.id,
+		'team_name', t.name,
+		'role', m.role
+	) order by m.joined_at, t.name)
+	from team_memberships m
+		join teams t on t.id = m.team_id
+	where m.user_id = u.id
+), '[]'::jsonb)`
+
+// GetUserFull возвращает пользователя со всеми его командами.
+func (s *Storage) GetUserFull(ctx context.Context, userID string) (domain.User, error) {
 	query, args, err := s.builder.Select(
-		"t.id as team_id",
-		"t.name as team_name",
-		"u.name as username",
-		"u.is_active as is_active",
+		"u.id",
+		"u.name",
+		"u.is_active",
+		membershipsColumn,
+		"u.timezone",
+		"u.work_start_minute",
+		"u.work_end_minute",
 	).From("users u").
-		LeftJoin("teams t on t.id = u.team_id").
 		Where(squirrel.Eq{"u.id": userID}).
 		ToSql()
 
 	if err != nil {
-		return domain.User{}, domain.Team{}, fmt.Errorf("query builder: %w", err)
+		return domain.User{}, fmt.Errorf("query builder: %w", err)
 	}
 
 	var (
-		teamID   sql.NullString
-		teamName sql.NullString
-		username string
-		isActive bool
+		user               domain.User
+		workStart, workEnd sql.NullInt16
 	)
 
 	if err := s.querier.QueryRow(ctx, query, args...).Scan(
-		&teamID,
-		&teamName,
-		&username,
-		&isActive,
+		&user.ID,
+		&user.Name,
+		&user.IsActive,
+		&user.Teams,
+		&user.Timezone,
+		&workStart,
+		&workEnd,
 	); err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return domain.User{}, domain.Team{}, domain.ErrUserNotFound
+			return domain.User{}, domain.ErrUserNotFound
 		}
 
-		return domain.User{}, domain.Team{}, fmt.Errorf("conn.QueryRow: %w", err)
-	}
-
-	if !teamID.Valid {
-		return domain.User{}, domain.Team{}, domain.ErrTeamNotFound
-	}
-
-	team := domain.Team{
-		ID:   teamID.String,
-		Name: teamName.String,
+		return domain.User{}, fmt.Errorf("conn.QueryRow: %w", err)
 	}
 
-	user := domain.User{
-		ID:       userID,
-		Name:     username,
-		TeamID:   teamID.String,
-		IsActive: isActive,
-	}
+	user.WorkingHours = workingHours(workStart, workEnd)
 
-	return user, team, nil
+	return user, nil
 }
 
 func (s *Storage) GetUserShort(ctx context.Context, userID string) (domain.User, error) {
-	query, args, err := s.builder.Select("id, name, is_active, team_id").
+	query, args, err := s.builder.Select("id, name, is_active").
 		From("users").
 		Where(squirrel.Eq{"id": userID}).
 		ToSql()
@@ -99,7 +141,6 @@ func (s *Storage) GetUserShort(ctx context.Context, userID string) (domain.User,
 		&user.ID,
 		&user.Name,
 		&user.IsActive,
-		&user.TeamID,
 	); err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return domain.User{}, domain.ErrUserNotFound
@@ -111,26 +152,82 @@ func (s *Storage) GetUserShort(ctx context.Context, userID string) (domain.User,
 	return user, nil
 }
 
-func (s *Storage) GetActiveColleagues(ctx context.Context, userID string) ([]domain.User, error) {
-	teamSubqueryQuery, _, err := s.builder.Select("team_id").
-		From("users").
-		Where(squirrel.Eq{"id": userID}).
+// GetUsersByIDs возвращает пользователей без их команд; отсутствующие идентификаторы пропускаются.
+func (s *Storage) GetUsersByIDs(ctx context.Context, userIDs []string) ([]domain.User, error) {
+	if len(userIDs) == 0 {
+		return []domain.User{}, nil
+	}
+
+	query, args, err := s.builder.Select(
+		"u.id",
+		"u.name",
+		"u.is_active",
+		"u.timezone",
+		"u.work_start_minute",
+		"u.work_end_minute",
+	).From("users u").
+		Where(squirrel.Eq{"u.id": userIDs}).
+		OrderBy("u.id").
 		ToSql()
 
 	if err != nil {
-		return nil, fmt.Errorf("subquery query builder: %w", err)
+		return nil, fmt.Errorf("query builder: %w", err)
+	}
+
+	rows, err := s.querier.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("conn.Query: %w", err)
+	}
+	defer rows.Close()
+
+	users := []domain.User{}
+	for rows.Next() {
+		var (
+			user               domain.User
+			workStart, workEnd sql.NullInt16
+		)
+
+		if err := rows.Scan(
+			&user.ID,
+			&user.Name,
+			&user.IsActive,
+			&user.Timezone,
+			&workStart,
+			&workEnd,
+		); err != nil {
+			return nil, fmt.Errorf("rows.Scan: %w", err)
+		}
+
+		user.WorkingHours = workingHours(workStart, workEnd)
+		users = append(users, user)
 	}
 
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows.Err: %w", err)
+	}
+
+	return users, nil
+}
+
+// GetActiveColleagues возвращает активных участников команды teamID, кроме самого пользователя
+// и тех, кто сейчас в окне отсутствия.
+func (s *Storage) GetActiveColleagues(ctx context.Context, userID, teamID string) ([]domain.User, error) {
+	timeNow := s.clock.Now()
+
 	query, args, err := s.builder.Select(
 		"u.id as user_id",
 		"u.name as username",
 		"u.is_active as is_active",
-		"u.team_id as team_id",
+		"u.timezone as timezone",
+		"u.work_start_minute as work_start_minute",
+		"u.work_end_minute as work_end_minute",
 	).From("users u").
+		Join("team_memberships m on m.user_id = u.id").
 		Where(squirrel.And{
-			squirrel.Expr("u.team_id = (" + teamSubqueryQuery + ")"),
+			squirrel.Eq{"m.team_id": teamID},
 			squirrel.NotEq{"u.id": userID},
 			squirrel.Eq{"u.is_active": true},
+			squirrel.Expr(availableCondition, timeNow, timeNow),
 		}).
 		ToSql()
 
@@ -145,42 +242,44 @@ func (s *Storage) GetActiveColleagues(ctx context.Context, userID string) ([]dom
 
 	users := []domain.User{}
 	for rows.Next() {
-		var user domain.User
+		var (
+			user               domain.User
+			workStart, workEnd sql.NullInt16
+		)
 
 		if err := rows.Scan(
 			&user.ID,
 			&user.Name,
 			&user.IsActive,
-			&user.TeamID,
+			&user.Timezone,
+			&workStart,
+			&workEnd,
 		); err != nil {
 			return nil, fmt.Errorf("rows.Scan: %w", err)
 		}
 
+		user.WorkingHours = workingHours(workStart, workEnd)
 		users = append(users, user)
 	}
 
 	return users, nil
 }
 
-func (s *Storage) CreateUsers(ctx context.Context, requests []domain.CreateUserRequest, teamID string) error {
+// CreateUsers создаёт новых пользователей; существующие пользователи не меняются. Команды не меняются.
+func (s *Storage) CreateUsers(ctx context.Context, requests []domain.CreateUserRequest) error {
 	builder := s.builder.Insert("users").
-		Columns("id", "name", "is_active", "team_id")
+		Columns("id", "name", "is_active")
 
 	for _, member := range requests {
 		builder = builder.Values(
 			member.ID,
 			member.Name,
 			member.IsActive,
-			teamID,
 		)
 	}
 
-	builder = builder.Suffix(`
-		on conflict (id) do update set 
-        name = excluded.name,
-        is_active = excluded.is_active,
-		team_id = excluded.team_id
-	`)
+	// NOTE: активность существующих меняется только через смену статуса с заменой в ревью и аудитом
+	builder = builder.Suffix("on conflict (id) do nothing")
 
 	query, args, err := builder.ToSql()
 	if err != nil {
@@ -193,3 +292,126 @@ func (s *Storage) CreateUsers(ctx context.Context, requests []domain.CreateUserR
 
 	return nil
 }
+
+// GetActiveTeamMembers возвращает активных участников команды, кроме тех, кто сейчас в окне отсутствия.
+func (s *Storage) GetActiveTeamMembers(ctx context.Context, teamID string) ([]domain.User, error) {
+	return s.getActiveTeamMembers(ctx, squirrel.Eq{"m.team_id": teamID})
+}
+
+// GetActiveTeamLeads возвращает активных лидов команды в порядке вступления, кроме тех, кто сейчас в окне отсутствия.
+func (s *Storage) GetActiveTeamLeads(ctx context.Context, teamID string) ([]domain.User, error) {
+	return s.getActiveTeamMembers(
+		ctx,
+		squirrel.Eq{"m.team_id": teamID, "m.role": domain.TeamRoleLead},
+		"m.joined_at", "u.id",
+	)
+}
+
+func (s *Storage) getActiveTeamMembers(
+	ctx context.Context,
+	where squirrel.Eq,
+	orderBy ...string,
+) ([]domain.User, error) {
+	timeNow := s.clock.Now()
+
+	query, args, err := s.builder.Select(
+		"u.id as user_id",
+		"u.name as username",
+		"u.is_active as is_active",
+		"u.timezone as timezone",
+		"u.work_start_minute as work_start_minute",
+		"u.work_end_minute as work_end_minute",
+	).From("users u").
+		Join("team_memberships m on m.user_id = u.id").
+		Where(where).
+		Where(squirrel.Eq{"u.is_active": true}).
+		Where(squirrel.Expr(availableCondition, timeNow, timeNow)).
+		OrderBy(orderBy...).
+		ToSql()
+
+	if err != nil {
+		return nil, fmt.Errorf("query builder: %w", err)
+	}
+
+	rows, err := s.querier.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("conn.Query: %w", err)
+	}
+	defer rows.Close()
+
+	users := []domain.User{}
+	for rows.Next() {
+		var (
+			user               domain.User
+			workStart, workEnd sql.NullInt16
+		)
+
+		if err := rows.Scan(
+			&user.ID,
+			&user.Name,
+			&user.IsActive,
+			&user.Timezone,
+			&workStart,
+			&workEnd,
+		); err != nil {
+			return nil, fmt.Errorf("rows.Scan: %w", err)
+		}
+
+		user.WorkingHours = workingHours(workStart, workEnd)
+		users = append(users, user)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows.Err: %w", err)
+	}
+
+	return users, nil
+}
+
+// UpdateUserWorkingHours задаёт часовой пояс пользователя и его рабочие часы; nil сбрасывает рабочие часы.
+func (s *Storage) UpdateUserWorkingHours(
+	ctx context.Context,
+	userID string,
+	timezone string,
+	hours *domain.WorkingHours,
+) error {
+	var workStart, workEnd sql.NullInt16
+	if hours != nil {
+		workStart = sql.NullInt16{Int16: int16(hours.StartMinute), Valid: true}
+		workEnd = sql.NullInt16{Int16: int16(hours.EndMinute), Valid: true}
+	}
+
+	query, args, err := s.builder.Update("users").
+		Set("timezone", timezone).
+		Set("work_start_minute", workStart).
+		Set("work_end_minute", workEnd).
+		Where(squirrel.Eq{"id": userID}).
+		ToSql()
+
+	if err != nil {
+		return fmt.Errorf("query builder: %w", err)
+	}
+
+	tag, err := s.querier.Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("conn.Exec: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return domain.ErrUserNotFound
+	}
+
+	return nil
+}
+
+// workingHours собирает рабочие часы из колонок work_start_minute и work_end_minute, которые задаются вместе.
+func workingHours(workStart, workEnd sql.NullInt16) *domain.WorkingHours {
+	if !workStart.Valid || !workEnd.Valid {
+		return nil
+	}
+
+	return &domain.WorkingHours{
+		StartMinute: int(workStart.Int16),
+		EndMinute:   int(workEnd.Int16),
+	}
+}
@@ -0,0 +1,385 @@
+package storage
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"pr-manager-service/internal/domain"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+	"github.com/samber/lo"
+)
+
+var webhookSubscriptionColumns = []string{"id", "url", "event_types", "secret", "created_at"}
+
+var webhookDeliveryColumns = []string{
+	"d.id",
+	"d.subscription_id",
+	"d.event_id",
+	"d.event_type",
+	"d.payload",
+	"d.status",
+	"d.attempts",
+	"d.next_attempt_at",
+	"d.last_status_code",
+	"d.last_error",
+	"d.created_at",
+	"d.delivered_at",
+}
+
+// CreateWebhookSubscription сохраняет подписку на события.
+func (s *Storage) CreateWebhookSubscription(
+	ctx context.Context,
+	request domain.CreateWebhookSubscriptionRequest,
+) (domain.WebhookSubscription, error) {
+	subscription := domain.WebhookSubscription{
+		URL:        request.URL,
+		EventTypes: lo.CoalesceSliceOrEmpty(request.EventTypes, []domain.EventType{}),
+		Secret:     request.Secret,
+		CreatedAt:  s.clock.Now(),
+	}
+
+	query, args, err := s.builder.Insert("webhook_subscriptions").
+		Columns("url", "event_types", "secret", "created_at").
+		Values(
+			subscription.URL,
+			eventTypesToStrings(subscription.EventTypes),
+			subscription.Secret,
+			subscription.CreatedAt,
+		).
+		Suffix("returning id, created_at").
+		ToSql()
+	if err != nil {
+		return domain.WebhookSubscription{}, fmt.Errorf("query builder: %w", err)
+	}
+
+	if err := s.querier.QueryRow(ctx, query, args...).Scan(&subscription.ID, &subscription.CreatedAt); err != nil {
+		return domain.WebhookSubscription{}, fmt.Errorf("conn.QueryRow: %w", err)
+	}
+
+	return subscription, nil
+}
+
+// GetWebhookSubscriptionByID возвращает подписку или ErrWebhookSubscriptionNotFound.
+func (s *Storage) GetWebhookSubscriptionByID(ctx context.Context, id int64) (domain.WebhookSubscription, error) {
+	subscriptions, err := s.getWebhookSubscriptions(ctx, squirrel.Eq{"id": id})
+	if err != nil {
+		return domain.WebhookSubscription{}, err
+	}
+
+	if len(subscriptions) == 0 {
+		return domain.WebhookSubscription{}, domain.ErrWebhookSubscriptionNotFound
+	}
+
+	return subscriptions[0], nil
+}
+
+// GetWebhookSubscriptions возвращает все подписки в порядке создания.
+func (s *Storage) GetWebhookSubscriptions(ctx context.Context) ([]domain.WebhookSubscription, error) {
+	return s.getWebhookSubscriptions(ctx, squirrel.Eq{})
+}
+
+func (s *Storage) getWebhookSubscriptions(
+	ctx context.Context,
+	where squirrel.Sqlizer,
+) ([]domain.WebhookSubscription, error) {
+	query, args, err := s.builder.Select(webhookSubscriptionColumns...).
+		From("webhook_subscriptions").
+		Where(where).
+		OrderBy("id").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("query builder: %w", err)
+	}
+
+	rows, err := s.querier.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("conn.Query: %w", err)
+	}
+	defer rows.Close()
+
+	subscriptions := []domain.WebhookSubscription{}
+	for rows.Next() {
+		var (
+			subscription domain.WebhookSubscription
+			eventTypes   []string
+		)
+
+		if err := rows.Scan(
+			&subscription.ID,
+			&subscription.URL,
+			&eventTypes,
+			&subscription.Secret,
+			&subscription.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("rows.Scan: %w", err)
+		}
+
+		subscription.EventTypes = lo.Map(eventTypes, func(eventType string, _ int) domain.EventType {
+			return domain.EventType(eventType)
+		})
+		subscriptions = append(subscriptions, subscription)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows.Err: %w", err)
+	}
+
+	return subscriptions, nil
+}
+
+// DeleteWebhookSubscription удаляет подписку и журнал её доставок.
+// Если подписки нет, возвращает ErrWebhookSubscriptionNotFound.
+func (s *Storage) DeleteWebhookSubscription(ctx context.Context, id int64) error {
+	query, args, err := s.builder.Delete("webhook_subscriptions").
+		Where(squirrel.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("query builder: %w", err)
+	}
+
+	tag, err := s.querier.Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("conn.Exec: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return domain.ErrWebhookSubscriptionNotFound
+	}
+
+	deliveriesQuery, deliveriesArgs, err := s.builder.Delete("webhook_deliveries").
+		Where(squirrel.Eq{"subscription_id": id}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("deliveries query builder: %w", err)
+	}
+
+	if _, err := s.querier.Exec(ctx, deliveriesQuery, deliveriesArgs...); err != nil {
+		return fmt.Errorf("deliveries conn.Exec: %w", err)
+	}
+
+	return nil
+}
+
+// CreateWebhookDeliveries ставит события в очередь доставки всем подпискам, чей фильтр их пропускает.
+// Количество запросов не зависит от числа событий и подписок. Повторно переданное событие
+// не создаёт новых доставок.
+func (s *Storage) CreateWebhookDeliveries(ctx context.Context, events []domain.Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	var (
+		timeNow    = s.clock.Now()
+		eventIDs   = make([]string, 0, len(events))
+		eventTypes = make([]string, 0, len(events))
+		payloads   = make([]string, 0, len(events))
+	)
+
+	for _, event := range events {
+		payload, err := json.Marshal(event)
+		if err != nil {
+			return fmt.Errorf("json.Marshal: %w", err)
+		}
+
+		eventIDs = append(eventIDs, event.ID)
+		eventTypes = append(eventTypes, string(event.Type))
+		payloads = append(payloads, string(payload))
+	}
+
+	query, args, err := s.builder.Insert("webhook_deliveries").
+		Columns("subscription_id", "event_id", "event_type", "payload", "status", "next_attempt_at", "created_at").
+		Select(
+			s.builder.Select("ws.id", "e.event_id", "e.event_type", "e.payload::jsonb", "?", "?::timestamp", "?::timestamp").
+				From("unnest(?::varchar[], ?::varchar[], ?::text[]) as e(event_id, event_type, payload)").
+				Join("webhook_subscriptions ws on cardinality(ws.event_types) = 0 or e.event_type = any(ws.event_types)"),
+		).
+		Suffix("on conflict (subscription_id, event_id) do nothing").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("query builder: %w", err)
+	}
+
+	// NOTE: плейсхолдеры в select идут раньше плейсхолдеров в from
+	args = append(args, domain.WebhookDeliveryPending, timeNow, timeNow, eventIDs, eventTypes, payloads)
+
+	if _, err := s.querier.Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("conn.Exec: %w", err)
+	}
+
+	return nil
+}
+
+// ClaimWebhookDeliveries берёт в работу до limit доставок, которым пора выполнить попытку.
+// Взятые доставки откладываются до leaseUntil: если результат попытки не будет записан, их возьмут снова.
+// Строки, которые в этот момент берёт другая реплика, пропускаются.
+func (s *Storage) ClaimWebhookDeliveries(
+	ctx context.Context,
+	limit int,
+	leaseUntil time.Time,
+) ([]domain.WebhookDelivery, error) {
+	// NOTE: подзапрос собирается с плейсхолдерами ?, чтобы внешний запрос пронумеровал их вместе со своими
+	claimable := squirrel.Select("id").
+		From("webhook_deliveries").
+		Where(squirrel.Eq{"status": domain.WebhookDeliveryPending}).
+		Where(squirrel.LtOrEq{"next_attempt_at": s.clock.Now()}).
+		OrderBy("next_attempt_at", "id").
+		Limit(uint64(limit)).
+		Suffix("for update skip locked")
+
+	query, args, err := s.builder.Update("webhook_deliveries d").
+		Set("next_attempt_at", leaseUntil).
+		From("webhook_subscriptions ws").
+		Where("ws.id = d.subscription_id").
+		Where(squirrel.Expr("d.id in (?)", claimable)).
+		Suffix("returning " + strings.Join(webhookDeliveryColumns, ", ") + ", ws.url, ws.secret").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("query builder: %w", err)
+	}
+
+	rows, err := s.querier.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("conn.Query: %w", err)
+	}
+	defer rows.Close()
+
+	deliveries := []domain.WebhookDelivery{}
+	for rows.Next() {
+		var url, secret string
+
+		delivery, err := scanWebhookDelivery(rows, &url, &secret)
+		if err != nil {
+			return nil, err
+		}
+
+		delivery.URL = url
+		delivery.Secret = secret
+		deliveries = append(deliveries, delivery)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows.Err: %w", err)
+	}
+
+	// NOTE: returning не сохраняет порядок подзапроса, а события отправляются в порядке появления
+	slices.SortFunc(deliveries, func(a, b domain.WebhookDelivery) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+
+	return deliveries, nil
+}
+
+// UpdateWebhookDelivery сохраняет результат попытки доставки.
+func (s *Storage) UpdateWebhookDelivery(ctx context.Context, delivery domain.WebhookDelivery) error {
+	query, args, err := s.builder.Update("webhook_deliveries").
+		Set("status", delivery.Status).
+		Set("attempts", delivery.Attempts).
+		Set("next_attempt_at", delivery.NextAttemptAt).
+		Set("last_status_code", sql.NullInt32{Int32: int32(delivery.LastStatusCode), Valid: delivery.LastStatusCode != 0}).
+		Set("last_error", nullString(delivery.LastError)).
+		Set("delivered_at", delivery.DeliveredAt).
+		Where(squirrel.Eq{"id": delivery.ID}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("query builder: %w", err)
+	}
+
+	if _, err := s.querier.Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("conn.Exec: %w", err)
+	}
+
+	return nil
+}
+
+// GetWebhookDeliveries возвращает журнал доставок подписки от новых к старым.
+func (s *Storage) GetWebhookDeliveries(
+	ctx context.Context,
+	request domain.GetWebhookDeliveriesRequest,
+) ([]domain.WebhookDelivery, error) {
+	builder := s.builder.Select(webhookDeliveryColumns...).
+		From("webhook_deliveries d").
+		Where(squirrel.Eq{"d.subscription_id": request.SubscriptionID}).
+		OrderBy("d.id desc").
+		Limit(uint64(request.Limit))
+
+	if request.Status != "" {
+		builder = builder.Where(squirrel.Eq{"d.status": request.Status})
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("query builder: %w", err)
+	}
+
+	rows, err := s.querier.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("conn.Query: %w", err)
+	}
+	defer rows.Close()
+
+	deliveries := []domain.WebhookDelivery{}
+	for rows.Next() {
+		delivery, err := scanWebhookDelivery(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		deliveries = append(deliveries, delivery)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows.Err: %w", err)
+	}
+
+	return deliveries, nil
+}
+
+// scanWebhookDelivery читает колонки webhookDeliveryColumns и следующие за ними колонки в extra.
+func scanWebhookDelivery(row pgx.Row, extra ...any) (domain.WebhookDelivery, error) {
+	var (
+		delivery          domain.WebhookDelivery
+		eventType, status string
+		lastStatusCode    sql.NullInt32
+		lastError         sql.NullString
+	)
+
+	dest := append([]any{
+		&delivery.ID,
+		&delivery.SubscriptionID,
+		&delivery.EventID,
+		&eventType,
+		&delivery.Payload,
+		&status,
+		&delivery.Attempts,
+		&delivery.NextAttemptAt,
+		&lastStatusCode,
+		&lastError,
+		&delivery.CreatedAt,
+		&delivery.DeliveredAt,
+	}, extra...)
+
+	if err := row.Scan(dest...); err != nil {
+		return domain.WebhookDelivery{}, fmt.Errorf("rows.Scan: %w", err)
+	}
+
+	delivery.EventType = domain.EventType(eventType)
+	delivery.Status = domain.WebhookDeliveryStatus(status)
+	delivery.LastStatusCode = int(lastStatusCode.Int32)
+	delivery.LastError = lastError.String
+
+	return delivery, nil
+}
+
+func eventTypesToStrings(eventTypes []domain.EventType) []string {
+	return lo.Map(eventTypes, func(eventType domain.EventType, _ int) string {
+		return string(eventType)
+	})
+}
@@ -0,0 +1,177 @@
+package usecases
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"pr-manager-service/internal/domain"
+	"pr-manager-service/internal/reqctx"
+
+	"github.com/samber/lo"
+)
+
+// auditLogOverfetch - сколько записей сверх лимита читается, чтобы понять, есть ли следующая страница
+const auditLogOverfetch = 1
+
+// audit записывает операцию в журнал аудита в той же транзакции s, что и сама операция.
+func (u *Usecases) audit(
+	ctx context.Context,
+	s Storage,
+	action domain.AuditAction,
+	targetType domain.AuditTargetType,
+	targetID string,
+	before, after any,
+) error {
+	entry, err := newAuditEntry(ctx, action, targetType, targetID, before, after)
+	if err != nil {
+		return fmt.Errorf("newAuditEntry: %w", err)
+	}
+
+	if err := s.CreateAuditEntries(ctx, []domain.AuditEntry{entry}); err != nil {
+		return fmt.Errorf("CreateAuditEntries: %w", err)
+	}
+
+	return nil
+}
+
+// newAuditEntry собирает запись аудита. before и after сериализуются в JSON; nil - объекта в этом состоянии нет.
+// Исполнитель и идентификатор запроса берутся из контекста.
+func newAuditEntry(
+	ctx context.Context,
+	action domain.AuditAction,
+	targetType domain.AuditTargetType,
+	targetID string,
+	before, after any,
+) (domain.AuditEntry, error) {
+	entry := domain.AuditEntry{
+		Actor:      reqctx.Actor(ctx),
+		Action:     action,
+		TargetType: targetType,
+		TargetID:   targetID,
+		RequestID:  reqctx.RequestID(ctx),
+	}
+
+	var err error
+
+	if entry.Before, err = marshalAuditState(before); err != nil {
+		return domain.AuditEntry{}, fmt.Errorf("marshalAuditState before: %w", err)
+	}
+
+	if entry.After, err = marshalAuditState(after); err != nil {
+		return domain.AuditEntry{}, fmt.Errorf("marshalAuditState after: %w", err)
+	}
+
+	return entry, nil
+}
+
+// auditReviewerReplaced записывает в журнал аудита замену ревьювера PR: вручную, при деактивации
+// или при эскалации просроченного ревью.
+func (u *Usecases) auditReviewerReplaced(
+	ctx context.Context,
+	s Storage,
+	pr domain.PullRequest,
+	replacement domain.ReviewerReplacement,
+) error {
+	before := domain.NewAuditPullRequestState(pr)
+
+	return u.audit(
+		ctx,
+		s,
+		domain.AuditPullRequestReassign,
+		domain.AuditTargetPullRequest,
+		pr.ID,
+		before,
+		before.WithReviewerReplaced(replacement.OldReviewerID, replacement.NewReviewerID),
+	)
+}
+
+// auditTeamDeactivation записывает в журнал аудита деактивацию пользователей команды и замены
+// ревьюверов в pullRequests одним запросом: по записи на пользователя и на PR.
+func (u *Usecases) auditTeamDeactivation(
+	ctx context.Context,
+	s Storage,
+	deactivatedUserIDs []string,
+	pullRequests []domain.PullRequest,
+	reassigned []domain.ReviewerReplacement,
+) error {
+	entries := make([]domain.AuditEntry, 0, len(deactivatedUserIDs)+len(pullRequests))
+
+	for _, userID := range deactivatedUserIDs {
+		entry, err := newAuditEntry(
+			ctx,
+			domain.AuditUserChangeStatus,
+			domain.AuditTargetUser,
+			userID,
+			domain.AuditUserState{IsActive: true},
+			domain.AuditUserState{IsActive: false},
+		)
+		if err != nil {
+			return fmt.Errorf("newAuditEntry: %w", err)
+		}
+
+		entries = append(entries, entry)
+	}
+
+	replacementsByPR := lo.GroupBy(reassigned, func(replacement domain.ReviewerReplacement) string {
+		return replacement.PullRequestID
+	})
+
+	for _, pr := range pullRequests {
+		replacements, ok := replacementsByPR[pr.ID]
+		if !ok {
+			continue
+		}
+
+		before := domain.NewAuditPullRequestState(pr)
+		after := before
+		for _, replacement := range replacements {
+			after = after.WithReviewerReplaced(replacement.OldReviewerID, replacement.NewReviewerID)
+		}
+
+		entry, err := newAuditEntry(
+			ctx,
+			domain.AuditPullRequestReassign,
+			domain.AuditTargetPullRequest,
+			pr.ID,
+			before,
+			after,
+		)
+		if err != nil {
+			return fmt.Errorf("newAuditEntry: %w", err)
+		}
+
+		entries = append(entries, entry)
+	}
+
+	if err := s.CreateAuditEntries(ctx, entries); err != nil {
+		return fmt.Errorf("CreateAuditEntries: %w", err)
+	}
+
+	return nil
+}
+
+func marshalAuditState(state any) (json.RawMessage, error) {
+	if state == nil {
+		return nil, nil
+	}
+
+	return json.Marshal(state)
+}
+
+// GetAuditLog возвращает страницу журнала аудита от новых записей к старым.
+func (u *Usecases) GetAuditLog(ctx context.Context, request domain.GetAuditLogRequest) (domain.AuditLogPage, error) {
+	entries, err := u.storage.GetAuditLog(ctx, request, request.Limit+auditLogOverfetch)
+	if err != nil {
+		return domain.AuditLogPage{}, fmt.Errorf("storage.GetAuditLog: %w", err)
+	}
+
+	page := domain.AuditLogPage{Entries: entries}
+
+	if len(entries) > request.Limit {
+		page.Entries = entries[:request.Limit]
+		page.NextCursor = page.Entries[request.Limit-1].ID
+	}
+
+	return page, nil
+}
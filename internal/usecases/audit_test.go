@@ -0,0 +1,125 @@
+package usecases
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"pr-manager-service/internal/domain"
+	"pr-manager-service/internal/reqctx"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// expectedAuditEntry - ожидаемая запись аудита: действие, объект и, если After не nil,
+// состояние после операции, которое сравнивается в JSON.
+type expectedAuditEntry struct {
+	Action   domain.AuditAction
+	TargetID string
+	After    any
+}
+
+// auditEntriesMatcher сравнивает записи аудита с ожидаемыми в том же порядке.
+type auditEntriesMatcher struct {
+	entries []expectedAuditEntry
+}
+
+func auditEntries(entries ...expectedAuditEntry) gomock.Matcher {
+	return auditEntriesMatcher{entries: entries}
+}
+
+// auditEntry ожидает одну запись с действием action над targetID.
+func auditEntry(action domain.AuditAction, targetID string, after any) gomock.Matcher {
+	return auditEntries(expectedAuditEntry{Action: action, TargetID: targetID, After: after})
+}
+
+func (m auditEntriesMatcher) Matches(x any) bool {
+	entries, ok := x.([]domain.AuditEntry)
+	if !ok || len(entries) != len(m.entries) {
+		return false
+	}
+
+	for i, entry := range entries {
+		expected := m.entries[i]
+		if entry.Action != expected.Action || entry.TargetID != expected.TargetID {
+			return false
+		}
+
+		if expected.After == nil {
+			continue
+		}
+
+		after, err := json.Marshal(expected.After)
+		if err != nil || string(after) != string(entry.After) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (m auditEntriesMatcher) String() string {
+	return fmt.Sprintf("audit entries %+v", m.entries)
+}
+
+func TestUsecases_audit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ms := NewMockStorage(ctrl)
+
+	ctx := reqctx.WithCaller(reqctx.WithRequestID(context.Background(), "req-1"), domain.Caller{UserID: "u1"})
+
+	ms.EXPECT().CreateAuditEntries(gomock.Any(), []domain.AuditEntry{{
+		Actor:      "u1",
+		Action:     domain.AuditUserChangeStatus,
+		TargetType: domain.AuditTargetUser,
+		TargetID:   "u2",
+		Before:     json.RawMessage(`{"is_active":true}`),
+		After:      json.RawMessage(`{"is_active":false}`),
+		RequestID:  "req-1",
+	}}).Return(nil)
+
+	u := NewUsecases(ms)
+	err := u.audit(
+		ctx,
+		ms,
+		domain.AuditUserChangeStatus,
+		domain.AuditTargetUser,
+		"u2",
+		domain.AuditUserState{IsActive: true},
+		domain.AuditUserState{IsActive: false},
+	)
+	require.NoError(t, err)
+}
+
+func TestUsecases_GetAuditLog(t *testing.T) {
+	entries := []domain.AuditEntry{{ID: 30}, {ID: 20}, {ID: 10}}
+
+	t.Run("next_page", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		ms := NewMockStorage(ctrl)
+
+		request := domain.GetAuditLogRequest{Actor: "u1", Limit: 2}
+		ms.EXPECT().GetAuditLog(gomock.Any(), request, 3).Return(entries, nil)
+
+		u := NewUsecases(ms)
+		page, err := u.GetAuditLog(context.Background(), request)
+		require.NoError(t, err)
+		assert.Equal(t, domain.AuditLogPage{Entries: entries[:2], NextCursor: 20}, page)
+	})
+
+	t.Run("last_page", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		ms := NewMockStorage(ctrl)
+
+		request := domain.GetAuditLogRequest{Cursor: 40, Limit: 3}
+		ms.EXPECT().GetAuditLog(gomock.Any(), request, 4).Return(entries, nil)
+
+		u := NewUsecases(ms)
+		page, err := u.GetAuditLog(context.Background(), request)
+		require.NoError(t, err)
+		assert.Equal(t, domain.AuditLogPage{Entries: entries}, page)
+	})
+}
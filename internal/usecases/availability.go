@@ -0,0 +1,86 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"pr-manager-service/internal/domain"
+
+	"github.com/samber/lo"
+)
+
+// CreateUnavailability добавляет пользователю окно отсутствия. Пока оно длится, пользователь не выбирается ревьювером.
+// Пользователь добавляет окна только себе.
+func (u *Usecases) CreateUnavailability(
+	ctx context.Context,
+	request domain.CreateUnavailabilityRequest,
+) (domain.Unavailability, error) {
+	if err := u.authorizeActAs(ctx, u.storage, request.UserID); err != nil {
+		return domain.Unavailability{}, err
+	}
+
+	// NOTE: проверка существования пользователя
+	if _, err := u.storage.GetUserShort(ctx, request.UserID); err != nil {
+		return domain.Unavailability{}, fmt.Errorf("GetUserShort: %w", err)
+	}
+
+	return u.storage.CreateUnavailability(ctx, request)
+}
+
+// GetUserAvailability возвращает текущие и будущие окна отсутствия пользователя и признак того,
+// что сейчас его можно назначить ревьювером: он активен и не находится в окне отсутствия.
+func (u *Usecases) GetUserAvailability(
+	ctx context.Context,
+	userID string,
+) (available bool, windows []domain.Unavailability, err error) {
+	user, err := u.storage.GetUserShort(ctx, userID)
+	if err != nil {
+		return false, nil, fmt.Errorf("GetUserShort: %w", err)
+	}
+
+	timeNow := u.clock.Now()
+
+	windows, err = u.storage.GetUserUnavailability(ctx, userID, timeNow)
+	if err != nil {
+		return false, nil, fmt.Errorf("GetUserUnavailability: %w", err)
+	}
+
+	return user.IsActive && !isUnavailable(windows, timeNow), windows, nil
+}
+
+// DeleteUnavailability удаляет окно отсутствия пользователя. Пользователь удаляет только свои окна.
+func (u *Usecases) DeleteUnavailability(ctx context.Context, request domain.DeleteUnavailabilityRequest) error {
+	if err := u.authorizeActAs(ctx, u.storage, request.UserID); err != nil {
+		return err
+	}
+
+	// NOTE: проверка существования пользователя
+	if _, err := u.storage.GetUserShort(ctx, request.UserID); err != nil {
+		return fmt.Errorf("GetUserShort: %w", err)
+	}
+
+	if err := u.storage.DeleteUnavailability(ctx, request.UserID, request.ID); err != nil {
+		return fmt.Errorf("DeleteUnavailability: %w", err)
+	}
+
+	return nil
+}
+
+// userAvailableNow проверяет, что у пользователя сейчас нет окна отсутствия.
+func (u *Usecases) userAvailableNow(ctx context.Context, s Storage, userID string) (bool, error) {
+	timeNow := u.clock.Now()
+
+	windows, err := s.GetUserUnavailability(ctx, userID, timeNow)
+	if err != nil {
+		return false, fmt.Errorf("GetUserUnavailability: %w", err)
+	}
+
+	return !isUnavailable(windows, timeNow), nil
+}
+
+func isUnavailable(windows []domain.Unavailability, now time.Time) bool {
+	return lo.SomeBy(windows, func(window domain.Unavailability) bool {
+		return window.Covers(now)
+	})
+}
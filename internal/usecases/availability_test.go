@@ -0,0 +1,74 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"pr-manager-service/internal/clock"
+	"pr-manager-service/internal/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUsecases_GetUserAvailability(t *testing.T) {
+	const userID = "101"
+
+	timeNow := time.Date(2025, time.November, 3, 12, 0, 0, 0, time.UTC)
+
+	current := domain.Unavailability{
+		ID:       1,
+		UserID:   userID,
+		StartsAt: timeNow.Add(-time.Hour),
+		EndsAt:   timeNow.Add(time.Hour),
+	}
+	future := domain.Unavailability{
+		ID:       2,
+		UserID:   userID,
+		StartsAt: timeNow.Add(24 * time.Hour),
+		EndsAt:   timeNow.Add(48 * time.Hour),
+	}
+	// NOTE: конец окна не включается
+	ended := domain.Unavailability{
+		ID:       3,
+		UserID:   userID,
+		StartsAt: timeNow.Add(-time.Hour),
+		EndsAt:   timeNow,
+	}
+
+	testCases := []struct {
+		name            string
+		isActive        bool
+		windows         []domain.Unavailability
+		expectAvailable bool
+	}{
+		{name: "no_windows", isActive: true, windows: []domain.Unavailability{}, expectAvailable: true},
+		{name: "future_window", isActive: true, windows: []domain.Unavailability{future}, expectAvailable: true},
+		{name: "current_window", isActive: true, windows: []domain.Unavailability{current, future}},
+		{name: "window_ends_now", isActive: true, windows: []domain.Unavailability{ended}, expectAvailable: true},
+		{name: "inactive", isActive: false, windows: []domain.Unavailability{}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			ms := NewMockStorage(ctrl)
+
+			ms.EXPECT().
+				GetUserShort(gomock.Any(), userID).
+				Return(domain.User{ID: userID, IsActive: tc.isActive}, nil)
+			ms.EXPECT().
+				GetUserUnavailability(gomock.Any(), userID, timeNow).
+				Return(tc.windows, nil)
+
+			u := NewUsecases(ms, WithClock(clock.NewFake(timeNow)))
+			available, windows, err := u.GetUserAvailability(context.Background(), userID)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectAvailable, available)
+			assert.Equal(t, tc.windows, windows)
+		})
+	}
+}
@@ -5,36 +5,124 @@ package usecases
 import (
 	"context"
 	"pr-manager-service/internal/domain"
+	"time"
 )
 
 type Storage interface {
 	CreateTeam(ctx context.Context, request domain.CreateTeamRequest, teamID string) error
 	GetTeamByName(ctx context.Context, teamName string) (domain.Team, error)
+	GetTeamByID(ctx context.Context, teamID string) (domain.Team, error)
+	UpdateTeamSettings(ctx context.Context, request domain.UpdateTeamSettingsRequest) error
 	GetTeamFullByName(ctx context.Context, teamName string) (domain.Team, []domain.User, error)
-	GetActiveColleagues(ctx context.Context, userID string) ([]domain.User, error)
+	GetActiveColleagues(ctx context.Context, userID, teamID string) ([]domain.User, error)
+	GetTeamReviewerCursor(ctx context.Context, teamID string) (string, error)
+	UpdateTeamReviewerCursor(ctx context.Context, teamID, userID string) error
+	GetTeamFallbacks(ctx context.Context, teamID string) ([]domain.Team, error)
+	SetTeamFallbacks(ctx context.Context, teamID string, fallbackTeamIDs []string) error
+	GetActiveTeamMembers(ctx context.Context, teamID string) ([]domain.User, error)
+	GetActiveTeamLeads(ctx context.Context, teamID string) ([]domain.User, error)
+	GetTeamOwnerRules(ctx context.Context, teamID string) ([]domain.OwnerRule, error)
+	SetTeamOwnerRules(ctx context.Context, teamID string, rules []domain.OwnerRule) error
+	RenameTeam(ctx context.Context, team domain.Team, newName string) error
+	DeleteTeam(ctx context.Context, teamID string) error
+	CreateTeamMemberships(ctx context.Context, teamID string, members []domain.CreateUserRequest) error
+	DeleteTeamMembership(ctx context.Context, teamID, userID string) error
+	UpdateTeamMemberRole(ctx context.Context, teamID, userID string, role domain.TeamRole) error
 
 	GetPullRequestsByReviewer(ctx context.Context, userID string) ([]domain.PullRequest, error)
 	GetPullRequestByID(ctx context.Context, prID string) (domain.PullRequest, error)
 	CreatePullRequest(
 		ctx context.Context,
 		request domain.CreatePullRequestRequest,
-		reviewersIDs []string,
+		team domain.Team,
+		needsMoreReviewers bool,
 	) (pr domain.PullRequest, err error)
 	UpdatePullRequestStatus(ctx context.Context, prID string, newStatus domain.PullRequestStatus) error
-	UpdatePullRequestReviewersIDs(ctx context.Context, prID string, reviewersIDs []string) error
+	UpdatePullRequestNeedsMoreReviewers(ctx context.Context, prID string, needsMoreReviewers bool) error
+	GetOpenReviewsCountByUsers(ctx context.Context, userIDs []string) (map[string]int, error)
+	AssignPullRequestReviewers(
+		ctx context.Context,
+		prID string,
+		assignments []domain.ReviewerAssignment,
+		reason domain.AssignmentReason,
+	) error
+	UnassignPullRequestReviewer(ctx context.Context, prID, userID string, reason domain.AssignmentReason) error
+	LockPullRequest(ctx context.Context, prID string) error
+	LockReviewAssignment(ctx context.Context, prID, userID string) (bool, error)
+	MarkReviewEscalated(ctx context.Context, prID, userID string) error
+	GetActivePullRequestsByReviewers(ctx context.Context, userIDs []string) ([]domain.PullRequest, error)
+	GetPullRequestsPastSLA(ctx context.Context, teamID string, now time.Time) ([]domain.PullRequest, error)
+	ListPullRequests(ctx context.Context, filter domain.PullRequestFilter, limit int) ([]domain.PullRequest, error)
+	GetAssignedReviews(
+		ctx context.Context,
+		filter domain.AssignedReviewFilter,
+		limit int,
+	) ([]domain.AssignedReview, error)
+	CountAssignedReviews(ctx context.Context, userID string) (map[domain.PullRequestStatus]int, error)
+	ReplacePullRequestReviewers(
+		ctx context.Context,
+		replacements []domain.ReviewerReplacement,
+		assignments []domain.ReviewerAssignment,
+		reason domain.AssignmentReason,
+	) error
+	CreatePullRequestReview(ctx context.Context, request domain.SubmitReviewRequest) (domain.Review, error)
+	CountPullRequestApprovals(ctx context.Context, prID string) (int, error)
 
-	CreateUsers(ctx context.Context, requests []domain.CreateUserRequest, teamID string) error
+	CreateUsers(ctx context.Context, requests []domain.CreateUserRequest) error
+	UpdateUserName(ctx context.Context, userID, name string) error
 	UpdateUserStatus(ctx context.Context, userID string, isActive bool) error
-	GetUserFull(ctx context.Context, userID string) (domain.User, domain.Team, error)
+	UpdateUsersStatus(ctx context.Context, userIDs []string, isActive bool) error
+	GetUserFull(ctx context.Context, userID string) (domain.User, error)
 	GetUserShort(ctx context.Context, userID string) (domain.User, error)
+	GetUsersByIDs(ctx context.Context, userIDs []string) ([]domain.User, error)
+	UpdateUserWorkingHours(ctx context.Context, userID, timezone string, hours *domain.WorkingHours) error
+	CreateUnavailability(ctx context.Context, request domain.CreateUnavailabilityRequest) (domain.Unavailability, error)
+	GetUserUnavailability(ctx context.Context, userID string, now time.Time) ([]domain.Unavailability, error)
+	DeleteUnavailability(ctx context.Context, userID string, windowID int64) error
 
-	PullRequestStatsCreate(ctx context.Context, pullRequestID string, assignmentsCount int) error
 	UserStatsCreateBatch(ctx context.Context, userIDs []string) error
-	UserAssignmentsIncrementBatch(ctx context.Context, userIDs []string) error
 	UserStatusChangesIncrementBatch(ctx context.Context, userIDs []string) error
-	PullRequestAssignmentsIncrement(ctx context.Context, pullRequestID string) error
+	GetUsersLastAssignedAt(ctx context.Context, userIDs []string) (map[string]time.Time, error)
 	GetUsersStats(ctx context.Context) (userStats []domain.UserStats, err error)
 	GetPullRequestsStats(ctx context.Context) (pullRequestsStats []domain.PullRequestStats, err error)
 
+	CreateWebhookSubscription(
+		ctx context.Context,
+		request domain.CreateWebhookSubscriptionRequest,
+	) (domain.WebhookSubscription, error)
+	GetWebhookSubscriptionByID(ctx context.Context, id int64) (domain.WebhookSubscription, error)
+	GetWebhookSubscriptions(ctx context.Context) ([]domain.WebhookSubscription, error)
+	DeleteWebhookSubscription(ctx context.Context, id int64) error
+	CreateWebhookDeliveries(ctx context.Context, events []domain.Event) error
+	ClaimWebhookDeliveries(ctx context.Context, limit int, leaseUntil time.Time) ([]domain.WebhookDelivery, error)
+	UpdateWebhookDelivery(ctx context.Context, delivery domain.WebhookDelivery) error
+	GetWebhookDeliveries(ctx context.Context, request domain.GetWebhookDeliveriesRequest) ([]domain.WebhookDelivery, error)
+
+	CreateOutboxEvents(ctx context.Context, events []domain.Event) error
+	LockOutboxEvents(ctx context.Context, limit int) ([]domain.Event, error)
+	MarkOutboxEventsDispatched(ctx context.Context, eventIDs []string) error
+	GetLastOutboxEventSeq(ctx context.Context) (int64, error)
+	GetDispatchedOutboxEvents(
+		ctx context.Context,
+		filter domain.EventStreamFilter,
+		afterSeq int64,
+		limit int,
+	) ([]domain.StreamEvent, error)
+
+	CreateAuditEntries(ctx context.Context, entries []domain.AuditEntry) error
+	GetAuditLog(ctx context.Context, request domain.GetAuditLogRequest, limit int) ([]domain.AuditEntry, error)
+
 	UnitOfWork(ctx context.Context, do func(s Storage) error) error
 }
+
+// WebhookSender отправляет доставку вебхука получателю. statusCode - код ответа получателя,
+// если он был получен; ответ не из диапазона 2xx возвращается как ошибка.
+type WebhookSender interface {
+	Send(ctx context.Context, delivery domain.WebhookDelivery) (statusCode int, err error)
+}
+
+// EventSink получает события из outbox. Доставка - как минимум один раз: если релей не смог отметить
+// события переданными, они придут снова, поэтому повторы нужно отбрасывать по Event.ID.
+type EventSink interface {
+	Publish(ctx context.Context, events []domain.Event) error
+}
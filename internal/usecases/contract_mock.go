@@ -1,9 +1,19 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./contract.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./contract.go -destination=./contract_mock.go -package=usecases
+//
+
+// Package usecases is a generated GoMock package.
 package usecases
 
 import (
 	context "context"
 	domain "pr-manager-service/internal/domain"
 	reflect "reflect"
+	time "time"
 
 	gomock "go.uber.org/mock/gomock"
 )
@@ -32,19 +42,121 @@ func (m *MockStorage) EXPECT() *MockStorageMockRecorder {
 	return m.recorder
 }
 
+// AssignPullRequestReviewers mocks base method.
+func (m *MockStorage) AssignPullRequestReviewers(ctx context.Context, prID string, assignments []domain.ReviewerAssignment, reason domain.AssignmentReason) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignPullRequestReviewers", ctx, prID, assignments, reason)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AssignPullRequestReviewers indicates an expected call of AssignPullRequestReviewers.
+func (mr *MockStorageMockRecorder) AssignPullRequestReviewers(ctx, prID, assignments, reason any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignPullRequestReviewers", reflect.TypeOf((*MockStorage)(nil).AssignPullRequestReviewers), ctx, prID, assignments, reason)
+}
+
+// ClaimWebhookDeliveries mocks base method.
+func (m *MockStorage) ClaimWebhookDeliveries(ctx context.Context, limit int, leaseUntil time.Time) ([]domain.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimWebhookDeliveries", ctx, limit, leaseUntil)
+	ret0, _ := ret[0].([]domain.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimWebhookDeliveries indicates an expected call of ClaimWebhookDeliveries.
+func (mr *MockStorageMockRecorder) ClaimWebhookDeliveries(ctx, limit, leaseUntil any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimWebhookDeliveries", reflect.TypeOf((*MockStorage)(nil).ClaimWebhookDeliveries), ctx, limit, leaseUntil)
+}
+
+// CountAssignedReviews mocks base method.
+func (m *MockStorage) CountAssignedReviews(ctx context.Context, userID string) (map[domain.PullRequestStatus]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountAssignedReviews", ctx, userID)
+	ret0, _ := ret[0].(map[domain.PullRequestStatus]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountAssignedReviews indicates an expected call of CountAssignedReviews.
+func (mr *MockStorageMockRecorder) CountAssignedReviews(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountAssignedReviews", reflect.TypeOf((*MockStorage)(nil).CountAssignedReviews), ctx, userID)
+}
+
+// CountPullRequestApprovals mocks base method.
+func (m *MockStorage) CountPullRequestApprovals(ctx context.Context, prID string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountPullRequestApprovals", ctx, prID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountPullRequestApprovals indicates an expected call of CountPullRequestApprovals.
+func (mr *MockStorageMockRecorder) CountPullRequestApprovals(ctx, prID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountPullRequestApprovals", reflect.TypeOf((*MockStorage)(nil).CountPullRequestApprovals), ctx, prID)
+}
+
+// CreateAuditEntries mocks base method.
+func (m *MockStorage) CreateAuditEntries(ctx context.Context, entries []domain.AuditEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAuditEntries", ctx, entries)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAuditEntries indicates an expected call of CreateAuditEntries.
+func (mr *MockStorageMockRecorder) CreateAuditEntries(ctx, entries any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAuditEntries", reflect.TypeOf((*MockStorage)(nil).CreateAuditEntries), ctx, entries)
+}
+
+// CreateOutboxEvents mocks base method.
+func (m *MockStorage) CreateOutboxEvents(ctx context.Context, events []domain.Event) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOutboxEvents", ctx, events)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateOutboxEvents indicates an expected call of CreateOutboxEvents.
+func (mr *MockStorageMockRecorder) CreateOutboxEvents(ctx, events any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOutboxEvents", reflect.TypeOf((*MockStorage)(nil).CreateOutboxEvents), ctx, events)
+}
+
 // CreatePullRequest mocks base method.
-func (m *MockStorage) CreatePullRequest(ctx context.Context, request domain.CreatePullRequestRequest, reviewersIDs []string) (domain.PullRequest, error) {
+func (m *MockStorage) CreatePullRequest(ctx context.Context, request domain.CreatePullRequestRequest, team domain.Team, needsMoreReviewers bool) (domain.PullRequest, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreatePullRequest", ctx, request, reviewersIDs)
+	ret := m.ctrl.Call(m, "CreatePullRequest", ctx, request, team, needsMoreReviewers)
 	ret0, _ := ret[0].(domain.PullRequest)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreatePullRequest indicates an expected call of CreatePullRequest.
-func (mr *MockStorageMockRecorder) CreatePullRequest(ctx, request, reviewersIDs any) *gomock.Call {
+func (mr *MockStorageMockRecorder) CreatePullRequest(ctx, request, team, needsMoreReviewers any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePullRequest", reflect.TypeOf((*MockStorage)(nil).CreatePullRequest), ctx, request, team, needsMoreReviewers)
+}
+
+// CreatePullRequestReview mocks base method.
+func (m *MockStorage) CreatePullRequestReview(ctx context.Context, request domain.SubmitReviewRequest) (domain.Review, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePullRequestReview", ctx, request)
+	ret0, _ := ret[0].(domain.Review)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePullRequestReview indicates an expected call of CreatePullRequestReview.
+func (mr *MockStorageMockRecorder) CreatePullRequestReview(ctx, request any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePullRequest", reflect.TypeOf((*MockStorage)(nil).CreatePullRequest), ctx, request, reviewersIDs)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePullRequestReview", reflect.TypeOf((*MockStorage)(nil).CreatePullRequestReview), ctx, request)
 }
 
 // CreateTeam mocks base method.
@@ -61,33 +173,267 @@ func (mr *MockStorageMockRecorder) CreateTeam(ctx, request, teamID any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTeam", reflect.TypeOf((*MockStorage)(nil).CreateTeam), ctx, request, teamID)
 }
 
+// CreateTeamMemberships mocks base method.
+func (m *MockStorage) CreateTeamMemberships(ctx context.Context, teamID string, members []domain.CreateUserRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTeamMemberships", ctx, teamID, members)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateTeamMemberships indicates an expected call of CreateTeamMemberships.
+func (mr *MockStorageMockRecorder) CreateTeamMemberships(ctx, teamID, members any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTeamMemberships", reflect.TypeOf((*MockStorage)(nil).CreateTeamMemberships), ctx, teamID, members)
+}
+
+// CreateUnavailability mocks base method.
+func (m *MockStorage) CreateUnavailability(ctx context.Context, request domain.CreateUnavailabilityRequest) (domain.Unavailability, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUnavailability", ctx, request)
+	ret0, _ := ret[0].(domain.Unavailability)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUnavailability indicates an expected call of CreateUnavailability.
+func (mr *MockStorageMockRecorder) CreateUnavailability(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUnavailability", reflect.TypeOf((*MockStorage)(nil).CreateUnavailability), ctx, request)
+}
+
 // CreateUsers mocks base method.
-func (m *MockStorage) CreateUsers(ctx context.Context, requests []domain.CreateUserRequest, teamID string) error {
+func (m *MockStorage) CreateUsers(ctx context.Context, requests []domain.CreateUserRequest) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateUsers", ctx, requests, teamID)
+	ret := m.ctrl.Call(m, "CreateUsers", ctx, requests)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CreateUsers indicates an expected call of CreateUsers.
-func (mr *MockStorageMockRecorder) CreateUsers(ctx, requests, teamID any) *gomock.Call {
+func (mr *MockStorageMockRecorder) CreateUsers(ctx, requests any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUsers", reflect.TypeOf((*MockStorage)(nil).CreateUsers), ctx, requests)
+}
+
+// CreateWebhookDeliveries mocks base method.
+func (m *MockStorage) CreateWebhookDeliveries(ctx context.Context, events []domain.Event) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWebhookDeliveries", ctx, events)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateWebhookDeliveries indicates an expected call of CreateWebhookDeliveries.
+func (mr *MockStorageMockRecorder) CreateWebhookDeliveries(ctx, events any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWebhookDeliveries", reflect.TypeOf((*MockStorage)(nil).CreateWebhookDeliveries), ctx, events)
+}
+
+// CreateWebhookSubscription mocks base method.
+func (m *MockStorage) CreateWebhookSubscription(ctx context.Context, request domain.CreateWebhookSubscriptionRequest) (domain.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWebhookSubscription", ctx, request)
+	ret0, _ := ret[0].(domain.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWebhookSubscription indicates an expected call of CreateWebhookSubscription.
+func (mr *MockStorageMockRecorder) CreateWebhookSubscription(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWebhookSubscription", reflect.TypeOf((*MockStorage)(nil).CreateWebhookSubscription), ctx, request)
+}
+
+// DeleteTeam mocks base method.
+func (m *MockStorage) DeleteTeam(ctx context.Context, teamID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTeam", ctx, teamID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTeam indicates an expected call of DeleteTeam.
+func (mr *MockStorageMockRecorder) DeleteTeam(ctx, teamID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTeam", reflect.TypeOf((*MockStorage)(nil).DeleteTeam), ctx, teamID)
+}
+
+// DeleteTeamMembership mocks base method.
+func (m *MockStorage) DeleteTeamMembership(ctx context.Context, teamID, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTeamMembership", ctx, teamID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTeamMembership indicates an expected call of DeleteTeamMembership.
+func (mr *MockStorageMockRecorder) DeleteTeamMembership(ctx, teamID, userID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUsers", reflect.TypeOf((*MockStorage)(nil).CreateUsers), ctx, requests, teamID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTeamMembership", reflect.TypeOf((*MockStorage)(nil).DeleteTeamMembership), ctx, teamID, userID)
+}
+
+// DeleteUnavailability mocks base method.
+func (m *MockStorage) DeleteUnavailability(ctx context.Context, userID string, windowID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUnavailability", ctx, userID, windowID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUnavailability indicates an expected call of DeleteUnavailability.
+func (mr *MockStorageMockRecorder) DeleteUnavailability(ctx, userID, windowID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUnavailability", reflect.TypeOf((*MockStorage)(nil).DeleteUnavailability), ctx, userID, windowID)
+}
+
+// DeleteWebhookSubscription mocks base method.
+func (m *MockStorage) DeleteWebhookSubscription(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWebhookSubscription", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWebhookSubscription indicates an expected call of DeleteWebhookSubscription.
+func (mr *MockStorageMockRecorder) DeleteWebhookSubscription(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWebhookSubscription", reflect.TypeOf((*MockStorage)(nil).DeleteWebhookSubscription), ctx, id)
 }
 
 // GetActiveColleagues mocks base method.
-func (m *MockStorage) GetActiveColleagues(ctx context.Context, userID string) ([]domain.User, error) {
+func (m *MockStorage) GetActiveColleagues(ctx context.Context, userID, teamID string) ([]domain.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetActiveColleagues", ctx, userID)
+	ret := m.ctrl.Call(m, "GetActiveColleagues", ctx, userID, teamID)
 	ret0, _ := ret[0].([]domain.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetActiveColleagues indicates an expected call of GetActiveColleagues.
-func (mr *MockStorageMockRecorder) GetActiveColleagues(ctx, userID any) *gomock.Call {
+func (mr *MockStorageMockRecorder) GetActiveColleagues(ctx, userID, teamID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActiveColleagues", reflect.TypeOf((*MockStorage)(nil).GetActiveColleagues), ctx, userID, teamID)
+}
+
+// GetActivePullRequestsByReviewers mocks base method.
+func (m *MockStorage) GetActivePullRequestsByReviewers(ctx context.Context, userIDs []string) ([]domain.PullRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActivePullRequestsByReviewers", ctx, userIDs)
+	ret0, _ := ret[0].([]domain.PullRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActivePullRequestsByReviewers indicates an expected call of GetActivePullRequestsByReviewers.
+func (mr *MockStorageMockRecorder) GetActivePullRequestsByReviewers(ctx, userIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActivePullRequestsByReviewers", reflect.TypeOf((*MockStorage)(nil).GetActivePullRequestsByReviewers), ctx, userIDs)
+}
+
+// GetActiveTeamLeads mocks base method.
+func (m *MockStorage) GetActiveTeamLeads(ctx context.Context, teamID string) ([]domain.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActiveTeamLeads", ctx, teamID)
+	ret0, _ := ret[0].([]domain.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActiveTeamLeads indicates an expected call of GetActiveTeamLeads.
+func (mr *MockStorageMockRecorder) GetActiveTeamLeads(ctx, teamID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActiveTeamLeads", reflect.TypeOf((*MockStorage)(nil).GetActiveTeamLeads), ctx, teamID)
+}
+
+// GetActiveTeamMembers mocks base method.
+func (m *MockStorage) GetActiveTeamMembers(ctx context.Context, teamID string) ([]domain.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActiveTeamMembers", ctx, teamID)
+	ret0, _ := ret[0].([]domain.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActiveTeamMembers indicates an expected call of GetActiveTeamMembers.
+func (mr *MockStorageMockRecorder) GetActiveTeamMembers(ctx, teamID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActiveTeamMembers", reflect.TypeOf((*MockStorage)(nil).GetActiveTeamMembers), ctx, teamID)
+}
+
+// GetAssignedReviews mocks base method.
+func (m *MockStorage) GetAssignedReviews(ctx context.Context, filter domain.AssignedReviewFilter, limit int) ([]domain.AssignedReview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAssignedReviews", ctx, filter, limit)
+	ret0, _ := ret[0].([]domain.AssignedReview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAssignedReviews indicates an expected call of GetAssignedReviews.
+func (mr *MockStorageMockRecorder) GetAssignedReviews(ctx, filter, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAssignedReviews", reflect.TypeOf((*MockStorage)(nil).GetAssignedReviews), ctx, filter, limit)
+}
+
+// GetAuditLog mocks base method.
+func (m *MockStorage) GetAuditLog(ctx context.Context, request domain.GetAuditLogRequest, limit int) ([]domain.AuditEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuditLog", ctx, request, limit)
+	ret0, _ := ret[0].([]domain.AuditEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAuditLog indicates an expected call of GetAuditLog.
+func (mr *MockStorageMockRecorder) GetAuditLog(ctx, request, limit any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActiveColleagues", reflect.TypeOf((*MockStorage)(nil).GetActiveColleagues), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuditLog", reflect.TypeOf((*MockStorage)(nil).GetAuditLog), ctx, request, limit)
+}
+
+// GetDispatchedOutboxEvents mocks base method.
+func (m *MockStorage) GetDispatchedOutboxEvents(ctx context.Context, filter domain.EventStreamFilter, afterSeq int64, limit int) ([]domain.StreamEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDispatchedOutboxEvents", ctx, filter, afterSeq, limit)
+	ret0, _ := ret[0].([]domain.StreamEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDispatchedOutboxEvents indicates an expected call of GetDispatchedOutboxEvents.
+func (mr *MockStorageMockRecorder) GetDispatchedOutboxEvents(ctx, filter, afterSeq, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDispatchedOutboxEvents", reflect.TypeOf((*MockStorage)(nil).GetDispatchedOutboxEvents), ctx, filter, afterSeq, limit)
+}
+
+// GetLastOutboxEventSeq mocks base method.
+func (m *MockStorage) GetLastOutboxEventSeq(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLastOutboxEventSeq", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLastOutboxEventSeq indicates an expected call of GetLastOutboxEventSeq.
+func (mr *MockStorageMockRecorder) GetLastOutboxEventSeq(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastOutboxEventSeq", reflect.TypeOf((*MockStorage)(nil).GetLastOutboxEventSeq), ctx)
+}
+
+// GetOpenReviewsCountByUsers mocks base method.
+func (m *MockStorage) GetOpenReviewsCountByUsers(ctx context.Context, userIDs []string) (map[string]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOpenReviewsCountByUsers", ctx, userIDs)
+	ret0, _ := ret[0].(map[string]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOpenReviewsCountByUsers indicates an expected call of GetOpenReviewsCountByUsers.
+func (mr *MockStorageMockRecorder) GetOpenReviewsCountByUsers(ctx, userIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOpenReviewsCountByUsers", reflect.TypeOf((*MockStorage)(nil).GetOpenReviewsCountByUsers), ctx, userIDs)
 }
 
 // GetPullRequestByID mocks base method.
@@ -120,6 +466,21 @@ func (mr *MockStorageMockRecorder) GetPullRequestsByReviewer(ctx, userID any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPullRequestsByReviewer", reflect.TypeOf((*MockStorage)(nil).GetPullRequestsByReviewer), ctx, userID)
 }
 
+// GetPullRequestsPastSLA mocks base method.
+func (m *MockStorage) GetPullRequestsPastSLA(ctx context.Context, teamID string, now time.Time) ([]domain.PullRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPullRequestsPastSLA", ctx, teamID, now)
+	ret0, _ := ret[0].([]domain.PullRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPullRequestsPastSLA indicates an expected call of GetPullRequestsPastSLA.
+func (mr *MockStorageMockRecorder) GetPullRequestsPastSLA(ctx, teamID, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPullRequestsPastSLA", reflect.TypeOf((*MockStorage)(nil).GetPullRequestsPastSLA), ctx, teamID, now)
+}
+
 // GetPullRequestsStats mocks base method.
 func (m *MockStorage) GetPullRequestsStats(ctx context.Context) ([]domain.PullRequestStats, error) {
 	m.ctrl.T.Helper()
@@ -135,6 +496,21 @@ func (mr *MockStorageMockRecorder) GetPullRequestsStats(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPullRequestsStats", reflect.TypeOf((*MockStorage)(nil).GetPullRequestsStats), ctx)
 }
 
+// GetTeamByID mocks base method.
+func (m *MockStorage) GetTeamByID(ctx context.Context, teamID string) (domain.Team, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTeamByID", ctx, teamID)
+	ret0, _ := ret[0].(domain.Team)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTeamByID indicates an expected call of GetTeamByID.
+func (mr *MockStorageMockRecorder) GetTeamByID(ctx, teamID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeamByID", reflect.TypeOf((*MockStorage)(nil).GetTeamByID), ctx, teamID)
+}
+
 // GetTeamByName mocks base method.
 func (m *MockStorage) GetTeamByName(ctx context.Context, teamName string) (domain.Team, error) {
 	m.ctrl.T.Helper()
@@ -150,6 +526,21 @@ func (mr *MockStorageMockRecorder) GetTeamByName(ctx, teamName any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeamByName", reflect.TypeOf((*MockStorage)(nil).GetTeamByName), ctx, teamName)
 }
 
+// GetTeamFallbacks mocks base method.
+func (m *MockStorage) GetTeamFallbacks(ctx context.Context, teamID string) ([]domain.Team, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTeamFallbacks", ctx, teamID)
+	ret0, _ := ret[0].([]domain.Team)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTeamFallbacks indicates an expected call of GetTeamFallbacks.
+func (mr *MockStorageMockRecorder) GetTeamFallbacks(ctx, teamID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeamFallbacks", reflect.TypeOf((*MockStorage)(nil).GetTeamFallbacks), ctx, teamID)
+}
+
 // GetTeamFullByName mocks base method.
 func (m *MockStorage) GetTeamFullByName(ctx context.Context, teamName string) (domain.Team, []domain.User, error) {
 	m.ctrl.T.Helper()
@@ -166,14 +557,43 @@ func (mr *MockStorageMockRecorder) GetTeamFullByName(ctx, teamName any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeamFullByName", reflect.TypeOf((*MockStorage)(nil).GetTeamFullByName), ctx, teamName)
 }
 
+// GetTeamOwnerRules mocks base method.
+func (m *MockStorage) GetTeamOwnerRules(ctx context.Context, teamID string) ([]domain.OwnerRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTeamOwnerRules", ctx, teamID)
+	ret0, _ := ret[0].([]domain.OwnerRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTeamOwnerRules indicates an expected call of GetTeamOwnerRules.
+func (mr *MockStorageMockRecorder) GetTeamOwnerRules(ctx, teamID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeamOwnerRules", reflect.TypeOf((*MockStorage)(nil).GetTeamOwnerRules), ctx, teamID)
+}
+
+// GetTeamReviewerCursor mocks base method.
+func (m *MockStorage) GetTeamReviewerCursor(ctx context.Context, teamID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTeamReviewerCursor", ctx, teamID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTeamReviewerCursor indicates an expected call of GetTeamReviewerCursor.
+func (mr *MockStorageMockRecorder) GetTeamReviewerCursor(ctx, teamID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeamReviewerCursor", reflect.TypeOf((*MockStorage)(nil).GetTeamReviewerCursor), ctx, teamID)
+}
+
 // GetUserFull mocks base method.
-func (m *MockStorage) GetUserFull(ctx context.Context, userID string) (domain.User, domain.Team, error) {
+func (m *MockStorage) GetUserFull(ctx context.Context, userID string) (domain.User, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetUserFull", ctx, userID)
 	ret0, _ := ret[0].(domain.User)
-	ret1, _ := ret[1].(domain.Team)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // GetUserFull indicates an expected call of GetUserFull.
@@ -197,6 +617,51 @@ func (mr *MockStorageMockRecorder) GetUserShort(ctx, userID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserShort", reflect.TypeOf((*MockStorage)(nil).GetUserShort), ctx, userID)
 }
 
+// GetUserUnavailability mocks base method.
+func (m *MockStorage) GetUserUnavailability(ctx context.Context, userID string, now time.Time) ([]domain.Unavailability, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserUnavailability", ctx, userID, now)
+	ret0, _ := ret[0].([]domain.Unavailability)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserUnavailability indicates an expected call of GetUserUnavailability.
+func (mr *MockStorageMockRecorder) GetUserUnavailability(ctx, userID, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserUnavailability", reflect.TypeOf((*MockStorage)(nil).GetUserUnavailability), ctx, userID, now)
+}
+
+// GetUsersByIDs mocks base method.
+func (m *MockStorage) GetUsersByIDs(ctx context.Context, userIDs []string) ([]domain.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUsersByIDs", ctx, userIDs)
+	ret0, _ := ret[0].([]domain.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUsersByIDs indicates an expected call of GetUsersByIDs.
+func (mr *MockStorageMockRecorder) GetUsersByIDs(ctx, userIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsersByIDs", reflect.TypeOf((*MockStorage)(nil).GetUsersByIDs), ctx, userIDs)
+}
+
+// GetUsersLastAssignedAt mocks base method.
+func (m *MockStorage) GetUsersLastAssignedAt(ctx context.Context, userIDs []string) (map[string]time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUsersLastAssignedAt", ctx, userIDs)
+	ret0, _ := ret[0].(map[string]time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUsersLastAssignedAt indicates an expected call of GetUsersLastAssignedAt.
+func (mr *MockStorageMockRecorder) GetUsersLastAssignedAt(ctx, userIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsersLastAssignedAt", reflect.TypeOf((*MockStorage)(nil).GetUsersLastAssignedAt), ctx, userIDs)
+}
+
 // GetUsersStats mocks base method.
 func (m *MockStorage) GetUsersStats(ctx context.Context) ([]domain.UserStats, error) {
 	m.ctrl.T.Helper()
@@ -212,32 +677,206 @@ func (mr *MockStorageMockRecorder) GetUsersStats(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsersStats", reflect.TypeOf((*MockStorage)(nil).GetUsersStats), ctx)
 }
 
-// PullRequestAssignmentsIncrement mocks base method.
-func (m *MockStorage) PullRequestAssignmentsIncrement(ctx context.Context, pullRequestID string) error {
+// GetWebhookDeliveries mocks base method.
+func (m *MockStorage) GetWebhookDeliveries(ctx context.Context, request domain.GetWebhookDeliveriesRequest) ([]domain.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhookDeliveries", ctx, request)
+	ret0, _ := ret[0].([]domain.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWebhookDeliveries indicates an expected call of GetWebhookDeliveries.
+func (mr *MockStorageMockRecorder) GetWebhookDeliveries(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhookDeliveries", reflect.TypeOf((*MockStorage)(nil).GetWebhookDeliveries), ctx, request)
+}
+
+// GetWebhookSubscriptionByID mocks base method.
+func (m *MockStorage) GetWebhookSubscriptionByID(ctx context.Context, id int64) (domain.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhookSubscriptionByID", ctx, id)
+	ret0, _ := ret[0].(domain.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWebhookSubscriptionByID indicates an expected call of GetWebhookSubscriptionByID.
+func (mr *MockStorageMockRecorder) GetWebhookSubscriptionByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhookSubscriptionByID", reflect.TypeOf((*MockStorage)(nil).GetWebhookSubscriptionByID), ctx, id)
+}
+
+// GetWebhookSubscriptions mocks base method.
+func (m *MockStorage) GetWebhookSubscriptions(ctx context.Context) ([]domain.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhookSubscriptions", ctx)
+	ret0, _ := ret[0].([]domain.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWebhookSubscriptions indicates an expected call of GetWebhookSubscriptions.
+func (mr *MockStorageMockRecorder) GetWebhookSubscriptions(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhookSubscriptions", reflect.TypeOf((*MockStorage)(nil).GetWebhookSubscriptions), ctx)
+}
+
+// ListPullRequests mocks base method.
+func (m *MockStorage) ListPullRequests(ctx context.Context, filter domain.PullRequestFilter, limit int) ([]domain.PullRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPullRequests", ctx, filter, limit)
+	ret0, _ := ret[0].([]domain.PullRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPullRequests indicates an expected call of ListPullRequests.
+func (mr *MockStorageMockRecorder) ListPullRequests(ctx, filter, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPullRequests", reflect.TypeOf((*MockStorage)(nil).ListPullRequests), ctx, filter, limit)
+}
+
+// LockOutboxEvents mocks base method.
+func (m *MockStorage) LockOutboxEvents(ctx context.Context, limit int) ([]domain.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockOutboxEvents", ctx, limit)
+	ret0, _ := ret[0].([]domain.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockOutboxEvents indicates an expected call of LockOutboxEvents.
+func (mr *MockStorageMockRecorder) LockOutboxEvents(ctx, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockOutboxEvents", reflect.TypeOf((*MockStorage)(nil).LockOutboxEvents), ctx, limit)
+}
+
+// LockPullRequest mocks base method.
+func (m *MockStorage) LockPullRequest(ctx context.Context, prID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockPullRequest", ctx, prID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockPullRequest indicates an expected call of LockPullRequest.
+func (mr *MockStorageMockRecorder) LockPullRequest(ctx, prID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockPullRequest", reflect.TypeOf((*MockStorage)(nil).LockPullRequest), ctx, prID)
+}
+
+// LockReviewAssignment mocks base method.
+func (m *MockStorage) LockReviewAssignment(ctx context.Context, prID, userID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockReviewAssignment", ctx, prID, userID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockReviewAssignment indicates an expected call of LockReviewAssignment.
+func (mr *MockStorageMockRecorder) LockReviewAssignment(ctx, prID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockReviewAssignment", reflect.TypeOf((*MockStorage)(nil).LockReviewAssignment), ctx, prID, userID)
+}
+
+// MarkOutboxEventsDispatched mocks base method.
+func (m *MockStorage) MarkOutboxEventsDispatched(ctx context.Context, eventIDs []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkOutboxEventsDispatched", ctx, eventIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkOutboxEventsDispatched indicates an expected call of MarkOutboxEventsDispatched.
+func (mr *MockStorageMockRecorder) MarkOutboxEventsDispatched(ctx, eventIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkOutboxEventsDispatched", reflect.TypeOf((*MockStorage)(nil).MarkOutboxEventsDispatched), ctx, eventIDs)
+}
+
+// MarkReviewEscalated mocks base method.
+func (m *MockStorage) MarkReviewEscalated(ctx context.Context, prID, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkReviewEscalated", ctx, prID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkReviewEscalated indicates an expected call of MarkReviewEscalated.
+func (mr *MockStorageMockRecorder) MarkReviewEscalated(ctx, prID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkReviewEscalated", reflect.TypeOf((*MockStorage)(nil).MarkReviewEscalated), ctx, prID, userID)
+}
+
+// RenameTeam mocks base method.
+func (m *MockStorage) RenameTeam(ctx context.Context, team domain.Team, newName string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PullRequestAssignmentsIncrement", ctx, pullRequestID)
+	ret := m.ctrl.Call(m, "RenameTeam", ctx, team, newName)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// PullRequestAssignmentsIncrement indicates an expected call of PullRequestAssignmentsIncrement.
-func (mr *MockStorageMockRecorder) PullRequestAssignmentsIncrement(ctx, pullRequestID any) *gomock.Call {
+// RenameTeam indicates an expected call of RenameTeam.
+func (mr *MockStorageMockRecorder) RenameTeam(ctx, team, newName any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PullRequestAssignmentsIncrement", reflect.TypeOf((*MockStorage)(nil).PullRequestAssignmentsIncrement), ctx, pullRequestID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameTeam", reflect.TypeOf((*MockStorage)(nil).RenameTeam), ctx, team, newName)
 }
 
-// PullRequestStatsCreate mocks base method.
-func (m *MockStorage) PullRequestStatsCreate(ctx context.Context, pullRequestID string, assignmentsCount int) error {
+// ReplacePullRequestReviewers mocks base method.
+func (m *MockStorage) ReplacePullRequestReviewers(ctx context.Context, replacements []domain.ReviewerReplacement, assignments []domain.ReviewerAssignment, reason domain.AssignmentReason) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PullRequestStatsCreate", ctx, pullRequestID, assignmentsCount)
+	ret := m.ctrl.Call(m, "ReplacePullRequestReviewers", ctx, replacements, assignments, reason)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// PullRequestStatsCreate indicates an expected call of PullRequestStatsCreate.
-func (mr *MockStorageMockRecorder) PullRequestStatsCreate(ctx, pullRequestID, assignmentsCount any) *gomock.Call {
+// ReplacePullRequestReviewers indicates an expected call of ReplacePullRequestReviewers.
+func (mr *MockStorageMockRecorder) ReplacePullRequestReviewers(ctx, replacements, assignments, reason any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PullRequestStatsCreate", reflect.TypeOf((*MockStorage)(nil).PullRequestStatsCreate), ctx, pullRequestID, assignmentsCount)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplacePullRequestReviewers", reflect.TypeOf((*MockStorage)(nil).ReplacePullRequestReviewers), ctx, replacements, assignments, reason)
+}
+
+// SetTeamFallbacks mocks base method.
+func (m *MockStorage) SetTeamFallbacks(ctx context.Context, teamID string, fallbackTeamIDs []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTeamFallbacks", ctx, teamID, fallbackTeamIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetTeamFallbacks indicates an expected call of SetTeamFallbacks.
+func (mr *MockStorageMockRecorder) SetTeamFallbacks(ctx, teamID, fallbackTeamIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTeamFallbacks", reflect.TypeOf((*MockStorage)(nil).SetTeamFallbacks), ctx, teamID, fallbackTeamIDs)
+}
+
+// SetTeamOwnerRules mocks base method.
+func (m *MockStorage) SetTeamOwnerRules(ctx context.Context, teamID string, rules []domain.OwnerRule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTeamOwnerRules", ctx, teamID, rules)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetTeamOwnerRules indicates an expected call of SetTeamOwnerRules.
+func (mr *MockStorageMockRecorder) SetTeamOwnerRules(ctx, teamID, rules any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTeamOwnerRules", reflect.TypeOf((*MockStorage)(nil).SetTeamOwnerRules), ctx, teamID, rules)
+}
+
+// UnassignPullRequestReviewer mocks base method.
+func (m *MockStorage) UnassignPullRequestReviewer(ctx context.Context, prID, userID string, reason domain.AssignmentReason) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnassignPullRequestReviewer", ctx, prID, userID, reason)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnassignPullRequestReviewer indicates an expected call of UnassignPullRequestReviewer.
+func (mr *MockStorageMockRecorder) UnassignPullRequestReviewer(ctx, prID, userID, reason any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnassignPullRequestReviewer", reflect.TypeOf((*MockStorage)(nil).UnassignPullRequestReviewer), ctx, prID, userID, reason)
 }
 
 // UnitOfWork mocks base method.
@@ -254,18 +893,18 @@ func (mr *MockStorageMockRecorder) UnitOfWork(ctx, do any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnitOfWork", reflect.TypeOf((*MockStorage)(nil).UnitOfWork), ctx, do)
 }
 
-// UpdatePullRequestReviewersIDs mocks base method.
-func (m *MockStorage) UpdatePullRequestReviewersIDs(ctx context.Context, prID string, reviewersIDs []string) error {
+// UpdatePullRequestNeedsMoreReviewers mocks base method.
+func (m *MockStorage) UpdatePullRequestNeedsMoreReviewers(ctx context.Context, prID string, needsMoreReviewers bool) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdatePullRequestReviewersIDs", ctx, prID, reviewersIDs)
+	ret := m.ctrl.Call(m, "UpdatePullRequestNeedsMoreReviewers", ctx, prID, needsMoreReviewers)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// UpdatePullRequestReviewersIDs indicates an expected call of UpdatePullRequestReviewersIDs.
-func (mr *MockStorageMockRecorder) UpdatePullRequestReviewersIDs(ctx, prID, reviewersIDs any) *gomock.Call {
+// UpdatePullRequestNeedsMoreReviewers indicates an expected call of UpdatePullRequestNeedsMoreReviewers.
+func (mr *MockStorageMockRecorder) UpdatePullRequestNeedsMoreReviewers(ctx, prID, needsMoreReviewers any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePullRequestReviewersIDs", reflect.TypeOf((*MockStorage)(nil).UpdatePullRequestReviewersIDs), ctx, prID, reviewersIDs)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePullRequestNeedsMoreReviewers", reflect.TypeOf((*MockStorage)(nil).UpdatePullRequestNeedsMoreReviewers), ctx, prID, needsMoreReviewers)
 }
 
 // UpdatePullRequestStatus mocks base method.
@@ -282,6 +921,62 @@ func (mr *MockStorageMockRecorder) UpdatePullRequestStatus(ctx, prID, newStatus
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePullRequestStatus", reflect.TypeOf((*MockStorage)(nil).UpdatePullRequestStatus), ctx, prID, newStatus)
 }
 
+// UpdateTeamMemberRole mocks base method.
+func (m *MockStorage) UpdateTeamMemberRole(ctx context.Context, teamID, userID string, role domain.TeamRole) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTeamMemberRole", ctx, teamID, userID, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTeamMemberRole indicates an expected call of UpdateTeamMemberRole.
+func (mr *MockStorageMockRecorder) UpdateTeamMemberRole(ctx, teamID, userID, role any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTeamMemberRole", reflect.TypeOf((*MockStorage)(nil).UpdateTeamMemberRole), ctx, teamID, userID, role)
+}
+
+// UpdateTeamReviewerCursor mocks base method.
+func (m *MockStorage) UpdateTeamReviewerCursor(ctx context.Context, teamID, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTeamReviewerCursor", ctx, teamID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTeamReviewerCursor indicates an expected call of UpdateTeamReviewerCursor.
+func (mr *MockStorageMockRecorder) UpdateTeamReviewerCursor(ctx, teamID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTeamReviewerCursor", reflect.TypeOf((*MockStorage)(nil).UpdateTeamReviewerCursor), ctx, teamID, userID)
+}
+
+// UpdateTeamSettings mocks base method.
+func (m *MockStorage) UpdateTeamSettings(ctx context.Context, request domain.UpdateTeamSettingsRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTeamSettings", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTeamSettings indicates an expected call of UpdateTeamSettings.
+func (mr *MockStorageMockRecorder) UpdateTeamSettings(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTeamSettings", reflect.TypeOf((*MockStorage)(nil).UpdateTeamSettings), ctx, request)
+}
+
+// UpdateUserName mocks base method.
+func (m *MockStorage) UpdateUserName(ctx context.Context, userID, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserName", ctx, userID, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateUserName indicates an expected call of UpdateUserName.
+func (mr *MockStorageMockRecorder) UpdateUserName(ctx, userID, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserName", reflect.TypeOf((*MockStorage)(nil).UpdateUserName), ctx, userID, name)
+}
+
 // UpdateUserStatus mocks base method.
 func (m *MockStorage) UpdateUserStatus(ctx context.Context, userID string, isActive bool) error {
 	m.ctrl.T.Helper()
@@ -296,18 +991,46 @@ func (mr *MockStorageMockRecorder) UpdateUserStatus(ctx, userID, isActive any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserStatus", reflect.TypeOf((*MockStorage)(nil).UpdateUserStatus), ctx, userID, isActive)
 }
 
-// UserAssignmentsIncrementBatch mocks base method.
-func (m *MockStorage) UserAssignmentsIncrementBatch(ctx context.Context, userIDs []string) error {
+// UpdateUserWorkingHours mocks base method.
+func (m *MockStorage) UpdateUserWorkingHours(ctx context.Context, userID, timezone string, hours *domain.WorkingHours) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UserAssignmentsIncrementBatch", ctx, userIDs)
+	ret := m.ctrl.Call(m, "UpdateUserWorkingHours", ctx, userID, timezone, hours)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// UserAssignmentsIncrementBatch indicates an expected call of UserAssignmentsIncrementBatch.
-func (mr *MockStorageMockRecorder) UserAssignmentsIncrementBatch(ctx, userIDs any) *gomock.Call {
+// UpdateUserWorkingHours indicates an expected call of UpdateUserWorkingHours.
+func (mr *MockStorageMockRecorder) UpdateUserWorkingHours(ctx, userID, timezone, hours any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserAssignmentsIncrementBatch", reflect.TypeOf((*MockStorage)(nil).UserAssignmentsIncrementBatch), ctx, userIDs)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserWorkingHours", reflect.TypeOf((*MockStorage)(nil).UpdateUserWorkingHours), ctx, userID, timezone, hours)
+}
+
+// UpdateUsersStatus mocks base method.
+func (m *MockStorage) UpdateUsersStatus(ctx context.Context, userIDs []string, isActive bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUsersStatus", ctx, userIDs, isActive)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateUsersStatus indicates an expected call of UpdateUsersStatus.
+func (mr *MockStorageMockRecorder) UpdateUsersStatus(ctx, userIDs, isActive any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUsersStatus", reflect.TypeOf((*MockStorage)(nil).UpdateUsersStatus), ctx, userIDs, isActive)
+}
+
+// UpdateWebhookDelivery mocks base method.
+func (m *MockStorage) UpdateWebhookDelivery(ctx context.Context, delivery domain.WebhookDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWebhookDelivery", ctx, delivery)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateWebhookDelivery indicates an expected call of UpdateWebhookDelivery.
+func (mr *MockStorageMockRecorder) UpdateWebhookDelivery(ctx, delivery any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWebhookDelivery", reflect.TypeOf((*MockStorage)(nil).UpdateWebhookDelivery), ctx, delivery)
 }
 
 // UserStatsCreateBatch mocks base method.
@@ -337,3 +1060,80 @@ func (mr *MockStorageMockRecorder) UserStatusChangesIncrementBatch(ctx, userIDs
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserStatusChangesIncrementBatch", reflect.TypeOf((*MockStorage)(nil).UserStatusChangesIncrementBatch), ctx, userIDs)
 }
+
+// MockWebhookSender is a mock of WebhookSender interface.
+type MockWebhookSender struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookSenderMockRecorder
+	isgomock struct{}
+}
+
+// MockWebhookSenderMockRecorder is the mock recorder for MockWebhookSender.
+type MockWebhookSenderMockRecorder struct {
+	mock *MockWebhookSender
+}
+
+// NewMockWebhookSender creates a new mock instance.
+func NewMockWebhookSender(ctrl *gomock.Controller) *MockWebhookSender {
+	mock := &MockWebhookSender{ctrl: ctrl}
+	mock.recorder = &MockWebhookSenderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookSender) EXPECT() *MockWebhookSenderMockRecorder {
+	return m.recorder
+}
+
+// Send mocks base method.
+func (m *MockWebhookSender) Send(ctx context.Context, delivery domain.WebhookDelivery) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", ctx, delivery)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockWebhookSenderMockRecorder) Send(ctx, delivery any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockWebhookSender)(nil).Send), ctx, delivery)
+}
+
+// MockEventSink is a mock of EventSink interface.
+type MockEventSink struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventSinkMockRecorder
+	isgomock struct{}
+}
+
+// MockEventSinkMockRecorder is the mock recorder for MockEventSink.
+type MockEventSinkMockRecorder struct {
+	mock *MockEventSink
+}
+
+// NewMockEventSink creates a new mock instance.
+func NewMockEventSink(ctrl *gomock.Controller) *MockEventSink {
+	mock := &MockEventSink{ctrl: ctrl}
+	mock.recorder = &MockEventSinkMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEventSink) EXPECT() *MockEventSinkMockRecorder {
+	return m.recorder
+}
+
+// Publish mocks base method.
+func (m *MockEventSink) Publish(ctx context.Context, events []domain.Event) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Publish", ctx, events)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Publish indicates an expected call of Publish.
+func (mr *MockEventSinkMockRecorder) Publish(ctx, events any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockEventSink)(nil).Publish), ctx, events)
+}
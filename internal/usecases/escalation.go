@@ -0,0 +1,205 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"pr-manager-service/internal/domain"
+)
+
+// escalationOutcome - чем закончилась обработка одного просроченного ревью.
+type escalationOutcome uint8
+
+const (
+	escalationSkipped escalationOutcome = iota
+	escalationReassigned
+	escalationEscalated
+	escalationUnresolved
+)
+
+// EscalateOverdueReviews обрабатывает ревью, просроченные по SLA команды PR: ревьювер заменяется коллегой
+// так же, как при ReassignPullRequest, а если замены нет - лидом своей команды. Если не нашлось и лида,
+// назначение отмечается эскалированным и больше не обрабатывается.
+// Каждое ревью обрабатывается в своей транзакции под блокировкой назначения, поэтому несколько реплик
+// сервиса не переназначат одно ревью дважды. Ошибка одного ревью не останавливает проход: ревью попадает
+// в result.Failed, а ошибки всех таких ревью возвращаются вместе после обработки остальных.
+func (u *Usecases) EscalateOverdueReviews(ctx context.Context) (domain.EscalationResult, error) {
+	result := domain.EscalationResult{
+		Reassigned: []domain.ReviewerReplacement{},
+		Escalated:  []domain.ReviewerReplacement{},
+		Unresolved: []domain.ReviewerReplacement{},
+		Failed:     []domain.ReviewerReplacement{},
+	}
+
+	pullRequests, err := u.GetOverduePullRequests(ctx, "")
+	if err != nil {
+		return domain.EscalationResult{}, fmt.Errorf("GetOverduePullRequests: %w", err)
+	}
+
+	var errs []error
+
+	for _, pr := range pullRequests {
+		for _, reviewerID := range pr.ReviewersUsersIDs {
+			pool := pr.ReviewerPools[reviewerID]
+			if !pool.IsOverdue || pool.Escalated {
+				continue
+			}
+
+			// NOTE: воркер останавливается между ревью, не дожидаясь конца всего списка
+			if err := ctx.Err(); err != nil {
+				return result, errors.Join(append(errs, err)...)
+			}
+
+			replacement := domain.ReviewerReplacement{
+				PullRequestID: pr.ID,
+				OldReviewerID: reviewerID,
+			}
+
+			outcome, err := u.escalateReview(ctx, &replacement)
+			// NOTE: список просроченных строится в одном порядке, поэтому ошибка одного ревью
+			// не должна блокировать эскалацию всех следующих за ним
+			if err != nil {
+				result.Failed = append(result.Failed, replacement)
+				errs = append(errs, fmt.Errorf("escalateReview %s/%s: %w", pr.ID, reviewerID, err))
+				continue
+			}
+
+			switch outcome {
+			case escalationReassigned:
+				result.Reassigned = append(result.Reassigned, replacement)
+			case escalationEscalated:
+				result.Escalated = append(result.Escalated, replacement)
+			case escalationUnresolved:
+				result.Unresolved = append(result.Unresolved, replacement)
+			}
+		}
+	}
+
+	return result, errors.Join(errs...)
+}
+
+// escalateReview заменяет просроченного ревьювера и записывает нового в replacement.NewReviewerID.
+func (u *Usecases) escalateReview(
+	ctx context.Context,
+	replacement *domain.ReviewerReplacement,
+) (escalationOutcome, error) {
+	outcome := escalationSkipped
+
+	if err := u.storage.UnitOfWork(ctx, func(s Storage) error {
+		outcome = escalationSkipped
+
+		locked, err := s.LockReviewAssignment(ctx, replacement.PullRequestID, replacement.OldReviewerID)
+		if err != nil {
+			return fmt.Errorf("LockReviewAssignment: %w", err)
+		}
+
+		// NOTE: назначение уже обрабатывает другая реплика или его сняли после чтения списка
+		if !locked {
+			return nil
+		}
+
+		pr, err := s.GetPullRequestByID(ctx, replacement.PullRequestID)
+		if err != nil {
+			return fmt.Errorf("GetPullRequestByID: %w", err)
+		}
+
+		if !pr.Status.IsActive() {
+			return nil
+		}
+
+		oldUser, err := s.GetUserShort(ctx, replacement.OldReviewerID)
+		if err != nil {
+			return fmt.Errorf("GetUserShort: %w", err)
+		}
+
+		replacement.NewReviewerID, err = u.replaceReviewer(ctx, s, pr, oldUser, domain.AssignmentReasonEscalation)
+		if err == nil {
+			outcome = escalationReassigned
+			return nil
+		}
+		if !errors.Is(err, domain.ErrNoCandidate) && !errors.Is(err, domain.ErrReviewersAtCapacity) {
+			return fmt.Errorf("replaceReviewer: %w", err)
+		}
+
+		replacement.NewReviewerID, err = u.replaceReviewerWithLead(ctx, s, pr, oldUser.ID)
+		if err != nil {
+			return fmt.Errorf("replaceReviewerWithLead: %w", err)
+		}
+
+		if replacement.NewReviewerID != "" {
+			outcome = escalationEscalated
+			return nil
+		}
+
+		if err := s.MarkReviewEscalated(ctx, pr.ID, oldUser.ID); err != nil {
+			return fmt.Errorf("MarkReviewEscalated: %w", err)
+		}
+
+		outcome = escalationUnresolved
+		return nil
+	}); err != nil {
+		return escalationSkipped, fmt.Errorf("UnitOfWork: %w", err)
+	}
+
+	return outcome, nil
+}
+
+// replaceReviewerWithLead передаёт ревью первому доступному лиду команды ревьювера.
+// Лимит открытых ревью на лида не действует. Возвращает пустую строку, если подходящего лида нет.
+func (u *Usecases) replaceReviewerWithLead(
+	ctx context.Context,
+	s Storage,
+	pr domain.PullRequest,
+	oldUserID string,
+) (string, error) {
+	team, err := u.reviewerTeam(ctx, s, pr, oldUserID)
+	if err != nil {
+		return "", fmt.Errorf("reviewerTeam: %w", err)
+	}
+
+	leads, err := s.GetActiveTeamLeads(ctx, team.ID)
+	if err != nil {
+		return "", fmt.Errorf("GetActiveTeamLeads: %w", err)
+	}
+
+	leadIdx := slices.IndexFunc(leads, func(lead domain.User) bool {
+		return lead.ID != pr.AuthorUserID && !slices.Contains(pr.ReviewersUsersIDs, lead.ID)
+	})
+	if leadIdx < 0 {
+		return "", nil
+	}
+
+	lead := leads[leadIdx]
+
+	if err := s.UnassignPullRequestReviewer(ctx, pr.ID, oldUserID, domain.AssignmentReasonEscalation); err != nil {
+		return "", fmt.Errorf("UnassignPullRequestReviewer: %w", err)
+	}
+
+	assignments := []domain.ReviewerAssignment{{
+		UserID:   lead.ID,
+		TeamID:   team.ID,
+		TeamName: team.Name,
+	}}
+
+	if err := s.AssignPullRequestReviewers(ctx, pr.ID, assignments, domain.AssignmentReasonEscalation); err != nil {
+		return "", fmt.Errorf("AssignPullRequestReviewers: %w", err)
+	}
+
+	replacements := []domain.ReviewerReplacement{{
+		PullRequestID: pr.ID,
+		OldReviewerID: oldUserID,
+		NewReviewerID: lead.ID,
+	}}
+
+	if err := u.emit(ctx, s, reviewerReassignedEvents(replacements, domain.AssignmentReasonEscalation)...); err != nil {
+		return "", fmt.Errorf("emit: %w", err)
+	}
+
+	if err := u.auditReviewerReplaced(ctx, s, pr, replacements[0]); err != nil {
+		return "", fmt.Errorf("auditReviewerReplaced: %w", err)
+	}
+
+	return lead.ID, nil
+}
@@ -0,0 +1,240 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"pr-manager-service/internal/clock"
+	"pr-manager-service/internal/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUsecases_EscalateOverdueReviews(t *testing.T) {
+	const (
+		teamID   = "300"
+		teamName = "team1"
+
+		authorID   = "100"
+		reviewerID = "101"
+		userID2    = "102"
+		leadID     = "103"
+
+		prID = "1"
+	)
+
+	now := time.Date(2025, time.November, 12, 12, 0, 0, 0, time.UTC)
+	team := domain.Team{ID: teamID, Name: teamName, ReviewSLAHours: 8}
+	reviewer := domain.User{ID: reviewerID, IsActive: true}
+
+	pullRequest := func(escalated bool) domain.PullRequest {
+		return domain.PullRequest{
+			ID:                prID,
+			AuthorUserID:      authorID,
+			Status:            domain.StatusOpen,
+			TeamID:            teamID,
+			ReviewersUsersIDs: []string{reviewerID},
+			ReviewerPools: map[string]domain.ReviewerPool{
+				reviewerID: {TeamName: teamName, AssignedAt: now.Add(-48 * time.Hour), Escalated: escalated},
+			},
+		}
+	}
+
+	mockOverdue := func(ms *MockStorage, escalated bool) {
+		ms.EXPECT().GetPullRequestsPastSLA(gomock.Any(), "", now).Return([]domain.PullRequest{pullRequest(escalated)}, nil)
+		ms.EXPECT().GetTeamByID(gomock.Any(), teamID).Return(team, nil)
+		ms.EXPECT().GetUsersByIDs(gomock.Any(), []string{reviewerID}).Return([]domain.User{reviewer}, nil)
+	}
+
+	mockLockedReview := func(ms *MockStorage) {
+		ms.EXPECT().UnitOfWork(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, fn func(s Storage) error) error {
+				return fn(ms)
+			})
+		ms.EXPECT().LockReviewAssignment(gomock.Any(), prID, reviewerID).Return(true, nil)
+		ms.EXPECT().GetPullRequestByID(gomock.Any(), prID).Return(pullRequest(false), nil)
+		ms.EXPECT().GetUserShort(gomock.Any(), reviewerID).Return(reviewer, nil)
+		ms.EXPECT().GetTeamByName(gomock.Any(), teamName).Return(team, nil).AnyTimes()
+	}
+
+	mockNoColleagues := func(ms *MockStorage) {
+		ms.EXPECT().GetActiveColleagues(gomock.Any(), reviewerID, teamID).Return([]domain.User{}, nil)
+		ms.EXPECT().GetTeamFallbacks(gomock.Any(), teamID).Return([]domain.Team{}, nil)
+	}
+
+	t.Run("reassigned", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		ms := NewMockStorage(ctrl)
+		mockOverdue(ms, false)
+		mockLockedReview(ms)
+
+		ms.EXPECT().GetActiveColleagues(gomock.Any(), reviewerID, teamID).
+			Return([]domain.User{{ID: userID2, IsActive: true}}, nil)
+		ms.EXPECT().UnassignPullRequestReviewer(gomock.Any(), prID, reviewerID, domain.AssignmentReasonEscalation).
+			Return(nil)
+		ms.EXPECT().AssignPullRequestReviewers(
+			gomock.Any(),
+			prID,
+			[]domain.ReviewerAssignment{{UserID: userID2, TeamID: teamID, TeamName: teamName}},
+			domain.AssignmentReasonEscalation,
+		).Return(nil)
+		ms.EXPECT().CreateOutboxEvents(gomock.Any(), eventsWithData(domain.ReviewerReassignedEvent{
+			PullRequestID: prID,
+			OldReviewerID: reviewerID,
+			NewReviewerID: userID2,
+			Reason:        domain.AssignmentReasonEscalation,
+		})).Return(nil)
+		ms.EXPECT().CreateAuditEntries(gomock.Any(), auditEntry(domain.AuditPullRequestReassign, prID, nil)).Return(nil)
+
+		u := NewUsecases(ms, WithClock(clock.NewFake(now)))
+		result, err := u.EscalateOverdueReviews(context.Background())
+		require.NoError(t, err)
+
+		assert.Equal(t, []domain.ReviewerReplacement{
+			{PullRequestID: prID, OldReviewerID: reviewerID, NewReviewerID: userID2},
+		}, result.Reassigned)
+		assert.Empty(t, result.Escalated)
+		assert.Empty(t, result.Unresolved)
+	})
+
+	t.Run("escalated_to_lead", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		ms := NewMockStorage(ctrl)
+		mockOverdue(ms, false)
+		mockLockedReview(ms)
+		mockNoColleagues(ms)
+
+		// NOTE: автор PR тоже лид, но ревьювером своего PR он быть не может
+		ms.EXPECT().GetActiveTeamLeads(gomock.Any(), teamID).
+			Return([]domain.User{{ID: authorID, IsActive: true}, {ID: leadID, IsActive: true}}, nil)
+		ms.EXPECT().UnassignPullRequestReviewer(gomock.Any(), prID, reviewerID, domain.AssignmentReasonEscalation).
+			Return(nil)
+		ms.EXPECT().AssignPullRequestReviewers(
+			gomock.Any(),
+			prID,
+			[]domain.ReviewerAssignment{{UserID: leadID, TeamID: teamID, TeamName: teamName}},
+			domain.AssignmentReasonEscalation,
+		).Return(nil)
+		ms.EXPECT().CreateOutboxEvents(gomock.Any(), eventsWithData(domain.ReviewerReassignedEvent{
+			PullRequestID: prID,
+			OldReviewerID: reviewerID,
+			NewReviewerID: leadID,
+			Reason:        domain.AssignmentReasonEscalation,
+		})).Return(nil)
+		ms.EXPECT().CreateAuditEntries(gomock.Any(), auditEntry(domain.AuditPullRequestReassign, prID, nil)).Return(nil)
+
+		u := NewUsecases(ms, WithClock(clock.NewFake(now)))
+		result, err := u.EscalateOverdueReviews(context.Background())
+		require.NoError(t, err)
+
+		assert.Empty(t, result.Reassigned)
+		assert.Equal(t, []domain.ReviewerReplacement{
+			{PullRequestID: prID, OldReviewerID: reviewerID, NewReviewerID: leadID},
+		}, result.Escalated)
+	})
+
+	t.Run("unresolved", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		ms := NewMockStorage(ctrl)
+		mockOverdue(ms, false)
+		mockLockedReview(ms)
+		mockNoColleagues(ms)
+
+		ms.EXPECT().GetActiveTeamLeads(gomock.Any(), teamID).Return([]domain.User{}, nil)
+		ms.EXPECT().MarkReviewEscalated(gomock.Any(), prID, reviewerID).Return(nil)
+
+		u := NewUsecases(ms, WithClock(clock.NewFake(now)))
+		result, err := u.EscalateOverdueReviews(context.Background())
+		require.NoError(t, err)
+
+		assert.Equal(t, []domain.ReviewerReplacement{
+			{PullRequestID: prID, OldReviewerID: reviewerID},
+		}, result.Unresolved)
+	})
+
+	t.Run("locked_by_other_replica", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		ms := NewMockStorage(ctrl)
+		mockOverdue(ms, false)
+
+		ms.EXPECT().UnitOfWork(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, fn func(s Storage) error) error {
+				return fn(ms)
+			})
+		ms.EXPECT().LockReviewAssignment(gomock.Any(), prID, reviewerID).Return(false, nil)
+
+		u := NewUsecases(ms, WithClock(clock.NewFake(now)))
+		result, err := u.EscalateOverdueReviews(context.Background())
+		require.NoError(t, err)
+
+		assert.Empty(t, result.Reassigned)
+		assert.Empty(t, result.Escalated)
+		assert.Empty(t, result.Unresolved)
+	})
+
+	t.Run("failed_review_does_not_block_others", func(t *testing.T) {
+		const failedPrID = "2"
+
+		ctrl := gomock.NewController(t)
+		ms := NewMockStorage(ctrl)
+
+		failedPr := pullRequest(false)
+		failedPr.ID = failedPrID
+		failedPr.ReviewerPools = map[string]domain.ReviewerPool{
+			reviewerID: {TeamName: teamName, AssignedAt: now.Add(-48 * time.Hour)},
+		}
+
+		ms.EXPECT().GetPullRequestsPastSLA(gomock.Any(), "", now).
+			Return([]domain.PullRequest{failedPr, pullRequest(false)}, nil)
+		ms.EXPECT().GetTeamByID(gomock.Any(), teamID).Return(team, nil)
+		ms.EXPECT().GetUsersByIDs(gomock.Any(), []string{reviewerID}).Return([]domain.User{reviewer}, nil)
+
+		ms.EXPECT().UnitOfWork(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, fn func(s Storage) error) error {
+				return fn(ms)
+			}).Times(2)
+		ms.EXPECT().LockReviewAssignment(gomock.Any(), failedPrID, reviewerID).Return(false, assert.AnError)
+
+		ms.EXPECT().LockReviewAssignment(gomock.Any(), prID, reviewerID).Return(true, nil)
+		ms.EXPECT().GetPullRequestByID(gomock.Any(), prID).Return(pullRequest(false), nil)
+		ms.EXPECT().GetUserShort(gomock.Any(), reviewerID).Return(reviewer, nil)
+		ms.EXPECT().GetTeamByName(gomock.Any(), teamName).Return(team, nil).AnyTimes()
+		ms.EXPECT().GetActiveColleagues(gomock.Any(), reviewerID, teamID).
+			Return([]domain.User{{ID: userID2, IsActive: true}}, nil)
+		ms.EXPECT().UnassignPullRequestReviewer(gomock.Any(), prID, reviewerID, domain.AssignmentReasonEscalation).
+			Return(nil)
+		ms.EXPECT().AssignPullRequestReviewers(
+			gomock.Any(),
+			prID,
+			[]domain.ReviewerAssignment{{UserID: userID2, TeamID: teamID, TeamName: teamName}},
+			domain.AssignmentReasonEscalation,
+		).Return(nil)
+		ms.EXPECT().CreateOutboxEvents(gomock.Any(), gomock.Any()).Return(nil)
+		ms.EXPECT().CreateAuditEntries(gomock.Any(), auditEntry(domain.AuditPullRequestReassign, prID, nil)).Return(nil)
+
+		u := NewUsecases(ms, WithClock(clock.NewFake(now)))
+		result, err := u.EscalateOverdueReviews(context.Background())
+		require.ErrorIs(t, err, assert.AnError)
+
+		assert.Equal(t, []domain.ReviewerReplacement{
+			{PullRequestID: failedPrID, OldReviewerID: reviewerID},
+		}, result.Failed)
+		assert.Equal(t, []domain.ReviewerReplacement{
+			{PullRequestID: prID, OldReviewerID: reviewerID, NewReviewerID: userID2},
+		}, result.Reassigned)
+	})
+
+	t.Run("already_escalated", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		ms := NewMockStorage(ctrl)
+		mockOverdue(ms, true)
+
+		u := NewUsecases(ms, WithClock(clock.NewFake(now)))
+		result, err := u.EscalateOverdueReviews(context.Background())
+		require.NoError(t, err)
+		assert.Empty(t, result.Unresolved)
+	})
+}
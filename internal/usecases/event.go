@@ -0,0 +1,67 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+
+	"pr-manager-service/internal/domain"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+// emit записывает события в outbox в той же транзакции s, что и изменения, которые они описывают:
+// получатели узнают о событии, только если транзакция зафиксирована. Передаёт события RelayOutboxEvents.
+func (u *Usecases) emit(ctx context.Context, s Storage, data ...domain.EventData) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	now := u.clock.Now()
+	events := lo.Map(data, func(eventData domain.EventData, _ int) domain.Event {
+		return domain.Event{
+			ID:         uuid.NewString(),
+			Type:       eventData.EventType(),
+			OccurredAt: now,
+			Data:       eventData,
+		}
+	})
+
+	if err := s.CreateOutboxEvents(ctx, events); err != nil {
+		return fmt.Errorf("CreateOutboxEvents: %w", err)
+	}
+
+	return nil
+}
+
+// reviewersAssignedEvents возвращает событие о назначении ревьюверов или ничего, если никто не назначен.
+func reviewersAssignedEvents(
+	prID string,
+	assignments []domain.ReviewerAssignment,
+	reason domain.AssignmentReason,
+) []domain.EventData {
+	if len(assignments) == 0 {
+		return nil
+	}
+
+	return []domain.EventData{domain.ReviewersAssignedEvent{
+		PullRequestID: prID,
+		ReviewerIDs:   assignmentsUsersIDs(assignments),
+		Reason:        reason,
+	}}
+}
+
+// reviewerReassignedEvents возвращает по событию на каждую замену ревьювера.
+func reviewerReassignedEvents(
+	replacements []domain.ReviewerReplacement,
+	reason domain.AssignmentReason,
+) []domain.EventData {
+	return lo.Map(replacements, func(replacement domain.ReviewerReplacement, _ int) domain.EventData {
+		return domain.ReviewerReassignedEvent{
+			PullRequestID: replacement.PullRequestID,
+			OldReviewerID: replacement.OldReviewerID,
+			NewReviewerID: replacement.NewReviewerID,
+			Reason:        reason,
+		}
+	})
+}
@@ -0,0 +1,53 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+
+	"pr-manager-service/internal/domain"
+)
+
+// streamEventsBatch - сколько событий потока читается за один запрос
+const streamEventsBatch = 100
+
+// OpenEventStream проверяет фильтр потока событий и возвращает позицию, после которой начинается поток:
+// request.LastEventID, если клиент продолжает поток, иначе - позицию последнего переданного события.
+func (u *Usecases) OpenEventStream(ctx context.Context, request domain.OpenEventStreamRequest) (int64, error) {
+	if request.Filter.UserID != "" {
+		if _, err := u.storage.GetUserShort(ctx, request.Filter.UserID); err != nil {
+			return 0, fmt.Errorf("storage.GetUserShort: %w", err)
+		}
+	}
+
+	if request.Filter.TeamName != "" {
+		if _, err := u.storage.GetTeamByName(ctx, request.Filter.TeamName); err != nil {
+			return 0, fmt.Errorf("storage.GetTeamByName: %w", err)
+		}
+	}
+
+	if request.LastEventID != nil {
+		return *request.LastEventID, nil
+	}
+
+	seq, err := u.storage.GetLastOutboxEventSeq(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("storage.GetLastOutboxEventSeq: %w", err)
+	}
+
+	return seq, nil
+}
+
+// GetStreamEvents возвращает очередные события потока после позиции afterSeq. В поток попадают только
+// события, которые релей outbox уже передал получателям.
+func (u *Usecases) GetStreamEvents(
+	ctx context.Context,
+	filter domain.EventStreamFilter,
+	afterSeq int64,
+) ([]domain.StreamEvent, error) {
+	events, err := u.storage.GetDispatchedOutboxEvents(ctx, filter, afterSeq, streamEventsBatch)
+	if err != nil {
+		return nil, fmt.Errorf("storage.GetDispatchedOutboxEvents: %w", err)
+	}
+
+	return events, nil
+}
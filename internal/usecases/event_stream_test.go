@@ -0,0 +1,61 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+
+	"pr-manager-service/internal/domain"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUsecases_OpenEventStream(t *testing.T) {
+	const (
+		userID   = "100"
+		teamName = "team1"
+	)
+
+	filter := domain.EventStreamFilter{UserID: userID, TeamName: teamName}
+
+	t.Run("from_last_event", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		ms := NewMockStorage(ctrl)
+
+		ms.EXPECT().GetUserShort(gomock.Any(), userID).Return(domain.User{ID: userID}, nil)
+		ms.EXPECT().GetTeamByName(gomock.Any(), teamName).Return(domain.Team{Name: teamName}, nil)
+		ms.EXPECT().GetLastOutboxEventSeq(gomock.Any()).Return(int64(42), nil)
+
+		u := NewUsecases(ms)
+		seq, err := u.OpenEventStream(context.Background(), domain.OpenEventStreamRequest{Filter: filter})
+		require.NoError(t, err)
+		assert.Equal(t, int64(42), seq)
+	})
+
+	t.Run("resume", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		ms := NewMockStorage(ctrl)
+
+		u := NewUsecases(ms)
+		seq, err := u.OpenEventStream(context.Background(), domain.OpenEventStreamRequest{
+			LastEventID: lo.ToPtr(int64(7)),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int64(7), seq)
+	})
+
+	t.Run("team_not_found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		ms := NewMockStorage(ctrl)
+
+		ms.EXPECT().GetTeamByName(gomock.Any(), teamName).Return(domain.Team{}, domain.ErrTeamNotFound)
+
+		u := NewUsecases(ms)
+		_, err := u.OpenEventStream(context.Background(), domain.OpenEventStreamRequest{
+			Filter: domain.EventStreamFilter{TeamName: teamName},
+		})
+		require.ErrorIs(t, err, domain.ErrTeamNotFound)
+	})
+}
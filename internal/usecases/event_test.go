@@ -0,0 +1,39 @@
+package usecases
+
+import (
+	"fmt"
+	"reflect"
+
+	"pr-manager-service/internal/domain"
+
+	"go.uber.org/mock/gomock"
+)
+
+// eventsMatcher сравнивает события только по данным: ID и время события генерируются в emit.
+type eventsMatcher struct {
+	data []domain.EventData
+}
+
+// eventsWithData ожидает события с данными data в том же порядке.
+func eventsWithData(data ...domain.EventData) gomock.Matcher {
+	return eventsMatcher{data: data}
+}
+
+func (m eventsMatcher) Matches(x any) bool {
+	events, ok := x.([]domain.Event)
+	if !ok || len(events) != len(m.data) {
+		return false
+	}
+
+	for i, event := range events {
+		if event.ID == "" || event.Type != m.data[i].EventType() || !reflect.DeepEqual(event.Data, m.data[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (m eventsMatcher) String() string {
+	return fmt.Sprintf("events with data %+v", m.data)
+}
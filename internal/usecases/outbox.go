@@ -0,0 +1,51 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+
+	"pr-manager-service/internal/domain"
+
+	"github.com/samber/lo"
+)
+
+// outboxRelayBatch - сколько событий outbox передаётся получателям за один проход
+const outboxRelayBatch = 100
+
+// RelayOutboxEvents передаёт очередную порцию событий outbox всем получателям в порядке записи
+// и возвращает переданные события. События отмечаются переданными в той же транзакции, в которой
+// заблокированы; если какой-то получатель вернул ошибку, транзакция откатывается и вся порция
+// передаётся снова на следующем проходе, в том числе получателям, которые её уже приняли.
+func (u *Usecases) RelayOutboxEvents(ctx context.Context) ([]domain.Event, error) {
+	var events []domain.Event
+
+	if err := u.storage.UnitOfWork(ctx, func(s Storage) error {
+		var err error
+
+		events, err = s.LockOutboxEvents(ctx, outboxRelayBatch)
+		if err != nil {
+			return fmt.Errorf("LockOutboxEvents: %w", err)
+		}
+
+		if len(events) == 0 {
+			return nil
+		}
+
+		for _, sink := range u.eventSinks {
+			if err := sink.Publish(ctx, events); err != nil {
+				return fmt.Errorf("%T.Publish: %w", sink, err)
+			}
+		}
+
+		eventIDs := lo.Map(events, func(event domain.Event, _ int) string { return event.ID })
+		if err := s.MarkOutboxEventsDispatched(ctx, eventIDs); err != nil {
+			return fmt.Errorf("MarkOutboxEventsDispatched: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("UnitOfWork: %w", err)
+	}
+
+	return events, nil
+}
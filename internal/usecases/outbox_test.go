@@ -0,0 +1,78 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"pr-manager-service/internal/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUsecases_RelayOutboxEvents(t *testing.T) {
+	events := []domain.Event{
+		{ID: "1", Type: domain.EventPullRequestMerged, Data: domain.PullRequestMergedEvent{PullRequestID: "100"}},
+		{ID: "2", Type: domain.EventPullRequestMerged, Data: domain.PullRequestMergedEvent{PullRequestID: "101"}},
+	}
+
+	mockUnitOfWork := func(ms *MockStorage) {
+		ms.EXPECT().UnitOfWork(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, fn func(s Storage) error) error {
+				return fn(ms)
+			})
+	}
+
+	t.Run("relayed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		ms := NewMockStorage(ctrl)
+		sink1 := NewMockEventSink(ctrl)
+		sink2 := NewMockEventSink(ctrl)
+		mockUnitOfWork(ms)
+
+		ms.EXPECT().LockOutboxEvents(gomock.Any(), outboxRelayBatch).Return(events, nil)
+		gomock.InOrder(
+			sink1.EXPECT().Publish(gomock.Any(), events).Return(nil),
+			sink2.EXPECT().Publish(gomock.Any(), events).Return(nil),
+		)
+		ms.EXPECT().MarkOutboxEventsDispatched(gomock.Any(), []string{"1", "2"}).Return(nil)
+
+		u := NewUsecases(ms, WithEventSinks(sink1), WithEventSinks(sink2))
+		relayed, err := u.RelayOutboxEvents(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, events, relayed)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		ms := NewMockStorage(ctrl)
+		sink := NewMockEventSink(ctrl)
+		mockUnitOfWork(ms)
+
+		ms.EXPECT().LockOutboxEvents(gomock.Any(), outboxRelayBatch).Return([]domain.Event{}, nil)
+
+		u := NewUsecases(ms, WithEventSinks(sink))
+		relayed, err := u.RelayOutboxEvents(context.Background())
+		require.NoError(t, err)
+		assert.Empty(t, relayed)
+	})
+
+	t.Run("sink_error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		ms := NewMockStorage(ctrl)
+		sink1 := NewMockEventSink(ctrl)
+		sink2 := NewMockEventSink(ctrl)
+		mockUnitOfWork(ms)
+
+		// NOTE: события не отмечаются переданными и будут переданы снова
+		sinkErr := errors.New("sink unavailable")
+		ms.EXPECT().LockOutboxEvents(gomock.Any(), outboxRelayBatch).Return(events, nil)
+		sink1.EXPECT().Publish(gomock.Any(), events).Return(sinkErr)
+
+		u := NewUsecases(ms, WithEventSinks(sink1, sink2))
+		_, err := u.RelayOutboxEvents(context.Background())
+		require.ErrorIs(t, err, sinkErr)
+	})
+}
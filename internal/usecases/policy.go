@@ -0,0 +1,45 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"pr-manager-service/internal/domain"
+	"pr-manager-service/internal/reqctx"
+)
+
+// principal возвращает вызывающего из контекста вместе с его ролями в командах.
+// Без вызывающего операцию выполняет сервис, и политики её не ограничивают.
+func (u *Usecases) principal(ctx context.Context, s Storage) (domain.Principal, error) {
+	caller, ok := reqctx.Caller(ctx)
+	if !ok {
+		return domain.ServicePrincipal, nil
+	}
+
+	if caller.Admin {
+		return domain.Principal{Caller: caller}, nil
+	}
+
+	user, err := s.GetUserFull(ctx, caller.UserID)
+	// NOTE: токен подписан для пользователя, которого нет в сервисе
+	if errors.Is(err, domain.ErrUserNotFound) {
+		return domain.Principal{}, domain.ErrForbidden
+	}
+	if err != nil {
+		return domain.Principal{}, fmt.Errorf("GetUserFull: %w", err)
+	}
+
+	return domain.NewPrincipal(caller, user), nil
+}
+
+// authorizeActAs проверяет, что пользователь из тела запроса userID - сам вызывающий.
+// Администратор и сервис действуют от имени любого пользователя.
+func (u *Usecases) authorizeActAs(ctx context.Context, s Storage, userID string) error {
+	principal, err := u.principal(ctx, s)
+	if err != nil {
+		return fmt.Errorf("principal: %w", err)
+	}
+
+	return principal.AuthorizeActAs(userID)
+}
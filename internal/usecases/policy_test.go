@@ -0,0 +1,161 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+
+	"pr-manager-service/internal/domain"
+	"pr-manager-service/internal/reqctx"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUsecases_Policies(t *testing.T) {
+	mockUnitOfWork := func(ms *MockStorage) {
+		ms.EXPECT().UnitOfWork(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, fn func(s Storage) error) error {
+				return fn(ms)
+			})
+	}
+
+	const (
+		prID       = "pr-1"
+		teamID     = "team-1"
+		authorID   = "author"
+		reviewerID = "reviewer"
+		callerID   = "caller"
+	)
+
+	pr := domain.PullRequest{
+		ID:                prID,
+		AuthorUserID:      authorID,
+		ReviewersUsersIDs: []string{reviewerID},
+		Status:            domain.StatusOpen,
+		TeamID:            teamID,
+	}
+
+	teammate := domain.User{
+		ID:    callerID,
+		Teams: []domain.TeamMembership{{TeamID: teamID, Role: domain.TeamRoleMember}},
+	}
+
+	ctx := reqctx.WithCaller(context.Background(), domain.Caller{UserID: callerID})
+
+	t.Run("merge_by_teammate", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		ms := NewMockStorage(ctrl)
+		mockUnitOfWork(ms)
+
+		ms.EXPECT().LockPullRequest(gomock.Any(), prID).Return(nil)
+		ms.EXPECT().GetPullRequestByID(gomock.Any(), prID).Return(pr, nil)
+		ms.EXPECT().GetUserFull(gomock.Any(), callerID).Return(teammate, nil)
+
+		_, err := NewUsecases(ms).MergePullRequest(ctx, prID)
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+	})
+
+	t.Run("reassign_by_teammate", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		ms := NewMockStorage(ctrl)
+		mockUnitOfWork(ms)
+
+		ms.EXPECT().GetUserShort(gomock.Any(), reviewerID).Return(domain.User{ID: reviewerID}, nil)
+
+		// NOTE: PR читается только после блокировки, чтобы проверки шли по актуальному состоянию
+		gomock.InOrder(
+			ms.EXPECT().LockPullRequest(gomock.Any(), prID).Return(nil),
+			ms.EXPECT().GetPullRequestByID(gomock.Any(), prID).Return(pr, nil),
+		)
+		ms.EXPECT().GetUserFull(gomock.Any(), callerID).Return(teammate, nil)
+
+		_, _, err := NewUsecases(ms).ReassignPullRequest(ctx, prID, reviewerID)
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+	})
+
+	t.Run("status_change_by_teammate", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		ms := NewMockStorage(ctrl)
+		mockUnitOfWork(ms)
+
+		ms.EXPECT().GetUserFull(gomock.Any(), reviewerID).Return(domain.User{
+			ID:       reviewerID,
+			IsActive: true,
+			Teams:    []domain.TeamMembership{{TeamID: teamID, Role: domain.TeamRoleMember}},
+		}, nil)
+		ms.EXPECT().GetUserFull(gomock.Any(), callerID).Return(teammate, nil)
+
+		_, _, err := NewUsecases(ms).UpdateUserStatus(ctx, reviewerID, false)
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+	})
+
+	t.Run("close_by_teammate", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		ms := NewMockStorage(ctrl)
+		mockUnitOfWork(ms)
+
+		ms.EXPECT().LockPullRequest(gomock.Any(), prID).Return(nil)
+		ms.EXPECT().GetPullRequestByID(gomock.Any(), prID).Return(pr, nil)
+		ms.EXPECT().GetUserFull(gomock.Any(), callerID).Return(teammate, nil)
+
+		_, err := NewUsecases(ms).ClosePullRequest(ctx, prID)
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+	})
+
+	t.Run("review_for_another_user", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		ms := NewMockStorage(ctrl)
+		mockUnitOfWork(ms)
+
+		ms.EXPECT().GetUserFull(gomock.Any(), callerID).Return(teammate, nil)
+
+		_, _, err := NewUsecases(ms).SubmitReview(ctx, domain.SubmitReviewRequest{
+			PullRequestID: prID,
+			UserID:        reviewerID,
+			Verdict:       domain.VerdictApprove,
+		})
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+	})
+
+	t.Run("create_for_another_author", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		ms := NewMockStorage(ctrl)
+
+		ms.EXPECT().GetUserFull(gomock.Any(), callerID).Return(teammate, nil)
+
+		_, err := NewUsecases(ms).CreatePullRequest(ctx, domain.CreatePullRequestRequest{
+			ID:           prID,
+			AuthorUserID: authorID,
+		})
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+	})
+
+	t.Run("unknown_caller", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		ms := NewMockStorage(ctrl)
+		mockUnitOfWork(ms)
+
+		ms.EXPECT().LockPullRequest(gomock.Any(), prID).Return(nil)
+		ms.EXPECT().GetPullRequestByID(gomock.Any(), prID).Return(pr, nil)
+		ms.EXPECT().GetUserFull(gomock.Any(), callerID).Return(domain.User{}, domain.ErrUserNotFound)
+
+		_, err := NewUsecases(ms).MergePullRequest(ctx, prID)
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+	})
+
+	t.Run("admin_skips_roles_lookup", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		ms := NewMockStorage(ctrl)
+		mockUnitOfWork(ms)
+
+		merged := pr
+		merged.Status = domain.StatusMerged
+		ms.EXPECT().LockPullRequest(gomock.Any(), prID).Return(nil)
+		ms.EXPECT().GetPullRequestByID(gomock.Any(), prID).Return(merged, nil).Times(2)
+
+		adminCtx := reqctx.WithCaller(context.Background(), domain.Caller{Admin: true})
+
+		_, err := NewUsecases(ms).MergePullRequest(adminCtx, prID)
+		assert.NoError(t, err)
+	})
+}
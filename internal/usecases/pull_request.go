@@ -2,23 +2,28 @@ package usecases
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"slices"
 
 	"pr-manager-service/internal/domain"
+	"pr-manager-service/internal/reqctx"
 
 	"github.com/samber/lo"
-	"github.com/samber/lo/mutable"
 )
 
 func (u *Usecases) CreatePullRequest(
 	ctx context.Context,
 	request domain.CreatePullRequestRequest,
 ) (domain.PullRequest, error) {
+	if err := u.authorizeActAs(ctx, u.storage, request.AuthorUserID); err != nil {
+		return domain.PullRequest{}, err
+	}
+
 	// NOTE: проверка существования пользователя
-	user, err := u.storage.GetUserShort(ctx, request.AuthorUserID)
+	user, err := u.storage.GetUserFull(ctx, request.AuthorUserID)
 	if err != nil {
-		return domain.PullRequest{}, fmt.Errorf("storage.GetUserShort: %w", err)
+		return domain.PullRequest{}, fmt.Errorf("storage.GetUserFull: %w", err)
 	}
 
 	if !user.IsActive {
@@ -28,28 +33,58 @@ func (u *Usecases) CreatePullRequest(
 	var pr domain.PullRequest
 
 	if err := u.storage.UnitOfWork(ctx, func(s Storage) error {
-		activeColleagues, err := s.GetActiveColleagues(ctx, request.AuthorUserID)
+		team, err := u.resolveUserTeam(ctx, s, user, request.TeamName)
 		if err != nil {
-			return fmt.Errorf("GetActiveColleagues: %w", err)
+			return fmt.Errorf("resolveUserTeam: %w", err)
 		}
 
-		reviewers := SelectRandomElements(activeColleagues, 2)
-		reviewersIDs := lo.Map(reviewers, func(u domain.User, _ int) string {
-			return u.ID
-		})
+		// NOTE: черновику ревьюверы назначаются при переводе в работу
+		assignments := []domain.ReviewerAssignment{}
+		needsMoreReviewers := false
+
+		if !request.Draft {
+			assignments, needsMoreReviewers, err = u.pickReviewers(ctx, s, user, team, request.ChangedFiles)
+			if err != nil {
+				return fmt.Errorf("pickReviewers: %w", err)
+			}
+		}
 
-		createdPr, err := s.CreatePullRequest(ctx, request, reviewersIDs)
+		createdPr, err := s.CreatePullRequest(ctx, request, team, needsMoreReviewers)
 		if err != nil {
 			return fmt.Errorf("CreatePullRequest: %w", err)
 		}
-		pr = createdPr
 
-		if err := s.UserAssignmentsIncrementBatch(ctx, reviewersIDs); err != nil {
-			return fmt.Errorf("UserAssignmentIncrementMany: %w", err)
+		if err := s.AssignPullRequestReviewers(
+			ctx,
+			createdPr.ID,
+			assignments,
+			domain.AssignmentReasonInitial,
+		); err != nil {
+			return fmt.Errorf("AssignPullRequestReviewers: %w", err)
 		}
 
-		if err := s.PullRequestStatsCreate(ctx, createdPr.ID, len(reviewersIDs)); err != nil {
-			return fmt.Errorf("PullRequestStatsCreate: %w", err)
+		if err := u.emit(
+			ctx,
+			s,
+			reviewersAssignedEvents(createdPr.ID, assignments, domain.AssignmentReasonInitial)...,
+		); err != nil {
+			return fmt.Errorf("emit: %w", err)
+		}
+
+		createdPr.ReviewersUsersIDs = assignmentsUsersIDs(assignments)
+		createdPr.ReviewerPools = domain.ReviewerPoolsFromAssignments(assignments, lo.FromPtr(createdPr.CreatedAt))
+		pr = createdPr
+
+		if err := u.audit(
+			ctx,
+			s,
+			domain.AuditPullRequestCreate,
+			domain.AuditTargetPullRequest,
+			createdPr.ID,
+			nil,
+			domain.NewAuditPullRequestState(createdPr),
+		); err != nil {
+			return fmt.Errorf("audit: %w", err)
 		}
 
 		return nil
@@ -60,13 +95,188 @@ func (u *Usecases) CreatePullRequest(
 	return pr, nil
 }
 
-func (u *Usecases) MergePullRequest(ctx context.Context, prID string) (domain.PullRequest, error) {
-	if _, err := u.storage.GetPullRequestByID(ctx, prID); err != nil {
-		return domain.PullRequest{}, fmt.Errorf("GetPullRequestByID: %w", err)
+// resolveUserTeam возвращает команду пользователя с названием teamName, например команду, для которой автор
+// создаёт PR. Без teamName используется единственная команда пользователя; если их несколько,
+// возвращается ErrAmbiguousTeam.
+func (u *Usecases) resolveUserTeam(
+	ctx context.Context,
+	s Storage,
+	author domain.User,
+	teamName string,
+) (domain.Team, error) {
+	if teamName == "" {
+		switch len(author.Teams) {
+		case 0:
+			return domain.Team{}, domain.ErrTeamNotFound
+		case 1:
+			return s.GetTeamByID(ctx, author.Teams[0].TeamID)
+		default:
+			return domain.Team{}, domain.ErrAmbiguousTeam
+		}
+	}
+
+	team, err := s.GetTeamByName(ctx, teamName)
+	if err != nil {
+		return domain.Team{}, fmt.Errorf("GetTeamByName: %w", err)
+	}
+
+	if _, ok := author.Membership(team.ID); !ok {
+		return domain.Team{}, domain.NewErrNotInTeam(team.Name, []string{author.ID})
+	}
+
+	return team, nil
+}
+
+// pullRequestTeam возвращает команду, для которой создан PR. PR без команды (автор не состоял
+// в команде при миграции) относится к единственной команде автора.
+func (u *Usecases) pullRequestTeam(ctx context.Context, s Storage, pr domain.PullRequest) (domain.Team, error) {
+	if pr.TeamID != "" {
+		return s.GetTeamByID(ctx, pr.TeamID)
+	}
+
+	author, err := s.GetUserFull(ctx, pr.AuthorUserID)
+	if err != nil {
+		return domain.Team{}, fmt.Errorf("GetUserFull: %w", err)
+	}
+
+	return u.resolveUserTeam(ctx, s, author, "")
+}
+
+// pickReviewers выбирает ревьюверов для PR автора: сначала владельцев изменённых файлов,
+// затем по стратегии команды PR и, если не хватает до min_reviewers, из резервных команд.
+func (u *Usecases) pickReviewers(
+	ctx context.Context,
+	s Storage,
+	author domain.User,
+	team domain.Team,
+	changedFiles []string,
+) (assignments []domain.ReviewerAssignment, needsMoreReviewers bool, err error) {
+	activeColleagues, err := s.GetActiveColleagues(ctx, author.ID, team.ID)
+	if err != nil {
+		return nil, false, fmt.Errorf("GetActiveColleagues: %w", err)
+	}
+
+	owners, err := u.selectOwners(ctx, s, team, changedFiles, author.ID, team.MaxReviewers)
+	if err != nil {
+		return nil, false, fmt.Errorf("selectOwners: %w", err)
+	}
+
+	assignments, err = u.assignReviewers(
+		ctx,
+		s,
+		team,
+		activeColleagues,
+		owners,
+		team.MaxReviewers,
+		team.MinReviewers,
+		[]string{author.ID},
+	)
+	if err != nil {
+		return nil, false, fmt.Errorf("assignReviewers: %w", err)
+	}
+
+	return assignments, len(assignments) < team.MinReviewers, nil
+}
+
+// assignPullRequestReviewers назначает ревьюверов PR, который создавался без них (черновик).
+func (u *Usecases) assignPullRequestReviewers(ctx context.Context, s Storage, pr domain.PullRequest) error {
+	author, err := s.GetUserShort(ctx, pr.AuthorUserID)
+	if err != nil {
+		return fmt.Errorf("GetUserShort: %w", err)
+	}
+
+	team, err := u.pullRequestTeam(ctx, s, pr)
+	if err != nil {
+		return fmt.Errorf("pullRequestTeam: %w", err)
+	}
+
+	assignments, needsMoreReviewers, err := u.pickReviewers(ctx, s, author, team, pr.ChangedFiles)
+	if err != nil {
+		return fmt.Errorf("pickReviewers: %w", err)
+	}
+
+	if err := s.UpdatePullRequestNeedsMoreReviewers(ctx, pr.ID, needsMoreReviewers); err != nil {
+		return fmt.Errorf("UpdatePullRequestNeedsMoreReviewers: %w", err)
 	}
 
-	if err := u.storage.UpdatePullRequestStatus(ctx, prID, domain.StatusMerged); err != nil {
-		return domain.PullRequest{}, fmt.Errorf("storage.UpdatePullRequestStatus: %w", err)
+	if err := s.AssignPullRequestReviewers(ctx, pr.ID, assignments, domain.AssignmentReasonInitial); err != nil {
+		return fmt.Errorf("AssignPullRequestReviewers: %w", err)
+	}
+
+	if err := u.emit(ctx, s, reviewersAssignedEvents(pr.ID, assignments, domain.AssignmentReasonInitial)...); err != nil {
+		return fmt.Errorf("emit: %w", err)
+	}
+
+	return nil
+}
+
+// MergePullRequest мержит PR. Смержить PR могут автор, maintainer и lead команды PR.
+// Проверки выполняются в транзакции под блокировкой PR, поэтому параллельное закрытие PR
+// или отзыв одобрения не дают смержить PR по устаревшему состоянию.
+func (u *Usecases) MergePullRequest(ctx context.Context, prID string) (domain.PullRequest, error) {
+	if err := u.storage.UnitOfWork(ctx, func(s Storage) error {
+		if err := s.LockPullRequest(ctx, prID); err != nil {
+			return fmt.Errorf("LockPullRequest: %w", err)
+		}
+
+		pullRequest, err := s.GetPullRequestByID(ctx, prID)
+		if err != nil {
+			return fmt.Errorf("GetPullRequestByID: %w", err)
+		}
+
+		principal, err := u.principal(ctx, s)
+		if err != nil {
+			return fmt.Errorf("principal: %w", err)
+		}
+
+		if err := principal.AuthorizeMerge(pullRequest); err != nil {
+			return err
+		}
+
+		// NOTE: повторный merge не меняет PR
+		if pullRequest.Status == domain.StatusMerged {
+			return nil
+		}
+
+		if err := domain.ValidateTransition(pullRequest.Status, domain.StatusMerged); err != nil {
+			return err
+		}
+
+		if err := u.checkMergeApprovals(ctx, s, pullRequest); err != nil {
+			return err
+		}
+
+		if err := s.UpdatePullRequestStatus(ctx, prID, domain.StatusMerged); err != nil {
+			return fmt.Errorf("UpdatePullRequestStatus: %w", err)
+		}
+
+		if err := u.emit(ctx, s, domain.PullRequestMergedEvent{
+			PullRequestID: pullRequest.ID,
+			AuthorID:      pullRequest.AuthorUserID,
+			ReviewerIDs:   pullRequest.ReviewersUsersIDs,
+		}); err != nil {
+			return fmt.Errorf("emit: %w", err)
+		}
+
+		before := domain.NewAuditPullRequestState(pullRequest)
+		after := before
+		after.Status = domain.ConvertPullRequestStatusToApi(domain.StatusMerged)
+
+		if err := u.audit(
+			ctx,
+			s,
+			domain.AuditPullRequestMerge,
+			domain.AuditTargetPullRequest,
+			pullRequest.ID,
+			before,
+			after,
+		); err != nil {
+			return fmt.Errorf("audit: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return domain.PullRequest{}, fmt.Errorf("UnitOfWork: %w", err)
 	}
 
 	pullRequest, err := u.storage.GetPullRequestByID(ctx, prID)
@@ -77,74 +287,146 @@ func (u *Usecases) MergePullRequest(ctx context.Context, prID string) (domain.Pu
 	return pullRequest, nil
 }
 
+// MarkPullRequestReady переводит черновик в работу и назначает ревьюверов.
+func (u *Usecases) MarkPullRequestReady(ctx context.Context, prID string) (domain.PullRequest, error) {
+	return u.changePullRequestStatus(ctx, prID, domain.StatusOpen, func(s Storage, pr domain.PullRequest) error {
+		return u.assignPullRequestReviewers(ctx, s, pr)
+	})
+}
+
+// ClosePullRequest закрывает PR без мержа. Ревьюверы остаются в PR, но он перестаёт учитываться в их нагрузке.
+func (u *Usecases) ClosePullRequest(ctx context.Context, prID string) (domain.PullRequest, error) {
+	return u.changePullRequestStatus(ctx, prID, domain.StatusClosed, nil)
+}
+
+// ReopenPullRequest переоткрывает закрытый PR. Если PR закрыли черновиком, ревьюверы назначаются заново.
+func (u *Usecases) ReopenPullRequest(ctx context.Context, prID string) (domain.PullRequest, error) {
+	return u.changePullRequestStatus(ctx, prID, domain.StatusReopened, func(s Storage, pr domain.PullRequest) error {
+		if len(pr.ReviewersUsersIDs) > 0 {
+			return nil
+		}
+
+		return u.assignPullRequestReviewers(ctx, s, pr)
+	})
+}
+
+// changePullRequestStatus проверяет переход статуса и выполняет его в одной транзакции с beforeUpdate.
+func (u *Usecases) changePullRequestStatus(
+	ctx context.Context,
+	prID string,
+	newStatus domain.PullRequestStatus,
+	beforeUpdate func(s Storage, pr domain.PullRequest) error,
+) (domain.PullRequest, error) {
+	var pullRequest domain.PullRequest
+
+	if err := u.storage.UnitOfWork(ctx, func(s Storage) error {
+		if err := s.LockPullRequest(ctx, prID); err != nil {
+			return fmt.Errorf("LockPullRequest: %w", err)
+		}
+
+		pr, err := s.GetPullRequestByID(ctx, prID)
+		if err != nil {
+			return fmt.Errorf("GetPullRequestByID: %w", err)
+		}
+
+		principal, err := u.principal(ctx, s)
+		if err != nil {
+			return fmt.Errorf("principal: %w", err)
+		}
+
+		if err := principal.AuthorizeStatusChange(pr); err != nil {
+			return err
+		}
+
+		if err := domain.ValidateTransition(pr.Status, newStatus); err != nil {
+			return err
+		}
+
+		if beforeUpdate != nil {
+			if err := beforeUpdate(s, pr); err != nil {
+				return err
+			}
+		}
+
+		if err := s.UpdatePullRequestStatus(ctx, prID, newStatus); err != nil {
+			return fmt.Errorf("UpdatePullRequestStatus: %w", err)
+		}
+
+		// NOTE: PR перечитывается в транзакции, чтобы в аудит попали назначенные в beforeUpdate ревьюверы
+		pullRequest, err = s.GetPullRequestByID(ctx, prID)
+		if err != nil {
+			return fmt.Errorf("GetPullRequestByID: %w", err)
+		}
+
+		if err := u.audit(
+			ctx,
+			s,
+			domain.AuditPullRequestChangeStatus,
+			domain.AuditTargetPullRequest,
+			prID,
+			domain.NewAuditPullRequestState(pr),
+			domain.NewAuditPullRequestState(pullRequest),
+		); err != nil {
+			return fmt.Errorf("audit: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return domain.PullRequest{}, fmt.Errorf("UnitOfWork: %w", err)
+	}
+
+	return u.markPullRequestOverdue(ctx, pullRequest)
+}
+
+// ReassignPullRequest заменяет ревьювера PR. Переназначить ревьювера могут автор и lead команды PR.
+// Замена выполняется под блокировкой PR, как MergePullRequest, поэтому параллельный мерж не проверит
+// одобрения по старому составу ревьюверов, а закрытие PR не пройдёт между проверкой статуса и заменой.
 func (u *Usecases) ReassignPullRequest(
 	ctx context.Context,
 	prID, oldUserID string,
 ) (domain.PullRequest, string, error) {
 	// NOTE: проверка существования пользователя
-	if _, err := u.storage.GetUserShort(ctx, oldUserID); err != nil {
+	oldUser, err := u.storage.GetUserShort(ctx, oldUserID)
+	if err != nil {
 		return domain.PullRequest{}, "", fmt.Errorf("storage.GetUserShort: %w", err)
 	}
 
 	var newReviewerID string
 
 	if err := u.storage.UnitOfWork(ctx, func(s Storage) error {
+		if err := s.LockPullRequest(ctx, prID); err != nil {
+			return fmt.Errorf("LockPullRequest: %w", err)
+		}
+
 		pr, err := s.GetPullRequestByID(ctx, prID)
 		if err != nil {
 			return fmt.Errorf("GetPullRequestByID: %w", err)
 		}
 
-		candidates, err := s.GetActiveColleagues(ctx, oldUserID)
+		principal, err := u.principal(ctx, s)
 		if err != nil {
-			return fmt.Errorf("GetActiveColleagues: %w", err)
+			return fmt.Errorf("principal: %w", err)
 		}
 
-		candidates = lo.Filter(candidates, func(u domain.User, _ int) bool {
-			notAuthor := u.ID != pr.AuthorUserID
-			notReviewer := !slices.ContainsFunc(pr.ReviewersUsersIDs, func(reviewerID string) bool {
-				return reviewerID == u.ID
-			})
-			return notAuthor && notReviewer
-		})
+		if err := principal.AuthorizeReassign(pr); err != nil {
+			return err
+		}
 
 		if pr.Status == domain.StatusMerged {
 			return domain.ErrPRMerged
 		}
 
-		if !slices.Contains(pr.ReviewersUsersIDs, oldUserID) {
-			return domain.ErrNotAssigned
-		}
-
-		if len(candidates) == 0 {
-			return domain.ErrNoCandidate
-		}
-
-		newReviewerID := SelectRandomElements(candidates, 1)[0].ID
-		updatedReviewersIDs := make([]string, 0, 2)
-
-		for _, id := range pr.ReviewersUsersIDs {
-			if id == oldUserID {
-				continue
-			}
-
-			if id == pr.AuthorUserID {
-				continue
-			}
-
-			updatedReviewersIDs = append(updatedReviewersIDs, id)
+		if !pr.Status.IsActive() {
+			return domain.ErrPRNotActive
 		}
-		updatedReviewersIDs = append(updatedReviewersIDs, newReviewerID)
 
-		if err := s.UpdatePullRequestReviewersIDs(ctx, prID, updatedReviewersIDs); err != nil {
-			return fmt.Errorf("UpdatePullRequestReviewersIDs: %w", err)
-		}
-
-		if err := s.UserAssignmentsIncrementBatch(ctx, []string{newReviewerID}); err != nil {
-			return fmt.Errorf("UserAssignmentIncrementMany: %w", err)
+		if !slices.Contains(pr.ReviewersUsersIDs, oldUserID) {
+			return domain.ErrNotAssigned
 		}
 
-		if err := s.PullRequestAssignmentsIncrement(ctx, prID); err != nil {
-			return fmt.Errorf("PullRequestAssignmentIncrement: %w", err)
+		newReviewerID, err = u.replaceReviewer(ctx, s, pr, oldUser, domain.AssignmentReasonReassign)
+		if err != nil {
+			return fmt.Errorf("replaceReviewer: %w", err)
 		}
 
 		return nil
@@ -157,19 +439,93 @@ func (u *Usecases) ReassignPullRequest(
 		return domain.PullRequest{}, "", fmt.Errorf("GetPullRequestByID: %w", err)
 	}
 
+	pr, err = u.markPullRequestOverdue(ctx, pr)
+	if err != nil {
+		return domain.PullRequest{}, "", err
+	}
+
 	return pr, newReviewerID, nil
 }
 
-func SelectRandomElements[T any](elements []T, count int) []T {
-	if len(elements) <= count {
-		result := make([]T, len(elements))
-		copy(result, elements)
-		return result
+// replaceReviewer заменяет ревьювера oldUser в PR одним кандидатом из команды, из которой он был назначен,
+// или её резервных команд. Автор и текущие ревьюверы PR не назначаются.
+// Если замены нет, возвращает ErrNoCandidate или ErrReviewersAtCapacity.
+func (u *Usecases) replaceReviewer(
+	ctx context.Context,
+	s Storage,
+	pr domain.PullRequest,
+	oldUser domain.User,
+	reason domain.AssignmentReason,
+) (string, error) {
+	team, err := u.reviewerTeam(ctx, s, pr, oldUser.ID)
+	if err != nil {
+		return "", fmt.Errorf("reviewerTeam: %w", err)
+	}
+
+	candidates, err := s.GetActiveColleagues(ctx, oldUser.ID, team.ID)
+	if err != nil {
+		return "", fmt.Errorf("GetActiveColleagues: %w", err)
+	}
+
+	assignments, err := u.assignReviewers(
+		ctx,
+		s,
+		team,
+		candidates,
+		nil,
+		1,
+		1,
+		append([]string{pr.AuthorUserID}, pr.ReviewersUsersIDs...),
+	)
+	if err != nil {
+		return "", fmt.Errorf("assignReviewers: %w", err)
+	}
+
+	if len(assignments) == 0 {
+		return "", domain.ErrNoCandidate
+	}
+
+	// NOTE: замену выполняет вызывающий; у фоновых воркеров исполнителя нет, и assigned_by остаётся пустым
+	assignments[0].AssignedBy = reqctx.Actor(ctx)
+
+	if err := s.UnassignPullRequestReviewer(ctx, pr.ID, oldUser.ID, reason); err != nil {
+		return "", fmt.Errorf("UnassignPullRequestReviewer: %w", err)
+	}
+
+	if err := s.AssignPullRequestReviewers(ctx, pr.ID, assignments, reason); err != nil {
+		return "", fmt.Errorf("AssignPullRequestReviewers: %w", err)
+	}
+
+	replacements := []domain.ReviewerReplacement{{
+		PullRequestID: pr.ID,
+		OldReviewerID: oldUser.ID,
+		NewReviewerID: assignments[0].UserID,
+	}}
+
+	if err := u.emit(ctx, s, reviewerReassignedEvents(replacements, reason)...); err != nil {
+		return "", fmt.Errorf("emit: %w", err)
+	}
+
+	if err := u.auditReviewerReplaced(ctx, s, pr, replacements[0]); err != nil {
+		return "", fmt.Errorf("auditReviewerReplaced: %w", err)
 	}
 
-	shuffled := make([]T, len(elements))
-	copy(shuffled, elements)
-	mutable.Shuffle(shuffled)
+	return assignments[0].UserID, nil
+}
+
+// reviewerTeam возвращает команду, из которой ревьювер назначен в PR. Если команда удалена, возвращает команду PR.
+func (u *Usecases) reviewerTeam(
+	ctx context.Context,
+	s Storage,
+	pr domain.PullRequest,
+	reviewerID string,
+) (domain.Team, error) {
+	if pool, ok := pr.ReviewerPools[reviewerID]; ok {
+		team, err := s.GetTeamByName(ctx, pool.TeamName)
+		if !errors.Is(err, domain.ErrTeamNotFound) {
+			return team, err
+		}
+	}
 
-	return shuffled[:count]
+	return u.pullRequestTeam(ctx, s, pr)
 }
@@ -0,0 +1,64 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+
+	"pr-manager-service/internal/domain"
+
+	"github.com/samber/lo"
+)
+
+// pullRequestListOverfetch - сколько PR сверх лимита читается, чтобы понять, есть ли следующая страница
+const pullRequestListOverfetch = 1
+
+// ListPullRequests возвращает страницу PR по фильтрам от новых к старым.
+func (u *Usecases) ListPullRequests(
+	ctx context.Context,
+	request domain.ListPullRequestsRequest,
+) (domain.PullRequestPage, error) {
+	after, err := domain.DecodePageCursor(request.Cursor)
+	if err != nil {
+		return domain.PullRequestPage{}, err
+	}
+
+	filter := domain.PullRequestFilter{
+		Statuses:    request.PullRequestStatuses(),
+		AuthorID:    request.AuthorID,
+		ReviewerID:  request.ReviewerID,
+		CreatedFrom: request.CreatedFrom,
+		CreatedTo:   request.CreatedTo,
+		MergedFrom:  request.MergedFrom,
+		MergedTo:    request.MergedTo,
+		After:       after,
+	}
+
+	if request.TeamName != "" {
+		team, err := u.storage.GetTeamByName(ctx, request.TeamName)
+		if err != nil {
+			return domain.PullRequestPage{}, fmt.Errorf("GetTeamByName: %w", err)
+		}
+
+		filter.TeamID = team.ID
+	}
+
+	pullRequests, err := u.storage.ListPullRequests(ctx, filter, request.Limit+pullRequestListOverfetch)
+	if err != nil {
+		return domain.PullRequestPage{}, fmt.Errorf("storage.ListPullRequests: %w", err)
+	}
+
+	page := domain.PullRequestPage{PullRequests: pullRequests}
+
+	if len(pullRequests) > request.Limit {
+		page.PullRequests = pullRequests[:request.Limit]
+
+		last := page.PullRequests[request.Limit-1]
+		page.NextCursor = domain.PageCursor{Time: lo.FromPtr(last.CreatedAt), ID: last.ID}.Encode()
+	}
+
+	if err := u.markOverdueReviews(ctx, u.storage, page.PullRequests); err != nil {
+		return domain.PullRequestPage{}, fmt.Errorf("markOverdueReviews: %w", err)
+	}
+
+	return page, nil
+}
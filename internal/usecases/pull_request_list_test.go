@@ -0,0 +1,81 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"pr-manager-service/internal/domain"
+	"pr-manager-service/internal/generated/api"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUsecases_ListPullRequests(t *testing.T) {
+	createdAt := time.Date(2025, 11, 3, 10, 0, 0, 0, time.UTC)
+
+	pullRequests := []domain.PullRequest{
+		{ID: "pr-3", CreatedAt: &createdAt, Status: domain.StatusMerged},
+		{ID: "pr-2", CreatedAt: &createdAt, Status: domain.StatusMerged},
+		{ID: "pr-1", CreatedAt: &createdAt, Status: domain.StatusMerged},
+	}
+
+	t.Run("next_page", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		ms := NewMockStorage(ctrl)
+
+		after := domain.PageCursor{Time: createdAt, ID: "pr-4"}
+
+		ms.EXPECT().GetTeamByName(gomock.Any(), "backend").Return(domain.Team{ID: "team-1"}, nil)
+		ms.EXPECT().
+			ListPullRequests(gomock.Any(), domain.PullRequestFilter{
+				Statuses: []domain.PullRequestStatus{domain.StatusMerged},
+				TeamID:   "team-1",
+				After:    &after,
+			}, 3).
+			Return(pullRequests, nil)
+
+		page, err := NewUsecases(ms).ListPullRequests(context.Background(), domain.ListPullRequestsRequest{
+			Statuses: []api.PullRequestStatus{api.MERGED},
+			TeamName: "backend",
+			Cursor:   after.Encode(),
+			Limit:    2,
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, pullRequests[:2], page.PullRequests)
+
+		next, err := domain.DecodePageCursor(page.NextCursor)
+		require.NoError(t, err)
+		assert.Equal(t, "pr-2", next.ID)
+		assert.True(t, createdAt.Equal(next.Time))
+	})
+
+	t.Run("last_page", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		ms := NewMockStorage(ctrl)
+
+		ms.EXPECT().
+			ListPullRequests(gomock.Any(), domain.PullRequestFilter{Statuses: []domain.PullRequestStatus{}}, 4).
+			Return(pullRequests, nil)
+
+		page, err := NewUsecases(ms).ListPullRequests(context.Background(), domain.ListPullRequestsRequest{Limit: 3})
+		require.NoError(t, err)
+
+		assert.Equal(t, pullRequests, page.PullRequests)
+		assert.Empty(t, page.NextCursor)
+	})
+
+	t.Run("invalid_cursor", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		ms := NewMockStorage(ctrl)
+
+		_, err := NewUsecases(ms).ListPullRequests(context.Background(), domain.ListPullRequestsRequest{
+			Cursor: "garbage!",
+			Limit:  10,
+		})
+		assert.ErrorIs(t, err, domain.ErrInvalidCursor)
+	})
+}
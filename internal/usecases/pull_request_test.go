@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"pr-manager-service/internal/clock"
 	"pr-manager-service/internal/domain"
 
 	"github.com/stretchr/testify/assert"
@@ -27,13 +28,20 @@ func TestUsecases_CreatePullRequest(t *testing.T) {
 		prName     = "prname1"
 		prAuthorID = "200"
 
-		teamID = "300"
+		teamID   = "300"
+		teamName = "team1"
+
+		fallbackTeamID   = "301"
+		fallbackTeamName = "team2"
 
 		userID1   = "101"
 		userName1 = "user1"
 
 		userID2   = "102"
 		userName2 = "user2"
+
+		userID3   = "103"
+		userName3 = "user3"
 	)
 
 	testCases := []struct {
@@ -57,32 +65,44 @@ func TestUsecases_CreatePullRequest(t *testing.T) {
 				ReviewersUsersIDs: []string{userID1, userID2},
 				CreatedAt:         &timeNow,
 				Status:            domain.StatusOpen,
+				ReviewerPools: map[string]domain.ReviewerPool{
+					userID1: {TeamName: teamName, AssignedAt: timeNow},
+					userID2: {TeamName: teamName, AssignedAt: timeNow},
+				},
 			},
 			mock: func(ms *MockStorage) {
 				ms.EXPECT().
-					GetUserShort(gomock.Any(), prAuthorID).
+					GetUserFull(gomock.Any(), prAuthorID).
 					Return(domain.User{
 						ID:       prAuthorID,
 						IsActive: true,
+						Teams:    []domain.TeamMembership{{TeamID: teamID, TeamName: teamName}},
 					}, nil)
 
 				mockUnitOfWork(ms)
 
 				ms.EXPECT().
-					GetActiveColleagues(gomock.Any(), prAuthorID).
+					GetTeamByID(gomock.Any(), teamID).
+					Return(domain.Team{
+						ID:           teamID,
+						Name:         teamName,
+						MinReviewers: domain.DefaultMinReviewers,
+						MaxReviewers: domain.DefaultMaxReviewers,
+					}, nil)
+
+				ms.EXPECT().
+					GetActiveColleagues(gomock.Any(), prAuthorID, teamID).
 					Return(
 						[]domain.User{
 							{
 								ID:       userID1,
 								Name:     userName1,
 								IsActive: true,
-								TeamID:   teamID,
 							},
 							{
 								ID:       userID2,
 								Name:     userName2,
 								IsActive: true,
-								TeamID:   teamID,
 							},
 						},
 						nil,
@@ -96,14 +116,15 @@ func TestUsecases_CreatePullRequest(t *testing.T) {
 							Name:         prName,
 							AuthorUserID: prAuthorID,
 						},
-						gomock.InAnyOrder([]string{userID1, userID2}),
+						gomock.Any(),
+						false,
 					).
 					Return(
 						domain.PullRequest{
 							ID:                prID,
 							Name:              prName,
 							AuthorUserID:      prAuthorID,
-							ReviewersUsersIDs: []string{userID1, userID2},
+							ReviewersUsersIDs: []string{},
 							CreatedAt:         &timeNow,
 							Status:            domain.StatusOpen,
 						},
@@ -111,14 +132,23 @@ func TestUsecases_CreatePullRequest(t *testing.T) {
 					)
 
 				ms.EXPECT().
-					UserAssignmentsIncrementBatch(
+					AssignPullRequestReviewers(
 						gomock.Any(),
-						gomock.InAnyOrder([]string{userID1, userID2}),
+						prID,
+						gomock.InAnyOrder([]domain.ReviewerAssignment{
+							{UserID: userID1, TeamID: teamID, TeamName: teamName},
+							{UserID: userID2, TeamID: teamID, TeamName: teamName},
+						}),
+						domain.AssignmentReasonInitial,
 					).
 					Return(nil)
 
 				ms.EXPECT().
-					PullRequestStatsCreate(gomock.Any(), prID, 2).
+					CreateOutboxEvents(gomock.Any(), gomock.Any()).
+					Return(nil)
+
+				ms.EXPECT().
+					CreateAuditEntries(gomock.Any(), auditEntry(domain.AuditPullRequestCreate, prID, nil)).
 					Return(nil)
 			},
 		},
@@ -136,26 +166,38 @@ func TestUsecases_CreatePullRequest(t *testing.T) {
 				ReviewersUsersIDs: []string{userID1},
 				CreatedAt:         &timeNow,
 				Status:            domain.StatusOpen,
+				ReviewerPools: map[string]domain.ReviewerPool{
+					userID1: {TeamName: teamName, AssignedAt: timeNow},
+				},
 			},
 			mock: func(ms *MockStorage) {
 				ms.EXPECT().
-					GetUserShort(gomock.Any(), prAuthorID).
+					GetUserFull(gomock.Any(), prAuthorID).
 					Return(domain.User{
 						ID:       prAuthorID,
 						IsActive: true,
+						Teams:    []domain.TeamMembership{{TeamID: teamID, TeamName: teamName}},
 					}, nil)
 
 				mockUnitOfWork(ms)
 
 				ms.EXPECT().
-					GetActiveColleagues(gomock.Any(), prAuthorID).
+					GetTeamByID(gomock.Any(), teamID).
+					Return(domain.Team{
+						ID:           teamID,
+						Name:         teamName,
+						MinReviewers: domain.DefaultMinReviewers,
+						MaxReviewers: domain.DefaultMaxReviewers,
+					}, nil)
+
+				ms.EXPECT().
+					GetActiveColleagues(gomock.Any(), prAuthorID, teamID).
 					Return(
 						[]domain.User{
 							{
 								ID:       userID1,
 								Name:     userName1,
 								IsActive: true,
-								TeamID:   teamID,
 							},
 						},
 						nil,
@@ -169,14 +211,15 @@ func TestUsecases_CreatePullRequest(t *testing.T) {
 							Name:         prName,
 							AuthorUserID: prAuthorID,
 						},
-						[]string{userID1},
+						gomock.Any(),
+						false,
 					).
 					Return(
 						domain.PullRequest{
 							ID:                prID,
 							Name:              prName,
 							AuthorUserID:      prAuthorID,
-							ReviewersUsersIDs: []string{userID1},
+							ReviewersUsersIDs: []string{},
 							CreatedAt:         &timeNow,
 							Status:            domain.StatusOpen,
 						},
@@ -184,14 +227,22 @@ func TestUsecases_CreatePullRequest(t *testing.T) {
 					)
 
 				ms.EXPECT().
-					UserAssignmentsIncrementBatch(
+					AssignPullRequestReviewers(
 						gomock.Any(),
-						[]string{userID1},
+						prID,
+						[]domain.ReviewerAssignment{
+							{UserID: userID1, TeamID: teamID, TeamName: teamName},
+						},
+						domain.AssignmentReasonInitial,
 					).
 					Return(nil)
 
 				ms.EXPECT().
-					PullRequestStatsCreate(gomock.Any(), prID, 1).
+					CreateOutboxEvents(gomock.Any(), gomock.Any()).
+					Return(nil)
+
+				ms.EXPECT().
+					CreateAuditEntries(gomock.Any(), auditEntry(domain.AuditPullRequestCreate, prID, nil)).
 					Return(nil)
 			},
 		},
@@ -202,30 +253,259 @@ func TestUsecases_CreatePullRequest(t *testing.T) {
 				Name:         prName,
 				AuthorUserID: prAuthorID,
 			},
+			expect: domain.PullRequest{
+				ID:                 prID,
+				Name:               prName,
+				AuthorUserID:       prAuthorID,
+				ReviewersUsersIDs:  []string{},
+				CreatedAt:          &timeNow,
+				Status:             domain.StatusOpen,
+				NeedsMoreReviewers: true,
+				ReviewerPools:      map[string]domain.ReviewerPool{},
+			},
+			mock: func(ms *MockStorage) {
+				ms.EXPECT().
+					GetUserFull(gomock.Any(), prAuthorID).
+					Return(domain.User{
+						ID:       prAuthorID,
+						IsActive: true,
+						Teams:    []domain.TeamMembership{{TeamID: teamID, TeamName: teamName}},
+					}, nil)
+
+				mockUnitOfWork(ms)
+
+				ms.EXPECT().
+					GetTeamByID(gomock.Any(), teamID).
+					Return(domain.Team{
+						ID:           teamID,
+						Name:         teamName,
+						MinReviewers: domain.DefaultMinReviewers,
+						MaxReviewers: domain.DefaultMaxReviewers,
+					}, nil)
+
+				ms.EXPECT().
+					GetActiveColleagues(gomock.Any(), prAuthorID, teamID).
+					Return(
+						[]domain.User{},
+						nil,
+					)
+
+				ms.EXPECT().
+					GetTeamFallbacks(gomock.Any(), teamID).
+					Return([]domain.Team{}, nil)
+
+				ms.EXPECT().
+					CreatePullRequest(
+						gomock.Any(),
+						domain.CreatePullRequestRequest{
+							ID:           prID,
+							Name:         prName,
+							AuthorUserID: prAuthorID,
+						},
+						gomock.Any(),
+						true,
+					).
+					Return(
+						domain.PullRequest{
+							ID:                 prID,
+							Name:               prName,
+							AuthorUserID:       prAuthorID,
+							ReviewersUsersIDs:  []string{},
+							CreatedAt:          &timeNow,
+							Status:             domain.StatusOpen,
+							NeedsMoreReviewers: true,
+						},
+						nil,
+					)
+
+				ms.EXPECT().
+					AssignPullRequestReviewers(
+						gomock.Any(),
+						prID,
+						[]domain.ReviewerAssignment{},
+						domain.AssignmentReasonInitial,
+					).
+					Return(nil)
+
+				ms.EXPECT().
+					CreateAuditEntries(gomock.Any(), auditEntry(domain.AuditPullRequestCreate, prID, nil)).
+					Return(nil)
+			},
+		},
+		{
+			name: "team_needs_more_reviewers",
+			in: domain.CreatePullRequestRequest{
+				ID:           prID,
+				Name:         prName,
+				AuthorUserID: prAuthorID,
+			},
+			expect: domain.PullRequest{
+				ID:                 prID,
+				Name:               prName,
+				AuthorUserID:       prAuthorID,
+				ReviewersUsersIDs:  []string{userID1, userID2},
+				CreatedAt:          &timeNow,
+				Status:             domain.StatusOpen,
+				NeedsMoreReviewers: true,
+				ReviewerPools: map[string]domain.ReviewerPool{
+					userID1: {TeamName: teamName, AssignedAt: timeNow},
+					userID2: {TeamName: teamName, AssignedAt: timeNow},
+				},
+			},
+			mock: func(ms *MockStorage) {
+				ms.EXPECT().
+					GetUserFull(gomock.Any(), prAuthorID).
+					Return(domain.User{
+						ID:       prAuthorID,
+						IsActive: true,
+						Teams:    []domain.TeamMembership{{TeamID: teamID, TeamName: teamName}},
+					}, nil)
+
+				mockUnitOfWork(ms)
+
+				ms.EXPECT().
+					GetTeamByID(gomock.Any(), teamID).
+					Return(domain.Team{
+						ID:           teamID,
+						Name:         teamName,
+						MinReviewers: 3,
+						MaxReviewers: 3,
+					}, nil)
+
+				ms.EXPECT().
+					GetActiveColleagues(gomock.Any(), prAuthorID, teamID).
+					Return(
+						[]domain.User{
+							{
+								ID:       userID1,
+								Name:     userName1,
+								IsActive: true,
+							},
+							{
+								ID:       userID2,
+								Name:     userName2,
+								IsActive: true,
+							},
+						},
+						nil,
+					)
+
+				ms.EXPECT().
+					GetTeamFallbacks(gomock.Any(), teamID).
+					Return([]domain.Team{}, nil)
+
+				ms.EXPECT().
+					CreatePullRequest(
+						gomock.Any(),
+						domain.CreatePullRequestRequest{
+							ID:           prID,
+							Name:         prName,
+							AuthorUserID: prAuthorID,
+						},
+						gomock.Any(),
+						true,
+					).
+					Return(
+						domain.PullRequest{
+							ID:                 prID,
+							Name:               prName,
+							AuthorUserID:       prAuthorID,
+							ReviewersUsersIDs:  []string{userID1, userID2},
+							CreatedAt:          &timeNow,
+							Status:             domain.StatusOpen,
+							NeedsMoreReviewers: true,
+						},
+						nil,
+					)
+
+				ms.EXPECT().
+					AssignPullRequestReviewers(
+						gomock.Any(),
+						prID,
+						gomock.InAnyOrder([]domain.ReviewerAssignment{
+							{UserID: userID1, TeamID: teamID, TeamName: teamName},
+							{UserID: userID2, TeamID: teamID, TeamName: teamName},
+						}),
+						domain.AssignmentReasonInitial,
+					).
+					Return(nil)
+
+				ms.EXPECT().
+					CreateOutboxEvents(gomock.Any(), gomock.Any()).
+					Return(nil)
+
+				ms.EXPECT().
+					CreateAuditEntries(gomock.Any(), auditEntry(domain.AuditPullRequestCreate, prID, nil)).
+					Return(nil)
+			},
+		},
+		{
+			name: "fallback_team",
+			in: domain.CreatePullRequestRequest{
+				ID:           prID,
+				Name:         prName,
+				AuthorUserID: prAuthorID,
+			},
 			expect: domain.PullRequest{
 				ID:                prID,
 				Name:              prName,
 				AuthorUserID:      prAuthorID,
-				ReviewersUsersIDs: []string{},
+				ReviewersUsersIDs: []string{userID3},
 				CreatedAt:         &timeNow,
 				Status:            domain.StatusOpen,
+				ReviewerPools: map[string]domain.ReviewerPool{
+					userID3: {TeamName: fallbackTeamName, IsFallback: true, AssignedAt: timeNow},
+				},
 			},
 			mock: func(ms *MockStorage) {
 				ms.EXPECT().
-					GetUserShort(gomock.Any(), prAuthorID).
+					GetUserFull(gomock.Any(), prAuthorID).
 					Return(domain.User{
 						ID:       prAuthorID,
 						IsActive: true,
+						Teams:    []domain.TeamMembership{{TeamID: teamID, TeamName: teamName}},
 					}, nil)
 
 				mockUnitOfWork(ms)
 
 				ms.EXPECT().
-					GetActiveColleagues(gomock.Any(), prAuthorID).
-					Return(
-						[]domain.User{},
-						nil,
-					)
+					GetTeamByID(gomock.Any(), teamID).
+					Return(domain.Team{
+						ID:           teamID,
+						Name:         teamName,
+						MinReviewers: domain.DefaultMinReviewers,
+						MaxReviewers: domain.DefaultMaxReviewers,
+					}, nil)
+
+				ms.EXPECT().
+					GetActiveColleagues(gomock.Any(), prAuthorID, teamID).
+					Return([]domain.User{}, nil)
+
+				ms.EXPECT().
+					GetTeamFallbacks(gomock.Any(), teamID).
+					Return([]domain.Team{
+						{
+							ID:           fallbackTeamID,
+							Name:         fallbackTeamName,
+							MinReviewers: domain.DefaultMinReviewers,
+							MaxReviewers: domain.DefaultMaxReviewers,
+						},
+					}, nil)
+
+				// NOTE: автор состоит и в резервной команде, но назначать его нельзя
+				ms.EXPECT().
+					GetActiveTeamMembers(gomock.Any(), fallbackTeamID).
+					Return([]domain.User{
+						{
+							ID:       prAuthorID,
+							IsActive: true,
+						},
+						{
+							ID:       userID3,
+							Name:     userName3,
+							IsActive: true,
+						},
+					}, nil)
 
 				ms.EXPECT().
 					CreatePullRequest(
@@ -235,7 +515,8 @@ func TestUsecases_CreatePullRequest(t *testing.T) {
 							Name:         prName,
 							AuthorUserID: prAuthorID,
 						},
-						[]string{},
+						gomock.Any(),
+						false,
 					).
 					Return(
 						domain.PullRequest{
@@ -250,48 +531,396 @@ func TestUsecases_CreatePullRequest(t *testing.T) {
 					)
 
 				ms.EXPECT().
-					UserAssignmentsIncrementBatch(
+					AssignPullRequestReviewers(
 						gomock.Any(),
-						[]string{},
+						prID,
+						[]domain.ReviewerAssignment{
+							{UserID: userID3, TeamID: fallbackTeamID, TeamName: fallbackTeamName, IsFallback: true},
+						},
+						domain.AssignmentReasonInitial,
 					).
 					Return(nil)
 
 				ms.EXPECT().
-					PullRequestStatsCreate(gomock.Any(), prID, 0).
+					CreateOutboxEvents(gomock.Any(), gomock.Any()).
+					Return(nil)
+
+				ms.EXPECT().
+					CreateAuditEntries(gomock.Any(), auditEntry(domain.AuditPullRequestCreate, prID, nil)).
 					Return(nil)
 			},
 		},
 		{
-			name: "author_not_found",
+			name: "code_owners_first",
 			in: domain.CreatePullRequestRequest{
 				ID:           prID,
 				Name:         prName,
 				AuthorUserID: prAuthorID,
+				ChangedFiles: []string{"internal/storage/team.go"},
+			},
+			expect: domain.PullRequest{
+				ID:                prID,
+				Name:              prName,
+				AuthorUserID:      prAuthorID,
+				ReviewersUsersIDs: []string{userID2, userID1},
+				CreatedAt:         &timeNow,
+				Status:            domain.StatusOpen,
+				ReviewerPools: map[string]domain.ReviewerPool{
+					userID2: {TeamName: teamName, IsOwner: true, AssignedAt: timeNow},
+					userID1: {TeamName: teamName, AssignedAt: timeNow},
+				},
 			},
 			mock: func(ms *MockStorage) {
+				team := domain.Team{
+					ID:           teamID,
+					Name:         teamName,
+					MinReviewers: domain.DefaultMinReviewers,
+					MaxReviewers: domain.DefaultMaxReviewers,
+				}
+
 				ms.EXPECT().
-					GetUserShort(gomock.Any(), prAuthorID).
-					Return(domain.User{}, domain.ErrUserNotFound)
+					GetUserFull(gomock.Any(), prAuthorID).
+					Return(domain.User{
+						ID:       prAuthorID,
+						IsActive: true,
+						Teams:    []domain.TeamMembership{{TeamID: teamID, TeamName: teamName}},
+					}, nil)
+
+				mockUnitOfWork(ms)
+
+				ms.EXPECT().
+					GetTeamByID(gomock.Any(), teamID).
+					Return(team, nil)
+
+				ms.EXPECT().
+					GetActiveColleagues(gomock.Any(), prAuthorID, teamID).
+					Return(
+						[]domain.User{
+							{
+								ID:       userID1,
+								Name:     userName1,
+								IsActive: true,
+							},
+							{
+								ID:       userID2,
+								Name:     userName2,
+								IsActive: true,
+							},
+						},
+						nil,
+					)
+
+				ms.EXPECT().
+					GetTeamOwnerRules(gomock.Any(), teamID).
+					Return([]domain.OwnerRule{
+						{Pattern: "*.sql", Owners: []string{userID1}},
+						{Pattern: "/internal/storage/", Owners: []string{userID2}},
+					}, nil)
+
+				ms.EXPECT().
+					GetUserShort(gomock.Any(), userID2).
+					Return(domain.User{
+						ID:       userID2,
+						Name:     userName2,
+						IsActive: true,
+					}, nil)
+
+				ms.EXPECT().
+					GetUserUnavailability(gomock.Any(), userID2, gomock.Any()).
+					Return([]domain.Unavailability{}, nil)
+
+				ms.EXPECT().
+					CreatePullRequest(
+						gomock.Any(),
+						domain.CreatePullRequestRequest{
+							ID:           prID,
+							Name:         prName,
+							AuthorUserID: prAuthorID,
+							ChangedFiles: []string{"internal/storage/team.go"},
+						},
+						gomock.Any(),
+						false,
+					).
+					Return(
+						domain.PullRequest{
+							ID:                prID,
+							Name:              prName,
+							AuthorUserID:      prAuthorID,
+							ReviewersUsersIDs: []string{},
+							CreatedAt:         &timeNow,
+							Status:            domain.StatusOpen,
+						},
+						nil,
+					)
+
+				ms.EXPECT().
+					AssignPullRequestReviewers(
+						gomock.Any(),
+						prID,
+						[]domain.ReviewerAssignment{
+							{UserID: userID2, TeamID: teamID, TeamName: teamName, IsOwner: true},
+							{UserID: userID1, TeamID: teamID, TeamName: teamName},
+						},
+						domain.AssignmentReasonInitial,
+					).
+					Return(nil)
+
+				ms.EXPECT().
+					CreateOutboxEvents(gomock.Any(), gomock.Any()).
+					Return(nil)
+
+				ms.EXPECT().
+					CreateAuditEntries(gomock.Any(), auditEntry(domain.AuditPullRequestCreate, prID, nil)).
+					Return(nil)
 			},
-			expectErrMsg: "storage.GetUserShort: user not found",
 		},
 		{
-			name: "author_not_active",
+			name: "draft_without_reviewers",
 			in: domain.CreatePullRequestRequest{
 				ID:           prID,
 				Name:         prName,
 				AuthorUserID: prAuthorID,
+				Draft:        true,
+			},
+			expect: domain.PullRequest{
+				ID:                prID,
+				Name:              prName,
+				AuthorUserID:      prAuthorID,
+				ReviewersUsersIDs: []string{},
+				CreatedAt:         &timeNow,
+				Status:            domain.StatusDraft,
+				ReviewerPools:     map[string]domain.ReviewerPool{},
 			},
 			mock: func(ms *MockStorage) {
 				ms.EXPECT().
-					GetUserShort(gomock.Any(), prAuthorID).
+					GetUserFull(gomock.Any(), prAuthorID).
 					Return(domain.User{
 						ID:       prAuthorID,
-						IsActive: false,
+						IsActive: true,
+						Teams:    []domain.TeamMembership{{TeamID: teamID, TeamName: teamName}},
 					}, nil)
-			},
+
+				mockUnitOfWork(ms)
+
+				ms.EXPECT().
+					GetTeamByID(gomock.Any(), teamID).
+					Return(domain.Team{ID: teamID, Name: teamName}, nil)
+
+				ms.EXPECT().
+					CreatePullRequest(
+						gomock.Any(),
+						domain.CreatePullRequestRequest{
+							ID:           prID,
+							Name:         prName,
+							AuthorUserID: prAuthorID,
+							Draft:        true,
+						},
+						gomock.Any(),
+						false,
+					).
+					Return(
+						domain.PullRequest{
+							ID:                prID,
+							Name:              prName,
+							AuthorUserID:      prAuthorID,
+							ReviewersUsersIDs: []string{},
+							CreatedAt:         &timeNow,
+							Status:            domain.StatusDraft,
+						},
+						nil,
+					)
+
+				ms.EXPECT().
+					AssignPullRequestReviewers(gomock.Any(), prID, []domain.ReviewerAssignment{}, domain.AssignmentReasonInitial).
+					Return(nil)
+
+				ms.EXPECT().
+					CreateAuditEntries(gomock.Any(), auditEntry(domain.AuditPullRequestCreate, prID, nil)).
+					Return(nil)
+			},
+		},
+		{
+			name: "author_not_found",
+			in: domain.CreatePullRequestRequest{
+				ID:           prID,
+				Name:         prName,
+				AuthorUserID: prAuthorID,
+			},
+			mock: func(ms *MockStorage) {
+				ms.EXPECT().
+					GetUserFull(gomock.Any(), prAuthorID).
+					Return(domain.User{}, domain.ErrUserNotFound)
+			},
+			expectErrMsg: "storage.GetUserFull: user not found",
+		},
+		{
+			name: "author_not_active",
+			in: domain.CreatePullRequestRequest{
+				ID:           prID,
+				Name:         prName,
+				AuthorUserID: prAuthorID,
+			},
+			mock: func(ms *MockStorage) {
+				ms.EXPECT().
+					GetUserFull(gomock.Any(), prAuthorID).
+					Return(domain.User{
+						ID:       prAuthorID,
+						IsActive: false,
+					}, nil)
+			},
 			expectErrMsg: domain.ErrUserInactive.Error(),
 		},
+		{
+			name: "ambiguous_team",
+			in: domain.CreatePullRequestRequest{
+				ID:           prID,
+				Name:         prName,
+				AuthorUserID: prAuthorID,
+			},
+			mock: func(ms *MockStorage) {
+				ms.EXPECT().
+					GetUserFull(gomock.Any(), prAuthorID).
+					Return(domain.User{
+						ID:       prAuthorID,
+						IsActive: true,
+						Teams: []domain.TeamMembership{
+							{TeamID: teamID, TeamName: teamName},
+							{TeamID: fallbackTeamID, TeamName: fallbackTeamName},
+						},
+					}, nil)
+
+				mockUnitOfWork(ms)
+			},
+			expectErrMsg: "UnitOfWork: resolveUserTeam: " + domain.ErrAmbiguousTeam.Error(),
+		},
+		{
+			name: "author_not_in_team",
+			in: domain.CreatePullRequestRequest{
+				ID:           prID,
+				Name:         prName,
+				AuthorUserID: prAuthorID,
+				TeamName:     fallbackTeamName,
+			},
+			mock: func(ms *MockStorage) {
+				ms.EXPECT().
+					GetUserFull(gomock.Any(), prAuthorID).
+					Return(domain.User{
+						ID:       prAuthorID,
+						IsActive: true,
+						Teams:    []domain.TeamMembership{{TeamID: teamID, TeamName: teamName}},
+					}, nil)
+
+				mockUnitOfWork(ms)
+
+				ms.EXPECT().
+					GetTeamByName(gomock.Any(), fallbackTeamName).
+					Return(domain.Team{ID: fallbackTeamID, Name: fallbackTeamName}, nil)
+			},
+			expectErrMsg: "UnitOfWork: resolveUserTeam: " + domain.NewErrNotInTeam(fallbackTeamName, []string{prAuthorID}).Error(),
+		},
+		{
+			// NOTE: сейчас 07:00 UTC - в Москве 10:00, в Нью-Йорке 02:00
+			name: "prefer_working_hours",
+			in: domain.CreatePullRequestRequest{
+				ID:           prID,
+				Name:         prName,
+				AuthorUserID: prAuthorID,
+			},
+			expect: domain.PullRequest{
+				ID:                prID,
+				Name:              prName,
+				AuthorUserID:      prAuthorID,
+				ReviewersUsersIDs: []string{userID1, userID3},
+				CreatedAt:         &timeNow,
+				Status:            domain.StatusOpen,
+				ReviewerPools: map[string]domain.ReviewerPool{
+					userID1: {TeamName: teamName, AssignedAt: timeNow},
+					userID3: {TeamName: teamName, AssignedAt: timeNow},
+				},
+			},
+			mock: func(ms *MockStorage) {
+				ms.EXPECT().
+					GetUserFull(gomock.Any(), prAuthorID).
+					Return(domain.User{
+						ID:       prAuthorID,
+						IsActive: true,
+						Teams:    []domain.TeamMembership{{TeamID: teamID, TeamName: teamName}},
+					}, nil)
+
+				mockUnitOfWork(ms)
+
+				ms.EXPECT().
+					GetTeamByID(gomock.Any(), teamID).
+					Return(domain.Team{
+						ID:                 teamID,
+						Name:               teamName,
+						MinReviewers:       domain.DefaultMinReviewers,
+						MaxReviewers:       domain.DefaultMaxReviewers,
+						PreferWorkingHours: true,
+					}, nil)
+
+				ms.EXPECT().
+					GetActiveColleagues(gomock.Any(), prAuthorID, teamID).
+					Return(
+						[]domain.User{
+							{
+								ID:           userID1,
+								Name:         userName1,
+								IsActive:     true,
+								Timezone:     "Europe/Moscow",
+								WorkingHours: &domain.WorkingHours{StartMinute: 9 * 60, EndMinute: 18 * 60},
+							},
+							{
+								ID:           userID2,
+								Name:         userName2,
+								IsActive:     true,
+								Timezone:     "America/New_York",
+								WorkingHours: &domain.WorkingHours{StartMinute: 9 * 60, EndMinute: 18 * 60},
+							},
+							{
+								ID:       userID3,
+								Name:     userName3,
+								IsActive: true,
+							},
+						},
+						nil,
+					)
+
+				ms.EXPECT().
+					CreatePullRequest(gomock.Any(), gomock.Any(), gomock.Any(), false).
+					Return(
+						domain.PullRequest{
+							ID:                prID,
+							Name:              prName,
+							AuthorUserID:      prAuthorID,
+							ReviewersUsersIDs: []string{},
+							CreatedAt:         &timeNow,
+							Status:            domain.StatusOpen,
+						},
+						nil,
+					)
+
+				ms.EXPECT().
+					AssignPullRequestReviewers(
+						gomock.Any(),
+						prID,
+						gomock.InAnyOrder([]domain.ReviewerAssignment{
+							{UserID: userID1, TeamID: teamID, TeamName: teamName},
+							{UserID: userID3, TeamID: teamID, TeamName: teamName},
+						}),
+						domain.AssignmentReasonInitial,
+					).
+					Return(nil)
+
+				ms.EXPECT().
+					CreateOutboxEvents(gomock.Any(), gomock.Any()).
+					Return(nil)
+
+				ms.EXPECT().
+					CreateAuditEntries(gomock.Any(), auditEntry(domain.AuditPullRequestCreate, prID, nil)).
+					Return(nil)
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -300,7 +929,9 @@ func TestUsecases_CreatePullRequest(t *testing.T) {
 			storageMock := NewMockStorage(ctrl)
 			tc.mock(storageMock)
 
-			u := NewUsecases(storageMock)
+			u := NewUsecases(storageMock, WithClock(clock.NewFake(
+				time.Date(2025, time.November, 3, 7, 0, 0, 0, time.UTC),
+			)))
 			gotPullRequest, err := u.CreatePullRequest(context.Background(), tc.in)
 			if tc.expectErrMsg != "" {
 				require.Error(t, err)
@@ -308,8 +939,375 @@ func TestUsecases_CreatePullRequest(t *testing.T) {
 				assert.Equal(t, domain.PullRequest{}, gotPullRequest)
 			} else {
 				require.NoError(t, err)
+
+				// NOTE: порядок ревьюверов зависит от случайного выбора
+				assert.ElementsMatch(t, tc.expect.ReviewersUsersIDs, gotPullRequest.ReviewersUsersIDs)
+				gotPullRequest.ReviewersUsersIDs = tc.expect.ReviewersUsersIDs
 				assert.Equal(t, tc.expect, gotPullRequest)
 			}
 		})
 	}
 }
+
+func TestUsecases_MarkPullRequestReady(t *testing.T) {
+	mockUnitOfWork := func(ms *MockStorage) {
+		ms.EXPECT().UnitOfWork(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, fn func(s Storage) error) error {
+				return fn(ms)
+			})
+	}
+
+	const (
+		prID       = "100"
+		prAuthorID = "200"
+		teamID     = "300"
+		teamName   = "team1"
+		userID1    = "101"
+	)
+
+	testCases := []struct {
+		name         string
+		mock         func(*MockStorage)
+		expectErr    error
+		expectStatus domain.PullRequestStatus
+	}{
+		{
+			name: "draft_gets_reviewers",
+			mock: func(ms *MockStorage) {
+				mockUnitOfWork(ms)
+
+				ms.EXPECT().LockPullRequest(gomock.Any(), prID).Return(nil)
+				ms.EXPECT().
+					GetPullRequestByID(gomock.Any(), prID).
+					Return(domain.PullRequest{
+						ID:                prID,
+						AuthorUserID:      prAuthorID,
+						ReviewersUsersIDs: []string{},
+						Status:            domain.StatusDraft,
+						TeamID:            teamID,
+					}, nil)
+
+				ms.EXPECT().
+					GetUserShort(gomock.Any(), prAuthorID).
+					Return(domain.User{ID: prAuthorID, IsActive: true}, nil)
+
+				ms.EXPECT().
+					GetTeamByID(gomock.Any(), teamID).
+					Return(domain.Team{
+						ID:           teamID,
+						Name:         teamName,
+						MinReviewers: domain.DefaultMinReviewers,
+						MaxReviewers: domain.DefaultMaxReviewers,
+					}, nil)
+
+				ms.EXPECT().
+					GetActiveColleagues(gomock.Any(), prAuthorID, teamID).
+					Return([]domain.User{{ID: userID1, IsActive: true}}, nil)
+
+				ms.EXPECT().
+					UpdatePullRequestNeedsMoreReviewers(gomock.Any(), prID, false).
+					Return(nil)
+
+				ms.EXPECT().
+					AssignPullRequestReviewers(gomock.Any(), prID, []domain.ReviewerAssignment{
+						{UserID: userID1, TeamID: teamID, TeamName: teamName},
+					}, domain.AssignmentReasonInitial).
+					Return(nil)
+
+				ms.EXPECT().
+					CreateOutboxEvents(gomock.Any(), eventsWithData(domain.ReviewersAssignedEvent{
+						PullRequestID: prID,
+						ReviewerIDs:   []string{userID1},
+						Reason:        domain.AssignmentReasonInitial,
+					})).
+					Return(nil)
+
+				ms.EXPECT().
+					UpdatePullRequestStatus(gomock.Any(), prID, domain.StatusOpen).
+					Return(nil)
+
+				ms.EXPECT().
+					GetPullRequestByID(gomock.Any(), prID).
+					Return(domain.PullRequest{
+						ID:                prID,
+						AuthorUserID:      prAuthorID,
+						ReviewersUsersIDs: []string{userID1},
+						Status:            domain.StatusOpen,
+					}, nil)
+
+				ms.EXPECT().
+					CreateAuditEntries(gomock.Any(), auditEntry(domain.AuditPullRequestChangeStatus, prID, domain.AuditPullRequestState{
+						AuthorID:    prAuthorID,
+						Status:      domain.ConvertPullRequestStatusToApi(domain.StatusOpen),
+						ReviewerIDs: []string{userID1},
+					})).
+					Return(nil)
+			},
+			expectStatus: domain.StatusOpen,
+		},
+		{
+			name: "not_draft",
+			mock: func(ms *MockStorage) {
+				mockUnitOfWork(ms)
+
+				ms.EXPECT().LockPullRequest(gomock.Any(), prID).Return(nil)
+				ms.EXPECT().
+					GetPullRequestByID(gomock.Any(), prID).
+					Return(domain.PullRequest{
+						ID:                prID,
+						AuthorUserID:      prAuthorID,
+						ReviewersUsersIDs: []string{userID1},
+						Status:            domain.StatusOpen,
+					}, nil)
+			},
+			expectErr: domain.ErrInvalidTransition,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			storageMock := NewMockStorage(ctrl)
+			tc.mock(storageMock)
+
+			u := NewUsecases(storageMock)
+			gotPullRequest, err := u.MarkPullRequestReady(context.Background(), prID)
+			if tc.expectErr != nil {
+				require.ErrorIs(t, err, tc.expectErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectStatus, gotPullRequest.Status)
+		})
+	}
+}
+
+func TestUsecases_ReopenPullRequest(t *testing.T) {
+	const prID = "100"
+
+	testCases := []struct {
+		name      string
+		status    domain.PullRequestStatus
+		expectErr error
+	}{
+		{name: "closed", status: domain.StatusClosed},
+		{name: "open", status: domain.StatusOpen, expectErr: domain.ErrInvalidTransition},
+		{name: "merged", status: domain.StatusMerged, expectErr: domain.ErrInvalidTransition},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			ms := NewMockStorage(ctrl)
+
+			ms.EXPECT().UnitOfWork(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, fn func(s Storage) error) error {
+					return fn(ms)
+				})
+
+			ms.EXPECT().LockPullRequest(gomock.Any(), prID).Return(nil)
+			ms.EXPECT().
+				GetPullRequestByID(gomock.Any(), prID).
+				Return(domain.PullRequest{
+					ID:                prID,
+					ReviewersUsersIDs: []string{"101"},
+					Status:            tc.status,
+				}, nil)
+
+			if tc.expectErr == nil {
+				ms.EXPECT().
+					UpdatePullRequestStatus(gomock.Any(), prID, domain.StatusReopened).
+					Return(nil)
+
+				ms.EXPECT().
+					GetPullRequestByID(gomock.Any(), prID).
+					Return(domain.PullRequest{
+						ID:                prID,
+						ReviewersUsersIDs: []string{"101"},
+						Status:            domain.StatusReopened,
+					}, nil)
+
+				ms.EXPECT().
+					CreateAuditEntries(gomock.Any(), auditEntry(domain.AuditPullRequestChangeStatus, prID, nil)).
+					Return(nil)
+			}
+
+			u := NewUsecases(ms)
+			gotPullRequest, err := u.ReopenPullRequest(context.Background(), prID)
+			if tc.expectErr != nil {
+				require.ErrorIs(t, err, tc.expectErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, domain.StatusReopened, gotPullRequest.Status)
+		})
+	}
+}
+
+func TestUsecases_MergePullRequest(t *testing.T) {
+	const (
+		prID       = "100"
+		authorID   = "101"
+		reviewerID = "102"
+		teamID     = "1"
+	)
+
+	testCases := []struct {
+		name              string
+		requiredApprovals int
+		approvals         int
+		expectErr         error
+	}{
+		{name: "without_gate", requiredApprovals: 0},
+		{name: "enough_approvals", requiredApprovals: 2, approvals: 2},
+		{name: "not_enough_approvals", requiredApprovals: 2, approvals: 1, expectErr: domain.ErrNotEnoughApprovals},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			ms := NewMockStorage(ctrl)
+
+			ms.EXPECT().UnitOfWork(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, fn func(s Storage) error) error {
+					return fn(ms)
+				},
+			)
+
+			ms.EXPECT().LockPullRequest(gomock.Any(), prID).Return(nil)
+			ms.EXPECT().
+				GetPullRequestByID(gomock.Any(), prID).
+				Return(domain.PullRequest{
+					ID:                prID,
+					AuthorUserID:      authorID,
+					ReviewersUsersIDs: []string{reviewerID},
+					Status:            domain.StatusOpen,
+					TeamID:            teamID,
+				}, nil)
+
+			ms.EXPECT().
+				GetTeamByID(gomock.Any(), teamID).
+				Return(domain.Team{ID: teamID, RequiredApprovals: tc.requiredApprovals}, nil)
+
+			if tc.requiredApprovals > 0 {
+				ms.EXPECT().
+					CountPullRequestApprovals(gomock.Any(), prID).
+					Return(tc.approvals, nil)
+			}
+
+			if tc.expectErr == nil {
+				ms.EXPECT().
+					UpdatePullRequestStatus(gomock.Any(), prID, domain.StatusMerged).
+					Return(nil)
+
+				ms.EXPECT().
+					CreateOutboxEvents(gomock.Any(), eventsWithData(domain.PullRequestMergedEvent{
+						PullRequestID: prID,
+						AuthorID:      authorID,
+						ReviewerIDs:   []string{reviewerID},
+					})).
+					Return(nil)
+
+				ms.EXPECT().
+					CreateAuditEntries(gomock.Any(), auditEntry(domain.AuditPullRequestMerge, prID, domain.AuditPullRequestState{
+						AuthorID:    authorID,
+						Status:      domain.ConvertPullRequestStatusToApi(domain.StatusMerged),
+						ReviewerIDs: []string{reviewerID},
+					})).
+					Return(nil)
+
+				ms.EXPECT().
+					GetPullRequestByID(gomock.Any(), prID).
+					Return(domain.PullRequest{ID: prID, Status: domain.StatusMerged}, nil)
+			}
+
+			u := NewUsecases(ms)
+			gotPullRequest, err := u.MergePullRequest(context.Background(), prID)
+			if tc.expectErr != nil {
+				require.ErrorIs(t, err, tc.expectErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, domain.StatusMerged, gotPullRequest.Status)
+		})
+	}
+}
+
+func TestUsecases_SubmitReview(t *testing.T) {
+	const (
+		prID       = "100"
+		reviewerID = "102"
+	)
+
+	testCases := []struct {
+		name      string
+		userID    string
+		status    domain.PullRequestStatus
+		expectErr error
+	}{
+		{name: "approve", userID: reviewerID, status: domain.StatusOpen},
+		{name: "not_assigned", userID: "103", status: domain.StatusOpen, expectErr: domain.ErrNotAssigned},
+		{name: "merged", userID: reviewerID, status: domain.StatusMerged, expectErr: domain.ErrPRMerged},
+		{name: "draft", userID: reviewerID, status: domain.StatusDraft, expectErr: domain.ErrPRNotActive},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			ms := NewMockStorage(ctrl)
+
+			request := domain.SubmitReviewRequest{
+				PullRequestID: prID,
+				UserID:        tc.userID,
+				Verdict:       domain.VerdictApprove,
+			}
+
+			ms.EXPECT().UnitOfWork(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, fn func(s Storage) error) error {
+					return fn(ms)
+				},
+			)
+
+			ms.EXPECT().LockPullRequest(gomock.Any(), prID).Return(nil)
+			ms.EXPECT().
+				GetPullRequestByID(gomock.Any(), prID).
+				Return(domain.PullRequest{
+					ID:                prID,
+					ReviewersUsersIDs: []string{reviewerID},
+					Status:            tc.status,
+				}, nil)
+
+			if tc.expectErr == nil {
+				ms.EXPECT().
+					CreatePullRequestReview(gomock.Any(), request).
+					Return(domain.Review{ID: 1, PullRequestID: prID, UserID: tc.userID, Verdict: domain.VerdictApprove}, nil)
+
+				ms.EXPECT().
+					CountPullRequestApprovals(gomock.Any(), prID).
+					Return(1, nil)
+
+				ms.EXPECT().
+					CreateAuditEntries(gomock.Any(), auditEntry(
+						domain.AuditPullRequestReview,
+						prID,
+						domain.AuditReviewState{UserID: tc.userID, Verdict: domain.VerdictApprove},
+					)).
+					Return(nil)
+			}
+
+			u := NewUsecases(ms)
+			review, approvals, err := u.SubmitReview(context.Background(), request)
+			if tc.expectErr != nil {
+				require.ErrorIs(t, err, tc.expectErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, domain.VerdictApprove, review.Verdict)
+			assert.Equal(t, 1, approvals)
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"pr-manager-service/internal/domain"
+)
+
+// SubmitReview сохраняет решение ревьювера и возвращает текущее число одобрений PR.
+// Решение сохраняется под блокировкой PR, чтобы параллельный мерж видел актуальные одобрения.
+func (u *Usecases) SubmitReview(ctx context.Context, request domain.SubmitReviewRequest) (domain.Review, int, error) {
+	var (
+		review    domain.Review
+		approvals int
+	)
+
+	if err := u.storage.UnitOfWork(ctx, func(s Storage) error {
+		if err := u.authorizeActAs(ctx, s, request.UserID); err != nil {
+			return err
+		}
+
+		if err := s.LockPullRequest(ctx, request.PullRequestID); err != nil {
+			return fmt.Errorf("LockPullRequest: %w", err)
+		}
+
+		pullRequest, err := s.GetPullRequestByID(ctx, request.PullRequestID)
+		if err != nil {
+			return fmt.Errorf("GetPullRequestByID: %w", err)
+		}
+
+		if pullRequest.Status == domain.StatusMerged {
+			return domain.ErrPRMerged
+		}
+
+		if !pullRequest.Status.IsActive() {
+			return domain.ErrPRNotActive
+		}
+
+		if !slices.Contains(pullRequest.ReviewersUsersIDs, request.UserID) {
+			return domain.ErrNotAssigned
+		}
+
+		review, err = s.CreatePullRequestReview(ctx, request)
+		if err != nil {
+			return fmt.Errorf("CreatePullRequestReview: %w", err)
+		}
+
+		approvals, err = s.CountPullRequestApprovals(ctx, request.PullRequestID)
+		if err != nil {
+			return fmt.Errorf("CountPullRequestApprovals: %w", err)
+		}
+
+		if err := u.audit(
+			ctx,
+			s,
+			domain.AuditPullRequestReview,
+			domain.AuditTargetPullRequest,
+			pullRequest.ID,
+			nil,
+			domain.NewAuditReviewState(review),
+		); err != nil {
+			return fmt.Errorf("audit: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return domain.Review{}, 0, fmt.Errorf("UnitOfWork: %w", err)
+	}
+
+	return review, approvals, nil
+}
+
+// checkMergeApprovals проверяет, что PR набрал required_approvals одобрений команды PR.
+func (u *Usecases) checkMergeApprovals(ctx context.Context, s Storage, pullRequest domain.PullRequest) error {
+	team, err := u.pullRequestTeam(ctx, s, pullRequest)
+	if err != nil {
+		return fmt.Errorf("pullRequestTeam: %w", err)
+	}
+
+	if team.RequiredApprovals == 0 {
+		return nil
+	}
+
+	approvals, err := s.CountPullRequestApprovals(ctx, pullRequest.ID)
+	if err != nil {
+		return fmt.Errorf("CountPullRequestApprovals: %w", err)
+	}
+
+	if approvals < team.RequiredApprovals {
+		return fmt.Errorf("%w: %d of %d", domain.ErrNotEnoughApprovals, approvals, team.RequiredApprovals)
+	}
+
+	return nil
+}
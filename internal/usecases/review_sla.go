@@ -0,0 +1,103 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+
+	"pr-manager-service/internal/domain"
+
+	"github.com/samber/lo"
+)
+
+// GetOverduePullRequests возвращает PR в статусах OPEN и REOPENED, где хотя бы один ревьювер держит PR
+// дольше SLA команды PR. Если teamName не пустой, возвращаются только PR этой команды.
+func (u *Usecases) GetOverduePullRequests(ctx context.Context, teamName string) ([]domain.PullRequest, error) {
+	var teamID string
+	if teamName != "" {
+		team, err := u.storage.GetTeamByName(ctx, teamName)
+		if err != nil {
+			return nil, fmt.Errorf("GetTeamByName: %w", err)
+		}
+
+		teamID = team.ID
+	}
+
+	pullRequests, err := u.storage.GetPullRequestsPastSLA(ctx, teamID, u.clock.Now())
+	if err != nil {
+		return nil, fmt.Errorf("GetPullRequestsPastSLA: %w", err)
+	}
+
+	if err := u.markOverdueReviews(ctx, u.storage, pullRequests); err != nil {
+		return nil, fmt.Errorf("markOverdueReviews: %w", err)
+	}
+
+	return lo.Filter(pullRequests, func(pr domain.PullRequest, _ int) bool {
+		return pr.IsOverdue
+	}), nil
+}
+
+// markPullRequestOverdue отмечает просроченные ревью в одном PR.
+func (u *Usecases) markPullRequestOverdue(ctx context.Context, pr domain.PullRequest) (domain.PullRequest, error) {
+	pullRequests := []domain.PullRequest{pr}
+	if err := u.markOverdueReviews(ctx, u.storage, pullRequests); err != nil {
+		return domain.PullRequest{}, fmt.Errorf("markOverdueReviews: %w", err)
+	}
+
+	return pullRequests[0], nil
+}
+
+// markOverdueReviews заполняет IsOverdue у ревьюверов и самих PR в статусах OPEN и REOPENED.
+// Время с назначения считается в рабочих часах ревьювера, лимит - SLA команды PR.
+func (u *Usecases) markOverdueReviews(ctx context.Context, s Storage, pullRequests []domain.PullRequest) error {
+	teams := make(map[string]domain.Team)
+	reviewerIDs := make([]string, 0)
+
+	for _, pr := range pullRequests {
+		if !pr.Status.IsActive() || pr.TeamID == "" || len(pr.ReviewerPools) == 0 {
+			continue
+		}
+
+		if _, ok := teams[pr.TeamID]; !ok {
+			team, err := s.GetTeamByID(ctx, pr.TeamID)
+			if err != nil {
+				return fmt.Errorf("GetTeamByID: %w", err)
+			}
+
+			teams[pr.TeamID] = team
+		}
+
+		if teams[pr.TeamID].ReviewSLAHours > 0 {
+			reviewerIDs = append(reviewerIDs, lo.Keys(pr.ReviewerPools)...)
+		}
+	}
+
+	if len(reviewerIDs) == 0 {
+		return nil
+	}
+
+	reviewers, err := s.GetUsersByIDs(ctx, lo.Uniq(reviewerIDs))
+	if err != nil {
+		return fmt.Errorf("GetUsersByIDs: %w", err)
+	}
+
+	reviewersByID := lo.KeyBy(reviewers, func(user domain.User) string {
+		return user.ID
+	})
+
+	now := u.clock.Now()
+	for i, pr := range pullRequests {
+		team, ok := teams[pr.TeamID]
+		if !ok || !pr.Status.IsActive() {
+			continue
+		}
+
+		for reviewerID, pool := range pr.ReviewerPools {
+			pool.IsOverdue = domain.ReviewOverdue(team, reviewersByID[reviewerID], pool.AssignedAt, now)
+			pr.ReviewerPools[reviewerID] = pool
+
+			pullRequests[i].IsOverdue = pullRequests[i].IsOverdue || pool.IsOverdue
+		}
+	}
+
+	return nil
+}
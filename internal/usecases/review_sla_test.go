@@ -0,0 +1,104 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"pr-manager-service/internal/clock"
+	"pr-manager-service/internal/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUsecases_GetOverduePullRequests(t *testing.T) {
+	const (
+		teamID   = "300"
+		teamName = "team1"
+
+		userID1 = "101"
+		userID2 = "102"
+	)
+
+	// NOTE: 2025-11-10 - понедельник, с пятницы 12:00 прошло 24 рабочих часа
+	now := time.Date(2025, time.November, 10, 12, 0, 0, 0, time.UTC)
+	assignedAt := time.Date(2025, time.November, 7, 12, 0, 0, 0, time.UTC)
+
+	team := domain.Team{ID: teamID, Name: teamName, ReviewSLAHours: 24}
+
+	pullRequests := func() []domain.PullRequest {
+		return []domain.PullRequest{
+			{
+				ID:                "1",
+				Status:            domain.StatusOpen,
+				TeamID:            teamID,
+				ReviewersUsersIDs: []string{userID1, userID2},
+				ReviewerPools: map[string]domain.ReviewerPool{
+					userID1: {TeamName: teamName, AssignedAt: assignedAt},
+					// NOTE: ревьювер переназначен позже, его отсчёт начался заново
+					userID2: {TeamName: teamName, AssignedAt: assignedAt.Add(time.Hour)},
+				},
+			},
+			{
+				ID:                "2",
+				Status:            domain.StatusReopened,
+				TeamID:            teamID,
+				ReviewersUsersIDs: []string{userID2},
+				ReviewerPools: map[string]domain.ReviewerPool{
+					userID2: {TeamName: teamName, AssignedAt: assignedAt},
+				},
+			},
+		}
+	}
+
+	users := []domain.User{
+		{ID: userID1, IsActive: true},
+		// NOTE: у userID2 рабочий день до 13:00, поэтому 24 рабочих часа у него ещё не набрались
+		{ID: userID2, IsActive: true, Timezone: "UTC", WorkingHours: &domain.WorkingHours{StartMinute: 0, EndMinute: 13 * 60}},
+	}
+
+	t.Run("all_teams", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		ms := NewMockStorage(ctrl)
+
+		ms.EXPECT().GetPullRequestsPastSLA(gomock.Any(), "", now).Return(pullRequests(), nil)
+		ms.EXPECT().GetTeamByID(gomock.Any(), teamID).Return(team, nil)
+		ms.EXPECT().GetUsersByIDs(gomock.Any(), gomock.InAnyOrder([]string{userID1, userID2})).Return(users, nil)
+
+		u := NewUsecases(ms, WithClock(clock.NewFake(now)))
+		got, err := u.GetOverduePullRequests(context.Background(), "")
+		require.NoError(t, err)
+
+		require.Len(t, got, 1)
+		assert.Equal(t, "1", got[0].ID)
+		assert.True(t, got[0].IsOverdue)
+		assert.True(t, got[0].ReviewerPools[userID1].IsOverdue)
+		assert.False(t, got[0].ReviewerPools[userID2].IsOverdue)
+	})
+
+	t.Run("team_filter", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		ms := NewMockStorage(ctrl)
+
+		ms.EXPECT().GetTeamByName(gomock.Any(), teamName).Return(team, nil)
+		ms.EXPECT().GetPullRequestsPastSLA(gomock.Any(), teamID, now).Return([]domain.PullRequest{}, nil)
+
+		u := NewUsecases(ms, WithClock(clock.NewFake(now)))
+		got, err := u.GetOverduePullRequests(context.Background(), teamName)
+		require.NoError(t, err)
+		assert.Empty(t, got)
+	})
+
+	t.Run("team_not_found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		ms := NewMockStorage(ctrl)
+
+		ms.EXPECT().GetTeamByName(gomock.Any(), teamName).Return(domain.Team{}, domain.ErrTeamNotFound)
+
+		u := NewUsecases(ms, WithClock(clock.NewFake(now)))
+		_, err := u.GetOverduePullRequests(context.Background(), teamName)
+		require.ErrorIs(t, err, domain.ErrTeamNotFound)
+	})
+}
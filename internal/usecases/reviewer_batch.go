@@ -0,0 +1,187 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"pr-manager-service/internal/domain"
+
+	"github.com/samber/lo"
+)
+
+// reviewerBatch - хранилище для подбора замен сразу во многих PR одной транзакции.
+// Данные, которые читают стратегии выбора и резервные команды (нагрузка, время последнего назначения,
+// курсор round-robin, состав команд), читаются один раз и дальше ведутся в памяти, поэтому число запросов
+// зависит от числа команд и кандидатов, а не от числа PR. Остальные методы Storage выполняются как есть.
+type reviewerBatch struct {
+	Storage
+
+	now            time.Time
+	openReviews    map[string]int
+	lastAssignedAt map[string]time.Time
+	teams          map[string]domain.Team
+	teamMembers    map[string][]domain.User
+	colleagues     map[[2]string][]domain.User
+	fallbacks      map[string][]domain.Team
+	cursors        map[string]string
+	changedCursors map[string]bool
+}
+
+func newReviewerBatch(s Storage, now time.Time) *reviewerBatch {
+	return &reviewerBatch{
+		Storage:        s,
+		now:            now,
+		openReviews:    make(map[string]int),
+		lastAssignedAt: make(map[string]time.Time),
+		teams:          make(map[string]domain.Team),
+		teamMembers:    make(map[string][]domain.User),
+		colleagues:     make(map[[2]string][]domain.User),
+		fallbacks:      make(map[string][]domain.Team),
+		cursors:        make(map[string]string),
+		changedCursors: make(map[string]bool),
+	}
+}
+
+// assigned учитывает новое назначение пользователя в нагрузке и времени последнего назначения.
+func (b *reviewerBatch) assigned(userID string) {
+	b.openReviews[userID]++
+	b.lastAssignedAt[userID] = b.now
+}
+
+// flush сохраняет курсоры round-robin, сдвинутые за время подбора.
+func (b *reviewerBatch) flush(ctx context.Context) error {
+	for teamID := range b.changedCursors {
+		if err := b.Storage.UpdateTeamReviewerCursor(ctx, teamID, b.cursors[teamID]); err != nil {
+			return fmt.Errorf("UpdateTeamReviewerCursor: %w", err)
+		}
+	}
+
+	clear(b.changedCursors)
+
+	return nil
+}
+
+func (b *reviewerBatch) GetOpenReviewsCountByUsers(ctx context.Context, userIDs []string) (map[string]int, error) {
+	missing := lo.Filter(userIDs, func(userID string, _ int) bool {
+		_, ok := b.openReviews[userID]
+		return !ok
+	})
+
+	if len(missing) > 0 {
+		counts, err := b.Storage.GetOpenReviewsCountByUsers(ctx, missing)
+		if err != nil {
+			return nil, err
+		}
+
+		// NOTE: хранилище не возвращает пользователей без ревью, запоминаем им ноль явно
+		for _, userID := range missing {
+			b.openReviews[userID] = counts[userID]
+		}
+	}
+
+	return lo.PickByKeys(b.openReviews, userIDs), nil
+}
+
+func (b *reviewerBatch) GetUsersLastAssignedAt(ctx context.Context, userIDs []string) (map[string]time.Time, error) {
+	missing := lo.Filter(userIDs, func(userID string, _ int) bool {
+		_, ok := b.lastAssignedAt[userID]
+		return !ok
+	})
+
+	if len(missing) > 0 {
+		lastAssignedAt, err := b.Storage.GetUsersLastAssignedAt(ctx, missing)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, userID := range missing {
+			b.lastAssignedAt[userID] = lastAssignedAt[userID]
+		}
+	}
+
+	return lo.PickByKeys(b.lastAssignedAt, userIDs), nil
+}
+
+func (b *reviewerBatch) GetTeamReviewerCursor(ctx context.Context, teamID string) (string, error) {
+	if cursor, ok := b.cursors[teamID]; ok {
+		return cursor, nil
+	}
+
+	cursor, err := b.Storage.GetTeamReviewerCursor(ctx, teamID)
+	if err != nil {
+		return "", err
+	}
+
+	b.cursors[teamID] = cursor
+
+	return cursor, nil
+}
+
+func (b *reviewerBatch) UpdateTeamReviewerCursor(_ context.Context, teamID, userID string) error {
+	b.cursors[teamID] = userID
+	b.changedCursors[teamID] = true
+
+	return nil
+}
+
+func (b *reviewerBatch) GetTeamByName(ctx context.Context, teamName string) (domain.Team, error) {
+	if team, ok := b.teams[teamName]; ok {
+		return team, nil
+	}
+
+	team, err := b.Storage.GetTeamByName(ctx, teamName)
+	if err != nil {
+		return domain.Team{}, err
+	}
+
+	b.teams[teamName] = team
+
+	return team, nil
+}
+
+func (b *reviewerBatch) GetActiveTeamMembers(ctx context.Context, teamID string) ([]domain.User, error) {
+	if members, ok := b.teamMembers[teamID]; ok {
+		return members, nil
+	}
+
+	members, err := b.Storage.GetActiveTeamMembers(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	b.teamMembers[teamID] = members
+
+	return members, nil
+}
+
+func (b *reviewerBatch) GetActiveColleagues(ctx context.Context, userID, teamID string) ([]domain.User, error) {
+	key := [2]string{userID, teamID}
+	if colleagues, ok := b.colleagues[key]; ok {
+		return colleagues, nil
+	}
+
+	colleagues, err := b.Storage.GetActiveColleagues(ctx, userID, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	b.colleagues[key] = colleagues
+
+	return colleagues, nil
+}
+
+func (b *reviewerBatch) GetTeamFallbacks(ctx context.Context, teamID string) ([]domain.Team, error) {
+	if fallbacks, ok := b.fallbacks[teamID]; ok {
+		return fallbacks, nil
+	}
+
+	fallbacks, err := b.Storage.GetTeamFallbacks(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	b.fallbacks[teamID] = fallbacks
+
+	return fallbacks, nil
+}
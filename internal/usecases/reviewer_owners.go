@@ -0,0 +1,123 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"pr-manager-service/internal/domain"
+
+	"github.com/samber/lo"
+)
+
+// selectOwners назначает не более limit владельцев изменённых файлов по правилам команды.
+// Владелец-пользователь назначается сам, от владельца-команды выбирается один участник по стратегии этой команды.
+// Неактивные, отсутствующие, удалённые и достигшие лимита открытых ревью владельцы пропускаются.
+func (u *Usecases) selectOwners(
+	ctx context.Context,
+	s Storage,
+	team domain.Team,
+	changedFiles []string,
+	authorID string,
+	limit int,
+) ([]domain.ReviewerAssignment, error) {
+	assignments := []domain.ReviewerAssignment{}
+	if len(changedFiles) == 0 || limit <= 0 {
+		return assignments, nil
+	}
+
+	rules, err := s.GetTeamOwnerRules(ctx, team.ID)
+	if err != nil {
+		return nil, fmt.Errorf("GetTeamOwnerRules: %w", err)
+	}
+
+	owners, err := domain.MatchOwners(rules, changedFiles)
+	if err != nil {
+		return nil, fmt.Errorf("MatchOwners: %w", err)
+	}
+
+	available := func(user domain.User, _ int) bool {
+		return user.IsActive &&
+			user.ID != authorID &&
+			!slices.ContainsFunc(assignments, func(a domain.ReviewerAssignment) bool {
+				return a.UserID == user.ID
+			})
+	}
+
+	for _, owner := range owners {
+		if len(assignments) >= limit {
+			break
+		}
+
+		id, isTeam := domain.ParseOwner(owner)
+
+		var (
+			ownerTeam domain.Team
+			selected  []domain.User
+		)
+
+		if isTeam {
+			ownerTeam, err = s.GetTeamByName(ctx, id)
+			if errors.Is(err, domain.ErrTeamNotFound) {
+				continue
+			}
+			if err != nil {
+				return nil, fmt.Errorf("GetTeamByName: %w", err)
+			}
+
+			members, err := s.GetActiveTeamMembers(ctx, ownerTeam.ID)
+			if err != nil {
+				return nil, fmt.Errorf("GetActiveTeamMembers: %w", err)
+			}
+
+			selected, _, err = u.selectReviewersFromPool(ctx, s, ownerTeam, lo.Filter(members, available), 1)
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			user, err := s.GetUserShort(ctx, id)
+			if errors.Is(err, domain.ErrUserNotFound) {
+				continue
+			}
+			if err != nil {
+				return nil, fmt.Errorf("GetUserShort: %w", err)
+			}
+
+			if !available(user, 0) {
+				continue
+			}
+
+			availableNow, err := u.userAvailableNow(ctx, s, user.ID)
+			if err != nil {
+				return nil, fmt.Errorf("userAvailableNow: %w", err)
+			}
+			if !availableNow {
+				continue
+			}
+
+			selected, err = u.filterReviewersAtCapacity(ctx, s, []domain.User{user})
+			if errors.Is(err, domain.ErrReviewersAtCapacity) {
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+
+			// NOTE: пользователь может состоять в нескольких командах, поэтому назначение записывается
+			// на команду, по правилам которой он выбран
+			ownerTeam = team
+		}
+
+		for _, user := range selected {
+			assignments = append(assignments, domain.ReviewerAssignment{
+				UserID:   user.ID,
+				TeamID:   ownerTeam.ID,
+				TeamName: ownerTeam.Name,
+				IsOwner:  true,
+			})
+		}
+	}
+
+	return assignments, nil
+}
@@ -0,0 +1,117 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"pr-manager-service/internal/domain"
+
+	"github.com/samber/lo"
+)
+
+// assignReviewers дополняет уже выбранных ревьюверов assigned до count кандидатами домашней команды.
+// Если всего выбрано меньше required, недостающие добираются из резервных команд в порядке приоритета.
+// excludeIDs - пользователи, которых нельзя назначать (автор и текущие ревьюверы PR).
+func (u *Usecases) assignReviewers(
+	ctx context.Context,
+	s Storage,
+	team domain.Team,
+	candidates []domain.User,
+	assigned []domain.ReviewerAssignment,
+	count, required int,
+	excludeIDs []string,
+) ([]domain.ReviewerAssignment, error) {
+	assignments := append(make([]domain.ReviewerAssignment, 0, count), assigned...)
+	notAssigned := func(user domain.User, _ int) bool {
+		return !slices.Contains(excludeIDs, user.ID) &&
+			!slices.ContainsFunc(assignments, func(a domain.ReviewerAssignment) bool {
+				return a.UserID == user.ID
+			})
+	}
+
+	if len(assignments) >= count {
+		return assignments, nil
+	}
+
+	selected, atCapacity, err := u.selectReviewersFromPool(
+		ctx, s, team, lo.Filter(candidates, notAssigned), count-len(assignments),
+	)
+	if err != nil {
+		return nil, err
+	}
+	assignments = append(assignments, reviewerAssignments(selected, team, false)...)
+
+	if len(assignments) < required {
+		fallbacks, err := s.GetTeamFallbacks(ctx, team.ID)
+		if err != nil {
+			return nil, fmt.Errorf("GetTeamFallbacks: %w", err)
+		}
+
+		for _, fallback := range fallbacks {
+			if len(assignments) >= required {
+				break
+			}
+
+			members, err := s.GetActiveTeamMembers(ctx, fallback.ID)
+			if err != nil {
+				return nil, fmt.Errorf("GetActiveTeamMembers: %w", err)
+			}
+
+			// NOTE: пользователь может состоять в нескольких пулах, поэтому исключаем уже выбранных
+			selected, fallbackAtCapacity, err := u.selectReviewersFromPool(
+				ctx, s, fallback, lo.Filter(members, notAssigned), required-len(assignments),
+			)
+			if err != nil {
+				return nil, err
+			}
+			atCapacity = atCapacity || fallbackAtCapacity
+
+			assignments = append(assignments, reviewerAssignments(selected, fallback, true)...)
+		}
+	}
+
+	if len(assignments) == 0 && atCapacity {
+		return nil, domain.ErrReviewersAtCapacity
+	}
+
+	return assignments, nil
+}
+
+// selectReviewersFromPool выбирает ревьюверов из одной команды.
+// Если у всех кандидатов команды достигнут лимит открытых ревью, возвращает пустой список и atCapacity.
+func (u *Usecases) selectReviewersFromPool(
+	ctx context.Context,
+	s Storage,
+	team domain.Team,
+	candidates []domain.User,
+	count int,
+) (selected []domain.User, atCapacity bool, err error) {
+	selected, err = u.selectReviewers(ctx, s, team, candidates, count)
+	if errors.Is(err, domain.ErrReviewersAtCapacity) {
+		return []domain.User{}, true, nil
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("selectReviewers: %w", err)
+	}
+
+	return selected, false, nil
+}
+
+func reviewerAssignments(users []domain.User, team domain.Team, isFallback bool) []domain.ReviewerAssignment {
+	return lo.Map(users, func(user domain.User, _ int) domain.ReviewerAssignment {
+		return domain.ReviewerAssignment{
+			UserID:     user.ID,
+			TeamID:     team.ID,
+			TeamName:   team.Name,
+			IsFallback: isFallback,
+		}
+	})
+}
+
+func assignmentsUsersIDs(assignments []domain.ReviewerAssignment) []string {
+	return lo.Map(assignments, func(a domain.ReviewerAssignment, _ int) string {
+		return a.UserID
+	})
+}
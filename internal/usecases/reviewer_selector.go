@@ -0,0 +1,287 @@
+package usecases
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"pr-manager-service/internal/domain"
+
+	"github.com/samber/lo"
+	"github.com/samber/lo/mutable"
+)
+
+// ReviewerSelector выбирает не более count ревьюверов из кандидатов.
+// Кандидаты уже отфильтрованы: в них нет автора PR, неактивных и уже назначенных пользователей.
+// Storage передаётся из текущей транзакции, чтобы стратегия могла читать и обновлять своё состояние.
+type ReviewerSelector interface {
+	Select(
+		ctx context.Context,
+		s Storage,
+		team domain.Team,
+		candidates []domain.User,
+		count int,
+	) ([]domain.User, error)
+}
+
+func defaultReviewerSelectors() map[domain.ReviewerStrategy]ReviewerSelector {
+	return map[domain.ReviewerStrategy]ReviewerSelector{
+		domain.ReviewerStrategyRandom:                NewRandomSelector(),
+		domain.ReviewerStrategyRoundRobin:            NewRoundRobinSelector(),
+		domain.ReviewerStrategyLeastLoaded:           NewLeastLoadedSelector(),
+		domain.ReviewerStrategyLeastRecentlyAssigned: NewLeastRecentlyAssignedSelector(),
+	}
+}
+
+// RandomSelector выбирает ревьюверов случайным образом.
+type RandomSelector struct {
+	shuffle func([]domain.User)
+}
+
+func NewRandomSelector() *RandomSelector {
+	return &RandomSelector{
+		shuffle: mutable.Shuffle[domain.User, []domain.User],
+	}
+}
+
+func (r *RandomSelector) Select(
+	_ context.Context,
+	_ Storage,
+	_ domain.Team,
+	candidates []domain.User,
+	count int,
+) ([]domain.User, error) {
+	shuffled := slices.Clone(candidates)
+	r.shuffle(shuffled)
+
+	return firstN(shuffled, count), nil
+}
+
+// RoundRobinSelector выбирает ревьюверов по кругу в порядке их идентификаторов.
+// Позиция последнего назначенного хранится у команды, поэтому очередь общая для всех PR команды.
+type RoundRobinSelector struct{}
+
+func NewRoundRobinSelector() *RoundRobinSelector {
+	return &RoundRobinSelector{}
+}
+
+func (r *RoundRobinSelector) Select(
+	ctx context.Context,
+	s Storage,
+	team domain.Team,
+	candidates []domain.User,
+	count int,
+) ([]domain.User, error) {
+	if len(candidates) == 0 || count <= 0 {
+		return []domain.User{}, nil
+	}
+
+	cursor, err := s.GetTeamReviewerCursor(ctx, team.ID)
+	if err != nil {
+		return nil, fmt.Errorf("GetTeamReviewerCursor: %w", err)
+	}
+
+	sorted := slices.SortedFunc(slices.Values(candidates), compareUsersByID)
+
+	// NOTE: начинаем с первого кандидата после курсора; если такого нет, идём на новый круг
+	start, _ := slices.BinarySearchFunc(sorted, cursor, func(u domain.User, cursor string) int {
+		if u.ID <= cursor {
+			return -1
+		}
+		return 1
+	})
+
+	selected := make([]domain.User, 0, min(count, len(sorted)))
+	for i := range cap(selected) {
+		selected = append(selected, sorted[(start+i)%len(sorted)])
+	}
+
+	if err := s.UpdateTeamReviewerCursor(ctx, team.ID, selected[len(selected)-1].ID); err != nil {
+		return nil, fmt.Errorf("UpdateTeamReviewerCursor: %w", err)
+	}
+
+	return selected, nil
+}
+
+// LeastLoadedSelector выбирает ревьюверов с наименьшим числом открытых ревью.
+// При равной нагрузке выбор случайный.
+type LeastLoadedSelector struct {
+	shuffle func([]domain.User)
+}
+
+func NewLeastLoadedSelector() *LeastLoadedSelector {
+	return &LeastLoadedSelector{
+		shuffle: mutable.Shuffle[domain.User, []domain.User],
+	}
+}
+
+func (l *LeastLoadedSelector) Select(
+	ctx context.Context,
+	s Storage,
+	_ domain.Team,
+	candidates []domain.User,
+	count int,
+) ([]domain.User, error) {
+	if len(candidates) == 0 || count <= 0 {
+		return []domain.User{}, nil
+	}
+
+	openReviews, err := s.GetOpenReviewsCountByUsers(ctx, usersIDs(candidates))
+	if err != nil {
+		return nil, fmt.Errorf("GetOpenReviewsCountByUsers: %w", err)
+	}
+
+	// NOTE: перемешивание перед стабильной сортировкой даёт случайный порядок среди равных по нагрузке
+	sorted := slices.Clone(candidates)
+	l.shuffle(sorted)
+	slices.SortStableFunc(sorted, func(a, b domain.User) int {
+		return cmp.Compare(openReviews[a.ID], openReviews[b.ID])
+	})
+
+	return firstN(sorted, count), nil
+}
+
+// LeastRecentlyAssignedSelector выбирает ревьюверов, которых назначали давнее всего.
+// Пользователи, которых ещё ни разу не назначали, идут первыми.
+type LeastRecentlyAssignedSelector struct{}
+
+func NewLeastRecentlyAssignedSelector() *LeastRecentlyAssignedSelector {
+	return &LeastRecentlyAssignedSelector{}
+}
+
+func (l *LeastRecentlyAssignedSelector) Select(
+	ctx context.Context,
+	s Storage,
+	_ domain.Team,
+	candidates []domain.User,
+	count int,
+) ([]domain.User, error) {
+	if len(candidates) == 0 || count <= 0 {
+		return []domain.User{}, nil
+	}
+
+	lastAssignedAt, err := s.GetUsersLastAssignedAt(ctx, usersIDs(candidates))
+	if err != nil {
+		return nil, fmt.Errorf("GetUsersLastAssignedAt: %w", err)
+	}
+
+	// NOTE: у ни разу не назначенных нулевое время, поэтому они оказываются в начале
+	sorted := slices.SortedFunc(slices.Values(candidates), func(a, b domain.User) int {
+		return cmp.Or(
+			lastAssignedAt[a.ID].Compare(lastAssignedAt[b.ID]),
+			compareUsersByID(a, b),
+		)
+	})
+
+	return firstN(sorted, count), nil
+}
+
+func (u *Usecases) reviewerSelector(team domain.Team) (ReviewerSelector, error) {
+	strategy := team.ReviewerStrategy
+	if strategy == "" {
+		strategy = u.defaultReviewerStrategy
+	}
+
+	selector, ok := u.reviewerSelectors[strategy]
+	if !ok {
+		return nil, fmt.Errorf("unknown reviewer strategy %q", strategy)
+	}
+
+	return selector, nil
+}
+
+// selectReviewers выбирает ревьюверов стратегией команды. В командах с PreferWorkingHours
+// кандидаты вне рабочих часов выбираются, только если остальных не хватает.
+func (u *Usecases) selectReviewers(
+	ctx context.Context,
+	s Storage,
+	team domain.Team,
+	candidates []domain.User,
+	count int,
+) ([]domain.User, error) {
+	selector, err := u.reviewerSelector(team)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates, err = u.filterReviewersAtCapacity(ctx, s, candidates)
+	if err != nil {
+		return nil, err
+	}
+
+	if !team.PreferWorkingHours {
+		reviewers, err := selector.Select(ctx, s, team, candidates, count)
+		if err != nil {
+			return nil, fmt.Errorf("selector.Select: %w", err)
+		}
+
+		return reviewers, nil
+	}
+
+	// NOTE: сначала выбираем среди тех, у кого сейчас рабочее время, остальные только добирают недостающих
+	now := u.clock.Now()
+	inHours, offHours := lo.FilterReject(candidates, func(user domain.User, _ int) bool {
+		return user.InWorkingHours(now)
+	})
+
+	// NOTE: стратегия вызывается один раз, чтобы состояние (курсор round-robin) сдвигалось на одно назначение.
+	// Если кандидатов в рабочие часы не хватает, они берутся все, а стратегия добирает остальных.
+	preselected, pool := []domain.User{}, inHours
+	if len(inHours) < count {
+		preselected, pool = inHours, offHours
+	}
+
+	if len(pool) == 0 {
+		return preselected, nil
+	}
+
+	selected, err := selector.Select(ctx, s, team, pool, count-len(preselected))
+	if err != nil {
+		return nil, fmt.Errorf("selector.Select: %w", err)
+	}
+
+	return append(slices.Clone(preselected), selected...), nil
+}
+
+// filterReviewersAtCapacity убирает кандидатов, у которых достигнут лимит открытых ревью.
+// Если лимит достигнут у всех кандидатов, возвращается domain.ErrReviewersAtCapacity.
+func (u *Usecases) filterReviewersAtCapacity(
+	ctx context.Context,
+	s Storage,
+	candidates []domain.User,
+) ([]domain.User, error) {
+	if u.maxOpenReviewsPerUser <= 0 || len(candidates) == 0 {
+		return candidates, nil
+	}
+
+	openReviews, err := s.GetOpenReviewsCountByUsers(ctx, usersIDs(candidates))
+	if err != nil {
+		return nil, fmt.Errorf("GetOpenReviewsCountByUsers: %w", err)
+	}
+
+	available := lo.Filter(candidates, func(candidate domain.User, _ int) bool {
+		return openReviews[candidate.ID] < u.maxOpenReviewsPerUser
+	})
+
+	if len(available) == 0 {
+		return nil, domain.ErrReviewersAtCapacity
+	}
+
+	return available, nil
+}
+
+func usersIDs(users []domain.User) []string {
+	return lo.Map(users, func(u domain.User, _ int) string {
+		return u.ID
+	})
+}
+
+func compareUsersByID(a, b domain.User) int {
+	return strings.Compare(a.ID, b.ID)
+}
+
+func firstN[T any](elements []T, count int) []T {
+	return elements[:max(0, min(count, len(elements)))]
+}
@@ -0,0 +1,492 @@
+package usecases
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"pr-manager-service/internal/clock"
+	"pr-manager-service/internal/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	selectorTeamID = "300"
+
+	selectorUserID1 = "101"
+	selectorUserID2 = "102"
+	selectorUserID3 = "103"
+	selectorUserID4 = "104"
+)
+
+func selectorCandidates(ids ...string) []domain.User {
+	users := make([]domain.User, 0, len(ids))
+	for _, id := range ids {
+		users = append(users, domain.User{
+			ID:       id,
+			IsActive: true,
+		})
+	}
+	return users
+}
+
+func TestRandomSelector_Select(t *testing.T) {
+	testCases := []struct {
+		name       string
+		candidates []domain.User
+		count      int
+		expect     []domain.User
+	}{
+		{
+			name:       "less_than_candidates",
+			candidates: selectorCandidates(selectorUserID1, selectorUserID2, selectorUserID3),
+			count:      2,
+			expect:     selectorCandidates(selectorUserID3, selectorUserID2),
+		},
+		{
+			name:       "more_than_candidates",
+			candidates: selectorCandidates(selectorUserID1, selectorUserID2),
+			count:      3,
+			expect:     selectorCandidates(selectorUserID2, selectorUserID1),
+		},
+		{
+			name:       "no_candidates",
+			candidates: []domain.User{},
+			count:      2,
+			expect:     []domain.User{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			selector := &RandomSelector{
+				shuffle: slices.Reverse[[]domain.User],
+			}
+			candidates := slices.Clone(tc.candidates)
+
+			got, err := selector.Select(context.Background(), nil, domain.Team{ID: selectorTeamID}, candidates, tc.count)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expect, got)
+			assert.Equal(t, tc.candidates, candidates)
+		})
+	}
+}
+
+func TestRoundRobinSelector_Select(t *testing.T) {
+	testCases := []struct {
+		name        string
+		candidates  []domain.User
+		count       int
+		cursor      string
+		expect      []domain.User
+		expectSaved string
+	}{
+		{
+			name:        "empty_cursor",
+			candidates:  selectorCandidates(selectorUserID3, selectorUserID1, selectorUserID2),
+			count:       2,
+			cursor:      "",
+			expect:      selectorCandidates(selectorUserID1, selectorUserID2),
+			expectSaved: selectorUserID2,
+		},
+		{
+			name:        "wrap_around",
+			candidates:  selectorCandidates(selectorUserID1, selectorUserID2, selectorUserID3),
+			count:       2,
+			cursor:      selectorUserID2,
+			expect:      selectorCandidates(selectorUserID3, selectorUserID1),
+			expectSaved: selectorUserID1,
+		},
+		{
+			name:        "cursor_on_last",
+			candidates:  selectorCandidates(selectorUserID1, selectorUserID2, selectorUserID3),
+			count:       1,
+			cursor:      selectorUserID3,
+			expect:      selectorCandidates(selectorUserID1),
+			expectSaved: selectorUserID1,
+		},
+		{
+			name:        "cursor_user_not_candidate",
+			candidates:  selectorCandidates(selectorUserID1, selectorUserID2, selectorUserID4),
+			count:       2,
+			cursor:      selectorUserID3,
+			expect:      selectorCandidates(selectorUserID4, selectorUserID1),
+			expectSaved: selectorUserID1,
+		},
+		{
+			name:        "more_than_candidates",
+			candidates:  selectorCandidates(selectorUserID1, selectorUserID2),
+			count:       3,
+			cursor:      selectorUserID1,
+			expect:      selectorCandidates(selectorUserID2, selectorUserID1),
+			expectSaved: selectorUserID1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			storageMock := NewMockStorage(ctrl)
+
+			storageMock.EXPECT().
+				GetTeamReviewerCursor(gomock.Any(), selectorTeamID).
+				Return(tc.cursor, nil)
+
+			storageMock.EXPECT().
+				UpdateTeamReviewerCursor(gomock.Any(), selectorTeamID, tc.expectSaved).
+				Return(nil)
+
+			got, err := NewRoundRobinSelector().Select(
+				context.Background(),
+				storageMock,
+				domain.Team{ID: selectorTeamID},
+				tc.candidates,
+				tc.count,
+			)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expect, got)
+		})
+	}
+
+	t.Run("no_candidates", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		storageMock := NewMockStorage(ctrl)
+
+		got, err := NewRoundRobinSelector().Select(
+			context.Background(),
+			storageMock,
+			domain.Team{ID: selectorTeamID},
+			[]domain.User{},
+			2,
+		)
+		require.NoError(t, err)
+		assert.Empty(t, got)
+	})
+}
+
+func TestLeastLoadedSelector_Select(t *testing.T) {
+	testCases := []struct {
+		name        string
+		candidates  []domain.User
+		count       int
+		openReviews map[string]int
+		expect      []domain.User
+	}{
+		{
+			name:       "fewest_open_reviews_first",
+			candidates: selectorCandidates(selectorUserID1, selectorUserID2, selectorUserID3),
+			count:      2,
+			openReviews: map[string]int{
+				selectorUserID1: 5,
+				selectorUserID2: 1,
+				selectorUserID3: 3,
+			},
+			expect: selectorCandidates(selectorUserID2, selectorUserID3),
+		},
+		{
+			name:       "without_reviews_first",
+			candidates: selectorCandidates(selectorUserID1, selectorUserID2, selectorUserID3),
+			count:      1,
+			openReviews: map[string]int{
+				selectorUserID1: 1,
+				selectorUserID3: 2,
+			},
+			expect: selectorCandidates(selectorUserID2),
+		},
+		{
+			name:       "ties_in_shuffled_order",
+			candidates: selectorCandidates(selectorUserID1, selectorUserID2, selectorUserID3, selectorUserID4),
+			count:      2,
+			openReviews: map[string]int{
+				selectorUserID4: 1,
+			},
+			expect: selectorCandidates(selectorUserID3, selectorUserID2),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			storageMock := NewMockStorage(ctrl)
+
+			storageMock.EXPECT().
+				GetOpenReviewsCountByUsers(gomock.Any(), gomock.InAnyOrder(usersIDs(tc.candidates))).
+				Return(tc.openReviews, nil)
+
+			selector := &LeastLoadedSelector{
+				shuffle: slices.Reverse[[]domain.User],
+			}
+
+			got, err := selector.Select(
+				context.Background(),
+				storageMock,
+				domain.Team{ID: selectorTeamID},
+				tc.candidates,
+				tc.count,
+			)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expect, got)
+		})
+	}
+}
+
+func TestLeastRecentlyAssignedSelector_Select(t *testing.T) {
+	timeNow := time.Date(2025, 11, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name           string
+		candidates     []domain.User
+		count          int
+		lastAssignedAt map[string]time.Time
+		expect         []domain.User
+	}{
+		{
+			name:       "oldest_first",
+			candidates: selectorCandidates(selectorUserID1, selectorUserID2, selectorUserID3),
+			count:      2,
+			lastAssignedAt: map[string]time.Time{
+				selectorUserID1: timeNow,
+				selectorUserID2: timeNow.Add(-time.Hour),
+				selectorUserID3: timeNow.Add(-2 * time.Hour),
+			},
+			expect: selectorCandidates(selectorUserID3, selectorUserID2),
+		},
+		{
+			name:       "never_assigned_first",
+			candidates: selectorCandidates(selectorUserID1, selectorUserID2, selectorUserID3),
+			count:      2,
+			lastAssignedAt: map[string]time.Time{
+				selectorUserID1: timeNow.Add(-time.Hour),
+				selectorUserID2: timeNow,
+			},
+			expect: selectorCandidates(selectorUserID3, selectorUserID1),
+		},
+		{
+			name:           "ties_by_id",
+			candidates:     selectorCandidates(selectorUserID2, selectorUserID3, selectorUserID1),
+			count:          3,
+			lastAssignedAt: map[string]time.Time{},
+			expect:         selectorCandidates(selectorUserID1, selectorUserID2, selectorUserID3),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			storageMock := NewMockStorage(ctrl)
+
+			storageMock.EXPECT().
+				GetUsersLastAssignedAt(gomock.Any(), gomock.InAnyOrder(usersIDs(tc.candidates))).
+				Return(tc.lastAssignedAt, nil)
+
+			got, err := NewLeastRecentlyAssignedSelector().Select(
+				context.Background(),
+				storageMock,
+				domain.Team{ID: selectorTeamID},
+				tc.candidates,
+				tc.count,
+			)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expect, got)
+		})
+	}
+}
+
+func TestUsecases_selectReviewers(t *testing.T) {
+	candidates := selectorCandidates(selectorUserID1, selectorUserID2, selectorUserID3)
+
+	t.Run("team_strategy", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		storageMock := NewMockStorage(ctrl)
+
+		storageMock.EXPECT().
+			GetOpenReviewsCountByUsers(gomock.Any(), gomock.Any()).
+			Return(map[string]int{selectorUserID1: 1}, nil)
+
+		u := NewUsecases(
+			storageMock,
+			WithDefaultReviewerStrategy(domain.ReviewerStrategyRoundRobin),
+			WithReviewerSelector(domain.ReviewerStrategyLeastLoaded, &LeastLoadedSelector{
+				shuffle: func([]domain.User) {},
+			}),
+		)
+
+		got, err := u.selectReviewers(context.Background(), storageMock, domain.Team{
+			ID:               selectorTeamID,
+			ReviewerStrategy: domain.ReviewerStrategyLeastLoaded,
+		}, candidates, 2)
+		require.NoError(t, err)
+		assert.Equal(t, selectorCandidates(selectorUserID2, selectorUserID3), got)
+	})
+
+	t.Run("default_strategy", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		storageMock := NewMockStorage(ctrl)
+
+		storageMock.EXPECT().
+			GetUsersLastAssignedAt(gomock.Any(), gomock.Any()).
+			Return(map[string]time.Time{selectorUserID1: time.Now()}, nil)
+
+		u := NewUsecases(storageMock, WithDefaultReviewerStrategy(domain.ReviewerStrategyLeastRecentlyAssigned))
+
+		got, err := u.selectReviewers(context.Background(), storageMock, domain.Team{ID: selectorTeamID}, candidates, 1)
+		require.NoError(t, err)
+		assert.Equal(t, selectorCandidates(selectorUserID2), got)
+	})
+
+	t.Run("custom_selector", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		storageMock := NewMockStorage(ctrl)
+
+		u := NewUsecases(
+			storageMock,
+			WithReviewerSelector(domain.ReviewerStrategyRandom, &RandomSelector{
+				shuffle: slices.Reverse[[]domain.User],
+			}),
+		)
+
+		got, err := u.selectReviewers(context.Background(), storageMock, domain.Team{ID: selectorTeamID}, candidates, 2)
+		require.NoError(t, err)
+		assert.Equal(t, selectorCandidates(selectorUserID3, selectorUserID2), got)
+	})
+
+	t.Run("skip_at_capacity", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		storageMock := NewMockStorage(ctrl)
+
+		storageMock.EXPECT().
+			GetOpenReviewsCountByUsers(gomock.Any(), gomock.InAnyOrder(usersIDs(candidates))).
+			Return(map[string]int{
+				selectorUserID1: 3,
+				selectorUserID2: 2,
+				selectorUserID3: 4,
+			}, nil)
+
+		u := NewUsecases(
+			storageMock,
+			WithMaxOpenReviewsPerUser(3),
+			WithReviewerSelector(domain.ReviewerStrategyRandom, &RandomSelector{
+				shuffle: slices.Reverse[[]domain.User],
+			}),
+		)
+
+		got, err := u.selectReviewers(context.Background(), storageMock, domain.Team{ID: selectorTeamID}, candidates, 2)
+		require.NoError(t, err)
+		assert.Equal(t, selectorCandidates(selectorUserID2), got)
+	})
+
+	t.Run("all_at_capacity", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		storageMock := NewMockStorage(ctrl)
+
+		storageMock.EXPECT().
+			GetOpenReviewsCountByUsers(gomock.Any(), gomock.InAnyOrder(usersIDs(candidates))).
+			Return(map[string]int{
+				selectorUserID1: 3,
+				selectorUserID2: 3,
+				selectorUserID3: 5,
+			}, nil)
+
+		u := NewUsecases(storageMock, WithMaxOpenReviewsPerUser(3))
+
+		_, err := u.selectReviewers(context.Background(), storageMock, domain.Team{ID: selectorTeamID}, candidates, 2)
+		require.ErrorIs(t, err, domain.ErrReviewersAtCapacity)
+	})
+
+	t.Run("no_candidates_with_capacity", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		storageMock := NewMockStorage(ctrl)
+
+		u := NewUsecases(storageMock, WithMaxOpenReviewsPerUser(3))
+
+		got, err := u.selectReviewers(context.Background(), storageMock, domain.Team{ID: selectorTeamID}, []domain.User{}, 2)
+		require.NoError(t, err)
+		assert.Empty(t, got)
+	})
+
+	t.Run("prefer_working_hours", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		storageMock := NewMockStorage(ctrl)
+
+		// NOTE: сейчас 20:00 UTC - у первого кандидата рабочий день закончился, у второго идёт ночная смена
+		now := time.Date(2025, time.November, 3, 20, 0, 0, 0, time.UTC)
+		candidates := []domain.User{
+			{ID: selectorUserID1, Timezone: "UTC", WorkingHours: &domain.WorkingHours{StartMinute: 9 * 60, EndMinute: 18 * 60}},
+			{ID: selectorUserID2, Timezone: "UTC", WorkingHours: &domain.WorkingHours{StartMinute: 19 * 60, EndMinute: 3 * 60}},
+			{ID: selectorUserID3, Timezone: "Asia/Tokyo", WorkingHours: &domain.WorkingHours{StartMinute: 9 * 60, EndMinute: 18 * 60}},
+		}
+
+		u := NewUsecases(
+			storageMock,
+			WithClock(clock.NewFake(now)),
+			WithReviewerSelector(domain.ReviewerStrategyRandom, &RandomSelector{
+				shuffle: func([]domain.User) {},
+			}),
+		)
+
+		team := domain.Team{ID: selectorTeamID, PreferWorkingHours: true}
+
+		got, err := u.selectReviewers(context.Background(), storageMock, team, candidates, 1)
+		require.NoError(t, err)
+		assert.Equal(t, []domain.User{candidates[1]}, got)
+
+		// NOTE: кандидатов в рабочие часы не хватает, остальные добираются из тех, у кого рабочий день закончился
+		got, err = u.selectReviewers(context.Background(), storageMock, team, candidates, 3)
+		require.NoError(t, err)
+		assert.Equal(t, []domain.User{candidates[1], candidates[0], candidates[2]}, got)
+
+		// NOTE: без настройки команды рабочие часы не учитываются
+		got, err = u.selectReviewers(context.Background(), storageMock, domain.Team{ID: selectorTeamID}, candidates, 1)
+		require.NoError(t, err)
+		assert.Equal(t, []domain.User{candidates[0]}, got)
+	})
+
+	t.Run("prefer_working_hours_round_robin", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		storageMock := NewMockStorage(ctrl)
+
+		now := time.Date(2025, time.November, 3, 20, 0, 0, 0, time.UTC)
+		candidates := []domain.User{
+			{ID: selectorUserID1, Timezone: "UTC", WorkingHours: &domain.WorkingHours{StartMinute: 9 * 60, EndMinute: 18 * 60}},
+			{ID: selectorUserID2, Timezone: "UTC", WorkingHours: &domain.WorkingHours{StartMinute: 19 * 60, EndMinute: 3 * 60}},
+			{ID: selectorUserID3, Timezone: "UTC", WorkingHours: &domain.WorkingHours{StartMinute: 9 * 60, EndMinute: 18 * 60}},
+		}
+
+		// NOTE: курсор читается и сдвигается один раз, хотя кандидаты добираются из двух групп
+		storageMock.EXPECT().
+			GetTeamReviewerCursor(gomock.Any(), selectorTeamID).
+			Return(selectorUserID1, nil)
+		storageMock.EXPECT().
+			UpdateTeamReviewerCursor(gomock.Any(), selectorTeamID, selectorUserID3).
+			Return(nil)
+
+		u := NewUsecases(storageMock, WithClock(clock.NewFake(now)))
+
+		team := domain.Team{
+			ID:                 selectorTeamID,
+			ReviewerStrategy:   domain.ReviewerStrategyRoundRobin,
+			PreferWorkingHours: true,
+		}
+
+		got, err := u.selectReviewers(context.Background(), storageMock, team, candidates, 2)
+		require.NoError(t, err)
+		assert.Equal(t, []domain.User{candidates[1], candidates[2]}, got)
+	})
+
+	t.Run("unknown_strategy", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		storageMock := NewMockStorage(ctrl)
+
+		u := NewUsecases(storageMock)
+
+		_, err := u.selectReviewers(context.Background(), storageMock, domain.Team{
+			ID:               selectorTeamID,
+			ReviewerStrategy: "unknown",
+		}, candidates, 2)
+		require.Error(t, err)
+	})
+}
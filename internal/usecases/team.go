@@ -10,28 +10,79 @@ import (
 	"github.com/samber/lo"
 )
 
-func (u *Usecases) CreateTeam(ctx context.Context, request domain.CreateTeamRequest) error {
+// CreateTeam создаёт команду с участниками и возвращает её в сохранённом виде, с настройками по умолчанию.
+func (u *Usecases) CreateTeam(ctx context.Context, request domain.CreateTeamRequest) (domain.Team, []domain.User, error) {
 	if err := u.storage.UnitOfWork(ctx, func(s Storage) error {
 		teamID := uuid.NewString()
 
-		if err := u.storage.CreateTeam(ctx, request, teamID); err != nil {
+		if err := s.CreateTeam(ctx, request, teamID); err != nil {
 			return fmt.Errorf("CreateTeam: %w", err)
 		}
 
-		if err := u.storage.CreateUsers(ctx, request.Members, teamID); err != nil {
+		if err := u.updateExistingUsers(ctx, s, request.Members); err != nil {
+			return fmt.Errorf("updateExistingUsers: %w", err)
+		}
+
+		if err := s.CreateUsers(ctx, request.Members); err != nil {
 			return fmt.Errorf("CreateUsers: %w", err)
 		}
 
+		if err := s.CreateTeamMemberships(ctx, teamID, request.Members); err != nil {
+			return fmt.Errorf("CreateTeamMemberships: %w", err)
+		}
+
 		userIDs := lo.Map(request.Members, func(user domain.CreateUserRequest, _ int) string {
 			return user.ID
 		})
-		if err := u.storage.UserStatsCreateBatch(ctx, userIDs); err != nil {
+		if err := s.UserStatsCreateBatch(ctx, userIDs); err != nil {
 			return fmt.Errorf("UserStatsCreateBatch: %w", err)
 		}
 
+		if err := u.audit(ctx, s, domain.AuditTeamCreate, domain.AuditTargetTeam, request.Name, nil, request); err != nil {
+			return fmt.Errorf("audit: %w", err)
+		}
+
 		return nil
 	}); err != nil {
-		return fmt.Errorf("UnitOfWork: %w", err)
+		return domain.Team{}, nil, fmt.Errorf("UnitOfWork: %w", err)
+	}
+
+	return u.storage.GetTeamFullByName(ctx, request.Name)
+}
+
+// updateExistingUsers применяет к уже существующим участникам новой команды имя и флаг активности из запроса.
+// Флаг активности меняется так же, как в UpdateUserStatus: с проверкой прав, аудитом и заменой в ревью.
+func (u *Usecases) updateExistingUsers(ctx context.Context, s Storage, members []domain.CreateUserRequest) error {
+	requested := lo.KeyBy(members, func(member domain.CreateUserRequest) string {
+		return member.ID
+	})
+
+	existing, err := s.GetUsersByIDs(ctx, lo.Keys(requested))
+	if err != nil {
+		return fmt.Errorf("GetUsersByIDs: %w", err)
+	}
+
+	for _, user := range existing {
+		member := requested[user.ID]
+
+		if member.Name != user.Name {
+			if err := s.UpdateUserName(ctx, user.ID, member.Name); err != nil {
+				return fmt.Errorf("UpdateUserName: %w", err)
+			}
+		}
+
+		if member.IsActive == user.IsActive {
+			continue
+		}
+
+		fullUser, err := s.GetUserFull(ctx, user.ID)
+		if err != nil {
+			return fmt.Errorf("GetUserFull: %w", err)
+		}
+
+		if _, err := u.changeUserStatus(ctx, s, fullUser, member.IsActive); err != nil {
+			return fmt.Errorf("changeUserStatus: %w", err)
+		}
 	}
 
 	return nil
@@ -40,3 +91,183 @@ func (u *Usecases) CreateTeam(ctx context.Context, request domain.CreateTeamRequ
 func (u *Usecases) GetTeamFullByName(ctx context.Context, teamName string) (domain.Team, []domain.User, error) {
 	return u.storage.GetTeamFullByName(ctx, teamName)
 }
+
+// UpdateTeamSettings меняет настройки выбора ревьюверов команды. Незаданные в запросе настройки
+// не меняются; настройки после изменения должны пройти ValidateSettings.
+func (u *Usecases) UpdateTeamSettings(
+	ctx context.Context,
+	request domain.UpdateTeamSettingsRequest,
+) (domain.Team, error) {
+	var team domain.Team
+
+	if err := u.storage.UnitOfWork(ctx, func(s Storage) error {
+		current, err := s.GetTeamByName(ctx, request.TeamName)
+		if err != nil {
+			return fmt.Errorf("GetTeamByName: %w", err)
+		}
+
+		team = request.ApplyTo(current)
+		if err := team.ValidateSettings(); err != nil {
+			return err
+		}
+
+		if err := s.UpdateTeamSettings(ctx, request); err != nil {
+			return fmt.Errorf("UpdateTeamSettings: %w", err)
+		}
+
+		if err := u.audit(
+			ctx,
+			s,
+			domain.AuditTeamUpdateSettings,
+			domain.AuditTargetTeam,
+			team.Name,
+			domain.NewAuditTeamState(current),
+			domain.NewAuditTeamState(team),
+		); err != nil {
+			return fmt.Errorf("audit: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return domain.Team{}, fmt.Errorf("UnitOfWork: %w", err)
+	}
+
+	return team, nil
+}
+
+// SetTeamFallbacks заменяет резервные команды, из которых добираются ревьюверы.
+func (u *Usecases) SetTeamFallbacks(ctx context.Context, request domain.SetTeamFallbacksRequest) ([]domain.Team, error) {
+	var fallbacks []domain.Team
+
+	if err := u.storage.UnitOfWork(ctx, func(s Storage) error {
+		team, err := s.GetTeamByName(ctx, request.TeamName)
+		if err != nil {
+			return fmt.Errorf("GetTeamByName: %w", err)
+		}
+
+		previous, err := s.GetTeamFallbacks(ctx, team.ID)
+		if err != nil {
+			return fmt.Errorf("GetTeamFallbacks: %w", err)
+		}
+
+		fallbacks = make([]domain.Team, 0, len(request.FallbackTeamNames))
+		for _, fallbackTeamName := range request.FallbackTeamNames {
+			fallback, err := s.GetTeamByName(ctx, fallbackTeamName)
+			if err != nil {
+				return fmt.Errorf("GetTeamByName: %w", err)
+			}
+
+			if fallback.ID == team.ID {
+				return domain.ErrSelfFallback
+			}
+
+			fallbacks = append(fallbacks, fallback)
+		}
+
+		if err := s.SetTeamFallbacks(ctx, team.ID, lo.Map(fallbacks, func(t domain.Team, _ int) string {
+			return t.ID
+		})); err != nil {
+			return fmt.Errorf("SetTeamFallbacks: %w", err)
+		}
+
+		if err := u.audit(
+			ctx,
+			s,
+			domain.AuditTeamSetFallbacks,
+			domain.AuditTargetTeam,
+			team.Name,
+			teamsNames(previous),
+			teamsNames(fallbacks),
+		); err != nil {
+			return fmt.Errorf("audit: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("UnitOfWork: %w", err)
+	}
+
+	return fallbacks, nil
+}
+
+func (u *Usecases) GetTeamFallbacks(ctx context.Context, teamID string) ([]domain.Team, error) {
+	return u.storage.GetTeamFallbacks(ctx, teamID)
+}
+
+// SetTeamOwners заменяет правила владения путями команды.
+// Шаблоны, владельцы-пользователи и владельцы-команды проверяются при сохранении.
+func (u *Usecases) SetTeamOwners(ctx context.Context, request domain.SetTeamOwnersRequest) ([]domain.OwnerRule, error) {
+	if err := u.storage.UnitOfWork(ctx, func(s Storage) error {
+		team, err := s.GetTeamByName(ctx, request.TeamName)
+		if err != nil {
+			return fmt.Errorf("GetTeamByName: %w", err)
+		}
+
+		previous, err := s.GetTeamOwnerRules(ctx, team.ID)
+		if err != nil {
+			return fmt.Errorf("GetTeamOwnerRules: %w", err)
+		}
+
+		for _, rule := range request.Rules {
+			if _, err := domain.CompileOwnerPattern(rule.Pattern); err != nil {
+				return fmt.Errorf("CompileOwnerPattern %q: %w", rule.Pattern, err)
+			}
+
+			for _, owner := range rule.Owners {
+				id, isTeam := domain.ParseOwner(owner)
+				if isTeam {
+					if _, err := s.GetTeamByName(ctx, id); err != nil {
+						return fmt.Errorf("GetTeamByName: %w", err)
+					}
+					continue
+				}
+
+				if _, err := s.GetUserShort(ctx, id); err != nil {
+					return fmt.Errorf("GetUserShort: %w", err)
+				}
+			}
+		}
+
+		if err := s.SetTeamOwnerRules(ctx, team.ID, request.Rules); err != nil {
+			return fmt.Errorf("SetTeamOwnerRules: %w", err)
+		}
+
+		if err := u.audit(
+			ctx,
+			s,
+			domain.AuditTeamSetOwners,
+			domain.AuditTargetTeam,
+			team.Name,
+			lo.CoalesceSliceOrEmpty(previous, []domain.OwnerRule{}),
+			lo.CoalesceSliceOrEmpty(request.Rules, []domain.OwnerRule{}),
+		); err != nil {
+			return fmt.Errorf("audit: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("UnitOfWork: %w", err)
+	}
+
+	return request.Rules, nil
+}
+
+func (u *Usecases) GetTeamOwners(ctx context.Context, teamName string) ([]domain.OwnerRule, error) {
+	team, err := u.storage.GetTeamByName(ctx, teamName)
+	if err != nil {
+		return nil, fmt.Errorf("storage.GetTeamByName: %w", err)
+	}
+
+	rules, err := u.storage.GetTeamOwnerRules(ctx, team.ID)
+	if err != nil {
+		return nil, fmt.Errorf("storage.GetTeamOwnerRules: %w", err)
+	}
+
+	return rules, nil
+}
+
+func teamsNames(teams []domain.Team) []string {
+	return lo.Map(teams, func(t domain.Team, _ int) string {
+		return t.Name
+	})
+}
@@ -0,0 +1,252 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"pr-manager-service/internal/domain"
+	"pr-manager-service/internal/reqctx"
+
+	"github.com/samber/lo"
+)
+
+// DeactivateTeamUsers атомарно деактивирует пользователей команды и заменяет их во всех PR
+// в статусах OPEN и REOPENED. Ревью, назначенные из этой команды, получают замену так же, как при
+// ReassignPullRequest (стратегия команды, затем резервные команды), из оставшихся активных участников,
+// которые сейчас не отсутствуют. Ревью, назначенные из других команд, заменяет команда, из которой они назначены.
+func (u *Usecases) DeactivateTeamUsers(
+	ctx context.Context,
+	request domain.DeactivateUsersRequest,
+) (domain.DeactivateUsersResult, error) {
+	var result domain.DeactivateUsersResult
+
+	if err := u.storage.UnitOfWork(ctx, func(s Storage) error {
+		team, members, err := s.GetTeamFullByName(ctx, request.TeamName)
+		if err != nil {
+			return fmt.Errorf("GetTeamFullByName: %w", err)
+		}
+
+		notInTeam := lo.Without(request.UserIDs, usersIDs(members)...)
+		if len(notInTeam) > 0 {
+			return domain.NewErrNotInTeam(request.TeamName, notInTeam)
+		}
+
+		// NOTE: статистика смены статуса растёт только у тех, чей статус действительно изменился
+		result.DeactivatedUserIDs = usersIDs(lo.Filter(members, func(user domain.User, _ int) bool {
+			return user.IsActive && slices.Contains(request.UserIDs, user.ID)
+		}))
+
+		if err := s.UpdateUsersStatus(ctx, result.DeactivatedUserIDs, false); err != nil {
+			return fmt.Errorf("UpdateUsersStatus: %w", err)
+		}
+
+		if err := s.UserStatusChangesIncrementBatch(ctx, result.DeactivatedUserIDs); err != nil {
+			return fmt.Errorf("UserStatusChangesIncrementBatch: %w", err)
+		}
+
+		pullRequests, err := s.GetActivePullRequestsByReviewers(ctx, request.UserIDs)
+		if err != nil {
+			return fmt.Errorf("GetActivePullRequestsByReviewers: %w", err)
+		}
+
+		// NOTE: кандидаты - активные участники команды вне окна отсутствия, как при выборе ревьюверов
+		activeMembers, err := s.GetActiveTeamMembers(ctx, team.ID)
+		if err != nil {
+			return fmt.Errorf("GetActiveTeamMembers: %w", err)
+		}
+
+		candidates := lo.Filter(activeMembers, func(user domain.User, _ int) bool {
+			return !slices.Contains(request.UserIDs, user.ID)
+		})
+
+		batch := newReviewerBatch(s, u.clock.Now())
+
+		distributed, assignments, err := u.distributeReviews(ctx, batch, team, pullRequests, request.UserIDs, candidates)
+		if err != nil {
+			return fmt.Errorf("distributeReviews: %w", err)
+		}
+
+		if err := s.ReplacePullRequestReviewers(
+			ctx,
+			distributed.Reassigned,
+			assignments,
+			domain.AssignmentReasonDeactivation,
+		); err != nil {
+			return fmt.Errorf("ReplacePullRequestReviewers: %w", err)
+		}
+
+		if err := u.emit(
+			ctx,
+			s,
+			reviewerReassignedEvents(distributed.Reassigned, domain.AssignmentReasonDeactivation)...,
+		); err != nil {
+			return fmt.Errorf("emit: %w", err)
+		}
+
+		if err := u.auditTeamDeactivation(
+			ctx,
+			s,
+			result.DeactivatedUserIDs,
+			pullRequests,
+			distributed.Reassigned,
+		); err != nil {
+			return fmt.Errorf("auditTeamDeactivation: %w", err)
+		}
+
+		replaced, err := u.replaceReviewersFromOtherTeams(
+			ctx,
+			batch,
+			team,
+			pullRequests,
+			request.UserIDs,
+			distributed.Reassigned,
+		)
+		if err != nil {
+			return fmt.Errorf("replaceReviewersFromOtherTeams: %w", err)
+		}
+
+		if err := batch.flush(ctx); err != nil {
+			return fmt.Errorf("flush: %w", err)
+		}
+
+		result.Reassigned = append(distributed.Reassigned, replaced.Reassigned...)
+		result.NoCandidate = append(distributed.NoCandidate, replaced.NoCandidate...)
+
+		return nil
+	}); err != nil {
+		return domain.DeactivateUsersResult{}, fmt.Errorf("UnitOfWork: %w", err)
+	}
+
+	return result, nil
+}
+
+// distributeReviews подбирает замену каждому ревьюверу из replacedIDs, назначенному в pullRequests
+// из команды team, так же, как ReassignPullRequest: стратегией команды среди candidates, а если их не хватает -
+// из резервных команд. Ревью, назначенные из других команд, пропускаются. Данные для выбора читаются через batch,
+// поэтому число запросов не зависит от числа PR. Возвращает новые назначения в порядке result.Reassigned.
+func (u *Usecases) distributeReviews(
+	ctx context.Context,
+	batch *reviewerBatch,
+	team domain.Team,
+	pullRequests []domain.PullRequest,
+	replacedIDs []string,
+	candidates []domain.User,
+) (domain.ReassignmentResult, []domain.ReviewerAssignment, error) {
+	result := domain.ReassignmentResult{
+		Reassigned:  []domain.ReviewerReplacement{},
+		NoCandidate: []domain.ReviewerReplacement{},
+	}
+	assignments := []domain.ReviewerAssignment{}
+
+	for _, pr := range pullRequests {
+		excludeIDs := append([]string{pr.AuthorUserID}, pr.ReviewersUsersIDs...)
+
+		for _, oldReviewerID := range pr.ReviewersUsersIDs {
+			if !slices.Contains(replacedIDs, oldReviewerID) || !assignedFromTeam(pr, oldReviewerID, team) {
+				continue
+			}
+
+			replacement := domain.ReviewerReplacement{
+				PullRequestID: pr.ID,
+				OldReviewerID: oldReviewerID,
+			}
+
+			selected, err := u.assignReviewers(ctx, batch, team, candidates, nil, 1, 1, excludeIDs)
+			if errors.Is(err, domain.ErrReviewersAtCapacity) || (err == nil && len(selected) == 0) {
+				result.NoCandidate = append(result.NoCandidate, replacement)
+				continue
+			}
+			if err != nil {
+				return domain.ReassignmentResult{}, nil, fmt.Errorf("assignReviewers: %w", err)
+			}
+
+			assignment := selected[0]
+			assignment.AssignedBy = reqctx.Actor(ctx)
+
+			replacement.NewReviewerID = assignment.UserID
+			batch.assigned(assignment.UserID)
+			excludeIDs = append(excludeIDs, assignment.UserID)
+
+			result.Reassigned = append(result.Reassigned, replacement)
+			assignments = append(assignments, assignment)
+		}
+	}
+
+	return result, assignments, nil
+}
+
+// replaceReviewersFromOtherTeams заменяет ревьюверов из replacedIDs, назначенных в pullRequests не из команды team,
+// через replaceReviewer: замену подбирает команда, из которой назначен ревьювер, и её история назначений
+// не приписывается команде team. reassigned - уже выполненные замены, они учитываются в составе ревьюверов PR.
+// NOTE: такие ревью редки, поэтому каждое заменяется отдельными запросами
+func (u *Usecases) replaceReviewersFromOtherTeams(
+	ctx context.Context,
+	batch *reviewerBatch,
+	team domain.Team,
+	pullRequests []domain.PullRequest,
+	replacedIDs []string,
+	reassigned []domain.ReviewerReplacement,
+) (domain.ReassignmentResult, error) {
+	result := domain.ReassignmentResult{
+		Reassigned:  []domain.ReviewerReplacement{},
+		NoCandidate: []domain.ReviewerReplacement{},
+	}
+
+	for _, pr := range pullRequests {
+		oldReviewersIDs := pr.ReviewersUsersIDs
+		pr.ReviewersUsersIDs = replaceReviewersIDs(pr.ID, oldReviewersIDs, reassigned)
+
+		for _, oldReviewerID := range oldReviewersIDs {
+			if !slices.Contains(replacedIDs, oldReviewerID) || assignedFromTeam(pr, oldReviewerID, team) {
+				continue
+			}
+
+			replacement := domain.ReviewerReplacement{
+				PullRequestID: pr.ID,
+				OldReviewerID: oldReviewerID,
+			}
+
+			newReviewerID, err := u.replaceReviewer(
+				ctx,
+				batch,
+				pr,
+				domain.User{ID: oldReviewerID},
+				domain.AssignmentReasonDeactivation,
+			)
+			if errors.Is(err, domain.ErrNoCandidate) || errors.Is(err, domain.ErrReviewersAtCapacity) {
+				result.NoCandidate = append(result.NoCandidate, replacement)
+				continue
+			}
+			if err != nil {
+				return domain.ReassignmentResult{}, fmt.Errorf("replaceReviewer: %w", err)
+			}
+
+			replacement.NewReviewerID = newReviewerID
+			batch.assigned(newReviewerID)
+
+			pr.ReviewersUsersIDs = replaceReviewersIDs(pr.ID, pr.ReviewersUsersIDs, []domain.ReviewerReplacement{replacement})
+			result.Reassigned = append(result.Reassigned, replacement)
+		}
+	}
+
+	return result, nil
+}
+
+// replaceReviewersIDs возвращает ревьюверов PR prID после замен replacements.
+func replaceReviewersIDs(prID string, reviewersIDs []string, replacements []domain.ReviewerReplacement) []string {
+	replaced := slices.Clone(reviewersIDs)
+
+	for _, replacement := range replacements {
+		if replacement.PullRequestID != prID {
+			continue
+		}
+
+		if i := slices.Index(replaced, replacement.OldReviewerID); i >= 0 {
+			replaced[i] = replacement.NewReviewerID
+		}
+	}
+
+	return replaced
+}
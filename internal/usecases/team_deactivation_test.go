@@ -0,0 +1,236 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+
+	"pr-manager-service/internal/domain"
+	"pr-manager-service/internal/reqctx"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUsecases_DeactivateTeamUsers(t *testing.T) {
+	const (
+		teamID   = "300"
+		teamName = "team1"
+
+		authorID = "100"
+		userID1  = "101"
+		userID2  = "102"
+		userID3  = "103"
+		userID4  = "104"
+	)
+
+	team := domain.Team{ID: teamID, Name: teamName}
+
+	members := []domain.User{
+		{ID: authorID, IsActive: true},
+		{ID: userID1, IsActive: true},
+		{ID: userID2, IsActive: false},
+		{ID: userID3, IsActive: true},
+		{ID: userID4, IsActive: true},
+	}
+
+	mockUnitOfWork := func(ms *MockStorage) {
+		ms.EXPECT().UnitOfWork(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, fn func(s Storage) error) error {
+				return fn(ms)
+			})
+	}
+
+	t.Run("reassigns_with_team_strategy", func(t *testing.T) {
+		const (
+			otherTeamID   = "301"
+			otherTeamName = "team2"
+			otherUserID   = "200"
+		)
+
+		ctrl := gomock.NewController(t)
+		ms := NewMockStorage(ctrl)
+		mockUnitOfWork(ms)
+
+		team := domain.Team{ID: teamID, Name: teamName, ReviewerStrategy: domain.ReviewerStrategyRoundRobin}
+		otherTeam := domain.Team{ID: otherTeamID, Name: otherTeamName, ReviewerStrategy: domain.ReviewerStrategyRoundRobin}
+
+		fromTeam := func(teamName string, reviewersIDs ...string) map[string]domain.ReviewerPool {
+			return lo.SliceToMap(reviewersIDs, func(id string) (string, domain.ReviewerPool) {
+				return id, domain.ReviewerPool{TeamName: teamName}
+			})
+		}
+
+		ms.EXPECT().GetTeamFullByName(gomock.Any(), teamName).Return(team, members, nil)
+
+		// NOTE: userID2 уже неактивен, поэтому статус меняется только у userID1
+		ms.EXPECT().UpdateUsersStatus(gomock.Any(), []string{userID1}, false).Return(nil)
+		ms.EXPECT().UserStatusChangesIncrementBatch(gomock.Any(), []string{userID1}).Return(nil)
+
+		ms.EXPECT().
+			GetActivePullRequestsByReviewers(gomock.Any(), []string{userID1, userID2}).
+			Return([]domain.PullRequest{
+				{
+					ID: "1", AuthorUserID: authorID, TeamID: teamID, Status: domain.StatusOpen,
+					ReviewersUsersIDs: []string{userID1, userID2},
+					ReviewerPools:     fromTeam(teamName, userID1, userID2),
+				},
+				{
+					ID: "2", AuthorUserID: userID3, TeamID: teamID, Status: domain.StatusOpen,
+					ReviewersUsersIDs: []string{userID1},
+					ReviewerPools:     fromTeam(teamName, userID1),
+				},
+				{
+					ID: "3", AuthorUserID: authorID, TeamID: teamID, Status: domain.StatusOpen,
+					ReviewersUsersIDs: []string{userID1, userID3, userID4},
+					ReviewerPools:     fromTeam(teamName, userID1, userID3, userID4),
+				},
+				{
+					ID: "4", AuthorUserID: otherUserID, TeamID: otherTeamID, Status: domain.StatusOpen,
+					ReviewersUsersIDs: []string{userID1},
+					ReviewerPools:     fromTeam(otherTeamName, userID1),
+				},
+			}, nil)
+
+		// NOTE: хранилище отдает только активных участников вне окна отсутствия
+		ms.EXPECT().
+			GetActiveTeamMembers(gomock.Any(), teamID).
+			Return([]domain.User{{ID: authorID}, {ID: userID3}, {ID: userID4}}, nil)
+
+		// NOTE: курсор round-robin читается и сохраняется один раз на команду, а не на каждый PR
+		ms.EXPECT().GetTeamReviewerCursor(gomock.Any(), teamID).Return("", nil)
+		ms.EXPECT().UpdateTeamReviewerCursor(gomock.Any(), teamID, authorID).Return(nil)
+		ms.EXPECT().GetTeamFallbacks(gomock.Any(), teamID).Return([]domain.Team{}, nil)
+
+		expectReassigned := []domain.ReviewerReplacement{
+			{PullRequestID: "1", OldReviewerID: userID1, NewReviewerID: userID3},
+			{PullRequestID: "1", OldReviewerID: userID2, NewReviewerID: userID4},
+			{PullRequestID: "2", OldReviewerID: userID1, NewReviewerID: authorID},
+		}
+
+		assignment := func(userID string) domain.ReviewerAssignment {
+			return domain.ReviewerAssignment{
+				UserID:     userID,
+				TeamID:     teamID,
+				TeamName:   teamName,
+				AssignedBy: domain.AdminActor,
+			}
+		}
+
+		ms.EXPECT().
+			ReplacePullRequestReviewers(
+				gomock.Any(),
+				expectReassigned,
+				[]domain.ReviewerAssignment{assignment(userID3), assignment(userID4), assignment(authorID)},
+				domain.AssignmentReasonDeactivation,
+			).
+			Return(nil)
+
+		ms.EXPECT().
+			CreateOutboxEvents(gomock.Any(), eventsWithData(
+				domain.ReviewerReassignedEvent{
+					PullRequestID: "1", OldReviewerID: userID1, NewReviewerID: userID3,
+					Reason: domain.AssignmentReasonDeactivation,
+				},
+				domain.ReviewerReassignedEvent{
+					PullRequestID: "1", OldReviewerID: userID2, NewReviewerID: userID4,
+					Reason: domain.AssignmentReasonDeactivation,
+				},
+				domain.ReviewerReassignedEvent{
+					PullRequestID: "2", OldReviewerID: userID1, NewReviewerID: authorID,
+					Reason: domain.AssignmentReasonDeactivation,
+				},
+			)).
+			Return(nil)
+
+		ms.EXPECT().
+			CreateAuditEntries(gomock.Any(), auditEntries(
+				expectedAuditEntry{
+					Action:   domain.AuditUserChangeStatus,
+					TargetID: userID1,
+					After:    domain.AuditUserState{IsActive: false},
+				},
+				expectedAuditEntry{
+					Action:   domain.AuditPullRequestReassign,
+					TargetID: "1",
+					After: domain.AuditPullRequestState{
+						AuthorID:    authorID,
+						Status:      domain.ConvertPullRequestStatusToApi(domain.StatusOpen),
+						ReviewerIDs: []string{userID3, userID4},
+					},
+				},
+				expectedAuditEntry{Action: domain.AuditPullRequestReassign, TargetID: "2"},
+			)).
+			Return(nil)
+
+		// NOTE: ревью, назначенное из другой команды, заменяет её участник, и история пишется на неё
+		ms.EXPECT().GetTeamByName(gomock.Any(), otherTeamName).Return(otherTeam, nil)
+		ms.EXPECT().
+			GetActiveColleagues(gomock.Any(), userID1, otherTeamID).
+			Return([]domain.User{{ID: otherUserID}, {ID: "201"}}, nil)
+		ms.EXPECT().GetTeamReviewerCursor(gomock.Any(), otherTeamID).Return("", nil)
+		ms.EXPECT().UpdateTeamReviewerCursor(gomock.Any(), otherTeamID, "201").Return(nil)
+		ms.EXPECT().
+			UnassignPullRequestReviewer(gomock.Any(), "4", userID1, domain.AssignmentReasonDeactivation).
+			Return(nil)
+		ms.EXPECT().
+			AssignPullRequestReviewers(
+				gomock.Any(),
+				"4",
+				[]domain.ReviewerAssignment{{
+					UserID:     "201",
+					TeamID:     otherTeamID,
+					TeamName:   otherTeamName,
+					AssignedBy: domain.AdminActor,
+				}},
+				domain.AssignmentReasonDeactivation,
+			).
+			Return(nil)
+		ms.EXPECT().
+			CreateOutboxEvents(gomock.Any(), eventsWithData(domain.ReviewerReassignedEvent{
+				PullRequestID: "4", OldReviewerID: userID1, NewReviewerID: "201",
+				Reason: domain.AssignmentReasonDeactivation,
+			})).
+			Return(nil)
+		ms.EXPECT().
+			CreateAuditEntries(gomock.Any(), auditEntry(domain.AuditPullRequestReassign, "4", nil)).
+			Return(nil)
+
+		// NOTE: исполнитель из контекста записывается в assigned_by новых назначений
+		ctx := reqctx.WithCaller(context.Background(), domain.Caller{Admin: true})
+
+		u := NewUsecases(ms)
+		result, err := u.DeactivateTeamUsers(ctx, domain.DeactivateUsersRequest{
+			TeamName: teamName,
+			UserIDs:  []string{userID1, userID2},
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{userID1}, result.DeactivatedUserIDs)
+		assert.Equal(t, append(expectReassigned, domain.ReviewerReplacement{
+			PullRequestID: "4", OldReviewerID: userID1, NewReviewerID: "201",
+		}), result.Reassigned)
+		assert.Equal(t, []domain.ReviewerReplacement{
+			{PullRequestID: "3", OldReviewerID: userID1},
+		}, result.NoCandidate)
+	})
+
+	t.Run("not_in_team", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		ms := NewMockStorage(ctrl)
+		mockUnitOfWork(ms)
+
+		ms.EXPECT().GetTeamFullByName(gomock.Any(), teamName).Return(team, members, nil)
+
+		u := NewUsecases(ms)
+		_, err := u.DeactivateTeamUsers(context.Background(), domain.DeactivateUsersRequest{
+			TeamName: teamName,
+			UserIDs:  []string{userID1, "999"},
+		})
+
+		var errNotInTeam domain.ErrNotInTeam
+		require.ErrorAs(t, err, &errNotInTeam)
+		assert.Equal(t, []string{"999"}, errNotInTeam.UserIDs)
+	})
+}
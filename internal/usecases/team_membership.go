@@ -0,0 +1,282 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+
+	"pr-manager-service/internal/domain"
+
+	"github.com/samber/lo"
+)
+
+// AddTeamMembers добавляет участников в существующую команду.
+// Новые пользователи создаются, существующие остаются и в своих прежних командах.
+func (u *Usecases) AddTeamMembers(
+	ctx context.Context,
+	request domain.AddTeamMembersRequest,
+) (domain.Team, []domain.User, error) {
+	userIDs := lo.Map(request.Members, func(user domain.CreateUserRequest, _ int) string {
+		return user.ID
+	})
+
+	if err := u.storage.UnitOfWork(ctx, func(s Storage) error {
+		team, members, err := s.GetTeamFullByName(ctx, request.TeamName)
+		if err != nil {
+			return fmt.Errorf("GetTeamFullByName: %w", err)
+		}
+
+		if alreadyInTeam := lo.Intersect(usersIDs(members), userIDs); len(alreadyInTeam) > 0 {
+			return fmt.Errorf("%w: %s", domain.ErrAlreadyInTeam, alreadyInTeam[0])
+		}
+
+		if err := s.CreateUsers(ctx, request.Members); err != nil {
+			return fmt.Errorf("CreateUsers: %w", err)
+		}
+
+		if err := s.CreateTeamMemberships(ctx, team.ID, request.Members); err != nil {
+			return fmt.Errorf("CreateTeamMemberships: %w", err)
+		}
+
+		if err := s.UserStatsCreateBatch(ctx, userIDs); err != nil {
+			return fmt.Errorf("UserStatsCreateBatch: %w", err)
+		}
+
+		if err := u.audit(ctx, s, domain.AuditTeamAddMembers, domain.AuditTargetTeam, team.Name, nil, request); err != nil {
+			return fmt.Errorf("audit: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return domain.Team{}, nil, fmt.Errorf("UnitOfWork: %w", err)
+	}
+
+	return u.storage.GetTeamFullByName(ctx, request.TeamName)
+}
+
+// RemoveTeamMember удаляет пользователя из команды. Его PR, история назначений и другие команды сохраняются.
+// В PR в статусах OPEN и REOPENED, куда он назначен от этой команды, он заменяется её участниками.
+func (u *Usecases) RemoveTeamMember(
+	ctx context.Context,
+	request domain.RemoveTeamMemberRequest,
+) (domain.User, domain.ReassignmentResult, error) {
+	var result domain.ReassignmentResult
+
+	if err := u.storage.UnitOfWork(ctx, func(s Storage) error {
+		team, err := s.GetTeamByName(ctx, request.TeamName)
+		if err != nil {
+			return fmt.Errorf("GetTeamByName: %w", err)
+		}
+
+		user, err := s.GetUserFull(ctx, request.UserID)
+		if err != nil {
+			return fmt.Errorf("GetUserFull: %w", err)
+		}
+
+		membership, ok := user.Membership(team.ID)
+		if !ok {
+			return domain.NewErrNotInTeam(team.Name, []string{user.ID})
+		}
+
+		result, err = u.replaceReviewerInActivePullRequests(ctx, s, user, team, domain.AssignmentReasonTeamChange)
+		if err != nil {
+			return fmt.Errorf("replaceReviewerInActivePullRequests: %w", err)
+		}
+
+		if err := s.DeleteTeamMembership(ctx, team.ID, user.ID); err != nil {
+			return fmt.Errorf("DeleteTeamMembership: %w", err)
+		}
+
+		if err := u.audit(ctx, s, domain.AuditTeamRemoveMember, domain.AuditTargetUser, user.ID, membership, nil); err != nil {
+			return fmt.Errorf("audit: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return domain.User{}, domain.ReassignmentResult{}, fmt.Errorf("UnitOfWork: %w", err)
+	}
+
+	user, err := u.storage.GetUserFull(ctx, request.UserID)
+	if err != nil {
+		return domain.User{}, domain.ReassignmentResult{}, fmt.Errorf("GetUserFull: %w", err)
+	}
+
+	return user, result, nil
+}
+
+// MoveTeamMember переносит пользователя из одной его команды в другую, остальные его команды не меняются.
+// С ReassignReviews пользователь заменяется в PR в статусах OPEN и REOPENED, куда он назначен от прежней команды,
+// её участниками; иначе ревью остаются за ним.
+func (u *Usecases) MoveTeamMember(
+	ctx context.Context,
+	request domain.MoveTeamMemberRequest,
+) (domain.User, domain.ReassignmentResult, error) {
+	result := domain.ReassignmentResult{
+		Reassigned:  []domain.ReviewerReplacement{},
+		NoCandidate: []domain.ReviewerReplacement{},
+	}
+
+	if err := u.storage.UnitOfWork(ctx, func(s Storage) error {
+		team, err := s.GetTeamByName(ctx, request.TeamName)
+		if err != nil {
+			return fmt.Errorf("GetTeamByName: %w", err)
+		}
+
+		user, err := s.GetUserFull(ctx, request.UserID)
+		if err != nil {
+			return fmt.Errorf("GetUserFull: %w", err)
+		}
+
+		if _, ok := user.Membership(team.ID); ok {
+			return fmt.Errorf("%w: %s", domain.ErrAlreadyInTeam, user.ID)
+		}
+
+		// NOTE: пользователь без команд просто вступает в новую
+		var (
+			membership domain.TeamMembership
+			before     any
+		)
+		if request.FromTeamName != "" || len(user.Teams) > 0 {
+			fromTeam, err := u.resolveUserTeam(ctx, s, user, request.FromTeamName)
+			if err != nil {
+				return fmt.Errorf("resolveUserTeam: %w", err)
+			}
+
+			membership, _ = user.Membership(fromTeam.ID)
+			before = membership
+
+			if request.ReassignReviews {
+				result, err = u.replaceReviewerInActivePullRequests(
+					ctx,
+					s,
+					user,
+					fromTeam,
+					domain.AssignmentReasonTeamChange,
+				)
+				if err != nil {
+					return fmt.Errorf("replaceReviewerInActivePullRequests: %w", err)
+				}
+			}
+
+			if err := s.DeleteTeamMembership(ctx, fromTeam.ID, user.ID); err != nil {
+				return fmt.Errorf("DeleteTeamMembership: %w", err)
+			}
+		}
+
+		if err := s.CreateTeamMemberships(ctx, team.ID, []domain.CreateUserRequest{{
+			ID:   user.ID,
+			Role: membership.Role,
+		}}); err != nil {
+			return fmt.Errorf("CreateTeamMemberships: %w", err)
+		}
+
+		after := domain.TeamMembership{TeamID: team.ID, TeamName: team.Name, Role: membership.Role}
+		if err := u.audit(ctx, s, domain.AuditTeamMoveMember, domain.AuditTargetUser, user.ID, before, after); err != nil {
+			return fmt.Errorf("audit: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return domain.User{}, domain.ReassignmentResult{}, fmt.Errorf("UnitOfWork: %w", err)
+	}
+
+	user, err := u.storage.GetUserFull(ctx, request.UserID)
+	if err != nil {
+		return domain.User{}, domain.ReassignmentResult{}, fmt.Errorf("GetUserFull: %w", err)
+	}
+
+	return user, result, nil
+}
+
+// SetTeamMemberRole меняет роль участника команды.
+func (u *Usecases) SetTeamMemberRole(
+	ctx context.Context,
+	request domain.SetTeamMemberRoleRequest,
+) (domain.Team, []domain.User, error) {
+	if err := u.storage.UnitOfWork(ctx, func(s Storage) error {
+		team, err := s.GetTeamByName(ctx, request.TeamName)
+		if err != nil {
+			return fmt.Errorf("GetTeamByName: %w", err)
+		}
+
+		user, err := s.GetUserFull(ctx, request.UserID)
+		if err != nil {
+			return fmt.Errorf("GetUserFull: %w", err)
+		}
+
+		membership, ok := user.Membership(team.ID)
+		if !ok {
+			return domain.NewErrNotInTeam(team.Name, []string{user.ID})
+		}
+
+		if err := s.UpdateTeamMemberRole(ctx, team.ID, user.ID, request.Role); err != nil {
+			return fmt.Errorf("UpdateTeamMemberRole: %w", err)
+		}
+
+		after := membership
+		after.Role = request.Role
+		if err := u.audit(ctx, s, domain.AuditTeamSetMemberRole, domain.AuditTargetUser, user.ID, membership, after); err != nil {
+			return fmt.Errorf("audit: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return domain.Team{}, nil, fmt.Errorf("UnitOfWork: %w", err)
+	}
+
+	return u.storage.GetTeamFullByName(ctx, request.TeamName)
+}
+
+// RenameTeam меняет название команды. Ссылки на команду в правилах владения путями обновляются.
+func (u *Usecases) RenameTeam(ctx context.Context, request domain.RenameTeamRequest) (domain.Team, []domain.User, error) {
+	if err := u.storage.UnitOfWork(ctx, func(s Storage) error {
+		team, err := s.GetTeamByName(ctx, request.TeamName)
+		if err != nil {
+			return fmt.Errorf("GetTeamByName: %w", err)
+		}
+
+		if err := s.RenameTeam(ctx, team, request.NewTeamName); err != nil {
+			return fmt.Errorf("RenameTeam: %w", err)
+		}
+
+		before := domain.NewAuditTeamState(team)
+		after := before
+		after.Name = request.NewTeamName
+		if err := u.audit(ctx, s, domain.AuditTeamRename, domain.AuditTargetTeam, team.Name, before, after); err != nil {
+			return fmt.Errorf("audit: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return domain.Team{}, nil, fmt.Errorf("UnitOfWork: %w", err)
+	}
+
+	return u.storage.GetTeamFullByName(ctx, request.NewTeamName)
+}
+
+// DeleteTeam удаляет команду без участников.
+func (u *Usecases) DeleteTeam(ctx context.Context, request domain.DeleteTeamRequest) error {
+	if err := u.storage.UnitOfWork(ctx, func(s Storage) error {
+		team, members, err := s.GetTeamFullByName(ctx, request.TeamName)
+		if err != nil {
+			return fmt.Errorf("GetTeamFullByName: %w", err)
+		}
+
+		if len(members) > 0 {
+			return domain.ErrTeamNotEmpty
+		}
+
+		if err := s.DeleteTeam(ctx, team.ID); err != nil {
+			return fmt.Errorf("DeleteTeam: %w", err)
+		}
+
+		if err := u.audit(ctx, s, domain.AuditTeamDelete, domain.AuditTargetTeam, team.Name, domain.NewAuditTeamState(team), nil); err != nil {
+			return fmt.Errorf("audit: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return fmt.Errorf("UnitOfWork: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,329 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+
+	"pr-manager-service/internal/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUsecases_AddTeamMembers(t *testing.T) {
+	const (
+		teamID   = "300"
+		teamName = "team1"
+	)
+
+	team := domain.Team{ID: teamID, Name: teamName}
+
+	members := []domain.CreateUserRequest{
+		{ID: "101", Name: "user1", IsActive: true},
+		{ID: "102", Name: "user2", IsActive: true, Role: domain.TeamRoleLead},
+	}
+
+	testCases := []struct {
+		name        string
+		teamMembers []domain.User
+		expectErr   error
+	}{
+		{
+			name:        "new_users_and_members_of_other_teams",
+			teamMembers: []domain.User{{ID: "103"}},
+		},
+		{
+			name:        "already_in_team",
+			teamMembers: []domain.User{{ID: "102"}},
+			expectErr:   domain.ErrAlreadyInTeam,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			ms := NewMockStorage(ctrl)
+
+			ms.EXPECT().UnitOfWork(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, fn func(s Storage) error) error {
+					return fn(ms)
+				})
+
+			ms.EXPECT().GetTeamFullByName(gomock.Any(), teamName).Return(team, tc.teamMembers, nil)
+
+			if tc.expectErr == nil {
+				ms.EXPECT().CreateUsers(gomock.Any(), members).Return(nil)
+				ms.EXPECT().CreateTeamMemberships(gomock.Any(), teamID, members).Return(nil)
+				ms.EXPECT().UserStatsCreateBatch(gomock.Any(), []string{"101", "102"}).Return(nil)
+				ms.EXPECT().
+					CreateAuditEntries(gomock.Any(), auditEntry(domain.AuditTeamAddMembers, teamName, nil)).
+					Return(nil)
+				ms.EXPECT().GetTeamFullByName(gomock.Any(), teamName).Return(team, []domain.User{}, nil)
+			}
+
+			u := NewUsecases(ms)
+			_, _, err := u.AddTeamMembers(context.Background(), domain.AddTeamMembersRequest{
+				TeamName: teamName,
+				Members:  members,
+			})
+
+			if tc.expectErr != nil {
+				require.ErrorIs(t, err, tc.expectErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestUsecases_MoveTeamMember(t *testing.T) {
+	const (
+		userID        = "101"
+		oldTeamID     = "300"
+		oldTeamName   = "team1"
+		newTeamID     = "301"
+		newTeamName   = "team2"
+		thirdTeamID   = "302"
+		thirdTeamName = "team3"
+	)
+
+	oldTeam := domain.Team{ID: oldTeamID, Name: oldTeamName}
+	newTeam := domain.Team{ID: newTeamID, Name: newTeamName}
+
+	user := domain.User{
+		ID:       userID,
+		IsActive: true,
+		Teams:    []domain.TeamMembership{{TeamID: oldTeamID, TeamName: oldTeamName, Role: domain.TeamRoleLead}},
+	}
+
+	testCases := []struct {
+		name            string
+		user            domain.User
+		fromTeamName    string
+		reassignReviews bool
+		expectErr       error
+	}{
+		{
+			name: "keep_reviews",
+			user: user,
+		},
+		{
+			name:            "reassign_reviews",
+			user:            user,
+			reassignReviews: true,
+		},
+		{
+			name:         "explicit_from_team",
+			user:         user,
+			fromTeamName: oldTeamName,
+		},
+		{
+			name: "teamless_user_joins",
+			user: domain.User{ID: userID, IsActive: true},
+		},
+		{
+			name: "already_in_team",
+			user: domain.User{
+				ID:    userID,
+				Teams: []domain.TeamMembership{{TeamID: newTeamID, TeamName: newTeamName}},
+			},
+			expectErr: domain.ErrAlreadyInTeam,
+		},
+		{
+			name: "ambiguous_from_team",
+			user: domain.User{
+				ID: userID,
+				Teams: []domain.TeamMembership{
+					{TeamID: oldTeamID, TeamName: oldTeamName},
+					{TeamID: thirdTeamID, TeamName: thirdTeamName},
+				},
+			},
+			expectErr: domain.ErrAmbiguousTeam,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			ms := NewMockStorage(ctrl)
+
+			ms.EXPECT().UnitOfWork(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, fn func(s Storage) error) error {
+					return fn(ms)
+				})
+
+			ms.EXPECT().GetTeamByName(gomock.Any(), newTeamName).Return(newTeam, nil)
+			ms.EXPECT().GetUserFull(gomock.Any(), userID).Return(tc.user, nil)
+
+			_, inOldTeam := tc.user.Membership(oldTeamID)
+
+			if tc.fromTeamName != "" {
+				ms.EXPECT().GetTeamByName(gomock.Any(), tc.fromTeamName).Return(oldTeam, nil)
+			} else if inOldTeam && len(tc.user.Teams) == 1 {
+				ms.EXPECT().GetTeamByID(gomock.Any(), oldTeamID).Return(oldTeam, nil)
+			}
+
+			if tc.reassignReviews {
+				// NOTE: заменяются только назначения от прежней команды
+				ms.EXPECT().GetPullRequestsByReviewer(gomock.Any(), userID).Return([]domain.PullRequest{
+					{ID: "1", Status: domain.StatusMerged, ReviewersUsersIDs: []string{userID}},
+					{
+						ID:                "2",
+						Status:            domain.StatusOpen,
+						ReviewersUsersIDs: []string{userID},
+						ReviewerPools:     map[string]domain.ReviewerPool{userID: {TeamName: thirdTeamName}},
+					},
+				}, nil)
+			}
+
+			if tc.expectErr == nil {
+				expectRole := domain.TeamRole("")
+				if inOldTeam {
+					ms.EXPECT().DeleteTeamMembership(gomock.Any(), oldTeamID, userID).Return(nil)
+					expectRole = domain.TeamRoleLead
+				}
+
+				ms.EXPECT().
+					CreateTeamMemberships(gomock.Any(), newTeamID, []domain.CreateUserRequest{{ID: userID, Role: expectRole}}).
+					Return(nil)
+
+				ms.EXPECT().
+					CreateAuditEntries(gomock.Any(), auditEntry(
+						domain.AuditTeamMoveMember,
+						userID,
+						domain.TeamMembership{TeamID: newTeamID, TeamName: newTeamName, Role: expectRole},
+					)).
+					Return(nil)
+
+				ms.EXPECT().GetUserFull(gomock.Any(), userID).Return(domain.User{
+					ID:       userID,
+					IsActive: true,
+					Teams:    []domain.TeamMembership{{TeamID: newTeamID, TeamName: newTeamName, Role: expectRole}},
+				}, nil)
+			}
+
+			u := NewUsecases(ms)
+			movedUser, result, err := u.MoveTeamMember(context.Background(), domain.MoveTeamMemberRequest{
+				UserID:          userID,
+				TeamName:        newTeamName,
+				FromTeamName:    tc.fromTeamName,
+				ReassignReviews: tc.reassignReviews,
+			})
+
+			if tc.expectErr != nil {
+				require.ErrorIs(t, err, tc.expectErr)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, []string{newTeamName}, movedUser.TeamNames())
+			assert.Empty(t, result.Reassigned)
+			assert.Empty(t, result.NoCandidate)
+		})
+	}
+}
+
+func TestUsecases_SetTeamMemberRole(t *testing.T) {
+	const (
+		userID   = "101"
+		teamID   = "300"
+		teamName = "team1"
+	)
+
+	team := domain.Team{ID: teamID, Name: teamName}
+
+	t.Run("not_in_team", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		ms := NewMockStorage(ctrl)
+
+		ms.EXPECT().UnitOfWork(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, fn func(s Storage) error) error {
+				return fn(ms)
+			})
+		ms.EXPECT().GetTeamByName(gomock.Any(), teamName).Return(team, nil)
+		ms.EXPECT().GetUserFull(gomock.Any(), userID).Return(domain.User{ID: userID}, nil)
+
+		u := NewUsecases(ms)
+		_, _, err := u.SetTeamMemberRole(context.Background(), domain.SetTeamMemberRoleRequest{
+			TeamName: teamName,
+			UserID:   userID,
+			Role:     domain.TeamRoleLead,
+		})
+
+		var errNotInTeam domain.ErrNotInTeam
+		require.ErrorAs(t, err, &errNotInTeam)
+	})
+
+	t.Run("lead", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		ms := NewMockStorage(ctrl)
+
+		ms.EXPECT().UnitOfWork(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, fn func(s Storage) error) error {
+				return fn(ms)
+			})
+		ms.EXPECT().GetTeamByName(gomock.Any(), teamName).Return(team, nil)
+		ms.EXPECT().GetUserFull(gomock.Any(), userID).Return(domain.User{
+			ID:    userID,
+			Teams: []domain.TeamMembership{{TeamID: teamID, TeamName: teamName, Role: domain.TeamRoleMember}},
+		}, nil)
+		ms.EXPECT().UpdateTeamMemberRole(gomock.Any(), teamID, userID, domain.TeamRoleLead).Return(nil)
+		ms.EXPECT().
+			CreateAuditEntries(gomock.Any(), auditEntry(
+				domain.AuditTeamSetMemberRole,
+				userID,
+				domain.TeamMembership{TeamID: teamID, TeamName: teamName, Role: domain.TeamRoleLead},
+			)).
+			Return(nil)
+		ms.EXPECT().GetTeamFullByName(gomock.Any(), teamName).Return(team, []domain.User{}, nil)
+
+		u := NewUsecases(ms)
+		_, _, err := u.SetTeamMemberRole(context.Background(), domain.SetTeamMemberRoleRequest{
+			TeamName: teamName,
+			UserID:   userID,
+			Role:     domain.TeamRoleLead,
+		})
+		require.NoError(t, err)
+	})
+}
+
+func TestUsecases_DeleteTeam(t *testing.T) {
+	const (
+		teamID   = "300"
+		teamName = "team1"
+	)
+
+	team := domain.Team{ID: teamID, Name: teamName}
+
+	t.Run("not_empty", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		ms := NewMockStorage(ctrl)
+
+		ms.EXPECT().UnitOfWork(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, fn func(s Storage) error) error {
+				return fn(ms)
+			})
+		ms.EXPECT().GetTeamFullByName(gomock.Any(), teamName).Return(team, []domain.User{{ID: "101"}}, nil)
+
+		u := NewUsecases(ms)
+		err := u.DeleteTeam(context.Background(), domain.DeleteTeamRequest{TeamName: teamName})
+		require.ErrorIs(t, err, domain.ErrTeamNotEmpty)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		ms := NewMockStorage(ctrl)
+
+		ms.EXPECT().UnitOfWork(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, fn func(s Storage) error) error {
+				return fn(ms)
+			})
+		ms.EXPECT().GetTeamFullByName(gomock.Any(), teamName).Return(team, nil, nil)
+		ms.EXPECT().DeleteTeam(gomock.Any(), teamID).Return(nil)
+		ms.EXPECT().CreateAuditEntries(gomock.Any(), auditEntry(domain.AuditTeamDelete, teamName, nil)).Return(nil)
+
+		u := NewUsecases(ms)
+		require.NoError(t, u.DeleteTeam(context.Background(), domain.DeleteTeamRequest{TeamName: teamName}))
+	})
+}
@@ -1,11 +1,77 @@
 package usecases
 
+import (
+	"pr-manager-service/internal/clock"
+	"pr-manager-service/internal/domain"
+)
+
 type Usecases struct {
 	storage Storage
+
+	reviewerSelectors       map[domain.ReviewerStrategy]ReviewerSelector
+	defaultReviewerStrategy domain.ReviewerStrategy
+	maxOpenReviewsPerUser   int
+	clock                   clock.Clock
+	webhookSender           WebhookSender
+	eventSinks              []EventSink
+}
+
+type Option func(u *Usecases)
+
+// WithDefaultReviewerStrategy задаёт стратегию для команд, у которых она не настроена.
+func WithDefaultReviewerStrategy(strategy domain.ReviewerStrategy) Option {
+	return func(u *Usecases) {
+		u.defaultReviewerStrategy = strategy
+	}
+}
+
+// WithReviewerSelector подменяет реализацию стратегии выбора ревьюверов.
+func WithReviewerSelector(strategy domain.ReviewerStrategy, selector ReviewerSelector) Option {
+	return func(u *Usecases) {
+		u.reviewerSelectors[strategy] = selector
+	}
+}
+
+// WithMaxOpenReviewsPerUser ограничивает число открытых ревью на одного пользователя.
+// Ноль отключает ограничение.
+func WithMaxOpenReviewsPerUser(limit int) Option {
+	return func(u *Usecases) {
+		u.maxOpenReviewsPerUser = limit
+	}
 }
 
-func NewUsecases(storage Storage) *Usecases {
-	return &Usecases{
-		storage: storage,
+// WithClock подменяет системные часы, например фиктивными в тестах.
+func WithClock(clock clock.Clock) Option {
+	return func(u *Usecases) {
+		u.clock = clock
 	}
 }
+
+// WithWebhookSender задаёт отправителя вебхуков; без него DeliverWebhooks возвращает ошибку.
+func WithWebhookSender(sender WebhookSender) Option {
+	return func(u *Usecases) {
+		u.webhookSender = sender
+	}
+}
+
+// WithEventSinks добавляет получателей событий, которых релей outbox вызывает по порядку.
+func WithEventSinks(sinks ...EventSink) Option {
+	return func(u *Usecases) {
+		u.eventSinks = append(u.eventSinks, sinks...)
+	}
+}
+
+func NewUsecases(storage Storage, opts ...Option) *Usecases {
+	u := &Usecases{
+		storage:                 storage,
+		reviewerSelectors:       defaultReviewerSelectors(),
+		defaultReviewerStrategy: domain.ReviewerStrategyRandom,
+		clock:                   clock.New(),
+	}
+
+	for _, opt := range opts {
+		opt(u)
+	}
+
+	return u
+}
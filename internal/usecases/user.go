@@ -2,47 +2,189 @@ package usecases
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"pr-manager-service/internal/domain"
 )
 
-func (u *Usecases) GetPullRequestsByReviewer(ctx context.Context, userID string) ([]domain.PullRequest, error) {
-	// NOTE: проверка существования пользователя
-	if _, err := u.storage.GetUserShort(ctx, userID); err != nil {
-		return nil, fmt.Errorf("GetUserShort: %w", err)
-	}
-
-	return u.storage.GetPullRequestsByReviewer(ctx, userID)
-}
-
+// UpdateUserStatus меняет флаг активности пользователя. Менять флаг другим пользователям
+// могут только lead их команд. При деактивации пользователь в той же транзакции заменяется
+// во всех своих активных PR.
 func (u *Usecases) UpdateUserStatus(
 	ctx context.Context,
 	userID string,
 	isActive bool,
-) (domain.User, domain.Team, error) {
+) (domain.User, domain.ReassignmentResult, error) {
+	var result domain.ReassignmentResult
+
 	if err := u.storage.UnitOfWork(ctx, func(s Storage) error {
-		user, err := s.GetUserShort(ctx, userID)
+		user, err := s.GetUserFull(ctx, userID)
 		if err != nil {
-			return fmt.Errorf("GetUserShort: %w", err)
+			return fmt.Errorf("GetUserFull: %w", err)
 		}
 
-		if user.IsActive == isActive {
-			return nil
+		result, err = u.changeUserStatus(ctx, s, user, isActive)
+		if err != nil {
+			return fmt.Errorf("changeUserStatus: %w", err)
 		}
 
-		if err := u.storage.UpdateUserStatus(ctx, userID, isActive); err != nil {
-			return fmt.Errorf("storage.UpdateUserStatus: %w", err)
+		return nil
+	}); err != nil {
+		return domain.User{}, domain.ReassignmentResult{}, fmt.Errorf("UnitOfWork: %w", err)
+	}
+
+	user, err := u.storage.GetUserFull(ctx, userID)
+	if err != nil {
+		return domain.User{}, domain.ReassignmentResult{}, fmt.Errorf("GetUserFull: %w", err)
+	}
+
+	return user, result, nil
+}
+
+// changeUserStatus меняет флаг активности пользователя в транзакции s с проверкой прав, статистикой и аудитом.
+// Участие user в командах должно быть заполнено. При деактивации пользователь заменяется во всех своих
+// активных PR; если флаг не изменился или пользователь активирован, результат замены пуст.
+func (u *Usecases) changeUserStatus(
+	ctx context.Context,
+	s Storage,
+	user domain.User,
+	isActive bool,
+) (domain.ReassignmentResult, error) {
+	result := domain.ReassignmentResult{
+		Reassigned:  []domain.ReviewerReplacement{},
+		NoCandidate: []domain.ReviewerReplacement{},
+	}
+
+	principal, err := u.principal(ctx, s)
+	if err != nil {
+		return result, fmt.Errorf("principal: %w", err)
+	}
+
+	if err := principal.AuthorizeUserStatusChange(user); err != nil {
+		return result, err
+	}
+
+	if user.IsActive == isActive {
+		return result, nil
+	}
+
+	if err := s.UpdateUserStatus(ctx, user.ID, isActive); err != nil {
+		return result, fmt.Errorf("UpdateUserStatus: %w", err)
+	}
+
+	if err := s.UserStatusChangesIncrementBatch(ctx, []string{user.ID}); err != nil {
+		return result, fmt.Errorf("UserStatusChangeIncrementMany: %w", err)
+	}
+
+	if err := u.audit(
+		ctx,
+		s,
+		domain.AuditUserChangeStatus,
+		domain.AuditTargetUser,
+		user.ID,
+		domain.AuditUserState{IsActive: user.IsActive},
+		domain.AuditUserState{IsActive: isActive},
+	); err != nil {
+		return result, fmt.Errorf("audit: %w", err)
+	}
+
+	if isActive {
+		return result, nil
+	}
+
+	result, err = u.replaceReviewerInActivePullRequests(
+		ctx,
+		s,
+		user,
+		domain.Team{},
+		domain.AssignmentReasonDeactivation,
+	)
+	if err != nil {
+		return result, fmt.Errorf("replaceReviewerInActivePullRequests: %w", err)
+	}
+
+	return result, nil
+}
+
+// SetUserWorkingHours задаёт часовой пояс и рабочие часы пользователя. Пользователь задаёт их только себе.
+func (u *Usecases) SetUserWorkingHours(ctx context.Context, request domain.SetWorkingHoursRequest) (domain.User, error) {
+	if err := u.authorizeActAs(ctx, u.storage, request.UserID); err != nil {
+		return domain.User{}, err
+	}
+
+	if err := u.storage.UpdateUserWorkingHours(
+		ctx,
+		request.UserID,
+		request.Timezone,
+		request.WorkingHours(),
+	); err != nil {
+		return domain.User{}, fmt.Errorf("UpdateUserWorkingHours: %w", err)
+	}
+
+	user, err := u.storage.GetUserFull(ctx, request.UserID)
+	if err != nil {
+		return domain.User{}, fmt.Errorf("GetUserFull: %w", err)
+	}
+
+	return user, nil
+}
+
+// replaceReviewerInActivePullRequests заменяет ревьювера во всех его PR в статусах OPEN и REOPENED
+// по правилам ReassignPullRequest. PR, для которых замены не нашлось, остаются с этим ревьювером.
+// Если задана team, заменяются только назначения от этой команды.
+func (u *Usecases) replaceReviewerInActivePullRequests(
+	ctx context.Context,
+	s Storage,
+	user domain.User,
+	team domain.Team,
+	reason domain.AssignmentReason,
+) (domain.ReassignmentResult, error) {
+	result := domain.ReassignmentResult{
+		Reassigned:  []domain.ReviewerReplacement{},
+		NoCandidate: []domain.ReviewerReplacement{},
+	}
+
+	pullRequests, err := s.GetPullRequestsByReviewer(ctx, user.ID)
+	if err != nil {
+		return domain.ReassignmentResult{}, fmt.Errorf("GetPullRequestsByReviewer: %w", err)
+	}
+
+	for _, pr := range pullRequests {
+		if !pr.Status.IsActive() {
+			continue
 		}
 
-		if err := u.storage.UserStatusChangesIncrementBatch(ctx, []string{userID}); err != nil {
-			return fmt.Errorf("UserStatusChangeIncrementMany: %w", err)
+		if team.ID != "" && !assignedFromTeam(pr, user.ID, team) {
+			continue
 		}
 
-		return nil
-	}); err != nil {
-		return domain.User{}, domain.Team{}, fmt.Errorf("UnitOfWork: %w", err)
+		replacement := domain.ReviewerReplacement{
+			PullRequestID: pr.ID,
+			OldReviewerID: user.ID,
+		}
+
+		replacement.NewReviewerID, err = u.replaceReviewer(ctx, s, pr, user, reason)
+		if errors.Is(err, domain.ErrNoCandidate) || errors.Is(err, domain.ErrReviewersAtCapacity) {
+			result.NoCandidate = append(result.NoCandidate, replacement)
+			continue
+		}
+		if err != nil {
+			return domain.ReassignmentResult{}, fmt.Errorf("replaceReviewer: %w", err)
+		}
+
+		result.Reassigned = append(result.Reassigned, replacement)
+	}
+
+	return result, nil
+}
+
+// assignedFromTeam проверяет, что ревьювер назначен в PR от команды team.
+// Назначения без записанной команды относятся к команде PR.
+func assignedFromTeam(pr domain.PullRequest, reviewerID string, team domain.Team) bool {
+	if pool, ok := pr.ReviewerPools[reviewerID]; ok {
+		return pool.TeamName == team.Name
 	}
 
-	return u.storage.GetUserFull(ctx, userID)
+	return pr.TeamID == team.ID
 }
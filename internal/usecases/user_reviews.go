@@ -0,0 +1,63 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+
+	"pr-manager-service/internal/domain"
+
+	"github.com/samber/lo"
+)
+
+// userReviewsOverfetch - сколько PR сверх лимита читается, чтобы понять, есть ли следующая страница
+const userReviewsOverfetch = 1
+
+// GetUserReviews возвращает страницу PR, где пользователь сейчас ревьювер, от дольше всех ждущих
+// его ревью к недавно назначенным, вместе с числом таких PR по статусам.
+func (u *Usecases) GetUserReviews(
+	ctx context.Context,
+	request domain.GetUserReviewsRequest,
+) (domain.UserReviewsPage, error) {
+	after, err := domain.DecodePageCursor(request.Cursor)
+	if err != nil {
+		return domain.UserReviewsPage{}, err
+	}
+
+	// NOTE: проверка существования пользователя
+	if _, err := u.storage.GetUserShort(ctx, request.UserID); err != nil {
+		return domain.UserReviewsPage{}, fmt.Errorf("GetUserShort: %w", err)
+	}
+
+	statuses := request.PullRequestStatuses()
+
+	reviews, err := u.storage.GetAssignedReviews(ctx, domain.AssignedReviewFilter{
+		UserID:   request.UserID,
+		Statuses: statuses,
+		After:    after,
+	}, request.Limit+userReviewsOverfetch)
+	if err != nil {
+		return domain.UserReviewsPage{}, fmt.Errorf("GetAssignedReviews: %w", err)
+	}
+
+	counts, err := u.storage.CountAssignedReviews(ctx, request.UserID)
+	if err != nil {
+		return domain.UserReviewsPage{}, fmt.Errorf("CountAssignedReviews: %w", err)
+	}
+
+	page := domain.UserReviewsPage{
+		Reviews:      reviews,
+		StatusCounts: counts,
+		Total: lo.SumBy(statuses, func(status domain.PullRequestStatus) int {
+			return counts[status]
+		}),
+	}
+
+	if len(reviews) > request.Limit {
+		page.Reviews = reviews[:request.Limit]
+
+		last := page.Reviews[request.Limit-1]
+		page.NextCursor = domain.PageCursor{Time: last.AssignedAt, ID: last.PullRequest.ID}.Encode()
+	}
+
+	return page, nil
+}
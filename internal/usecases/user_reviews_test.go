@@ -0,0 +1,117 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"pr-manager-service/internal/domain"
+	"pr-manager-service/internal/generated/api"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUsecases_GetUserReviews(t *testing.T) {
+	const userID = "reviewer"
+
+	assignedAt := time.Date(2025, 11, 3, 10, 0, 0, 0, time.UTC)
+
+	reviews := []domain.AssignedReview{
+		{PullRequest: domain.PullRequest{ID: "pr-1", Status: domain.StatusOpen}, AssignedAt: assignedAt},
+		{PullRequest: domain.PullRequest{ID: "pr-2", Status: domain.StatusReopened}, AssignedAt: assignedAt},
+		{PullRequest: domain.PullRequest{ID: "pr-3", Status: domain.StatusOpen}, AssignedAt: assignedAt.Add(time.Hour)},
+	}
+
+	counts := map[domain.PullRequestStatus]int{
+		domain.StatusOpen:     4,
+		domain.StatusReopened: 1,
+		domain.StatusMerged:   7,
+	}
+
+	t.Run("next_page", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		ms := NewMockStorage(ctrl)
+
+		after := domain.PageCursor{Time: assignedAt.Add(-time.Hour), ID: "pr-0"}
+
+		ms.EXPECT().GetUserShort(gomock.Any(), userID).Return(domain.User{ID: userID}, nil)
+		ms.EXPECT().
+			GetAssignedReviews(gomock.Any(), domain.AssignedReviewFilter{
+				UserID:   userID,
+				Statuses: []domain.PullRequestStatus{domain.StatusOpen, domain.StatusReopened},
+				After:    &after,
+			}, 3).
+			Return(reviews, nil)
+		ms.EXPECT().CountAssignedReviews(gomock.Any(), userID).Return(counts, nil)
+
+		page, err := NewUsecases(ms).GetUserReviews(context.Background(), domain.GetUserReviewsRequest{
+			UserID:   userID,
+			Statuses: []api.PullRequestStatus{api.OPEN, api.REOPENED},
+			Cursor:   after.Encode(),
+			Limit:    2,
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, reviews[:2], page.Reviews)
+		assert.Equal(t, 5, page.Total)
+		assert.Equal(t, counts, page.StatusCounts)
+
+		next, err := domain.DecodePageCursor(page.NextCursor)
+		require.NoError(t, err)
+		assert.Equal(t, "pr-2", next.ID)
+		assert.True(t, assignedAt.Equal(next.Time))
+	})
+
+	t.Run("last_page", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		ms := NewMockStorage(ctrl)
+
+		ms.EXPECT().GetUserShort(gomock.Any(), userID).Return(domain.User{ID: userID}, nil)
+		ms.EXPECT().
+			GetAssignedReviews(gomock.Any(), domain.AssignedReviewFilter{
+				UserID:   userID,
+				Statuses: []domain.PullRequestStatus{domain.StatusOpen},
+			}, 11).
+			Return(reviews, nil)
+		ms.EXPECT().CountAssignedReviews(gomock.Any(), userID).Return(counts, nil)
+
+		page, err := NewUsecases(ms).GetUserReviews(context.Background(), domain.GetUserReviewsRequest{
+			UserID:   userID,
+			Statuses: []api.PullRequestStatus{api.OPEN},
+			Limit:    10,
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, reviews, page.Reviews)
+		assert.Equal(t, 4, page.Total)
+		assert.Empty(t, page.NextCursor)
+	})
+
+	t.Run("unknown_user", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		ms := NewMockStorage(ctrl)
+
+		ms.EXPECT().GetUserShort(gomock.Any(), userID).Return(domain.User{}, domain.ErrUserNotFound)
+
+		_, err := NewUsecases(ms).GetUserReviews(context.Background(), domain.GetUserReviewsRequest{
+			UserID:   userID,
+			Statuses: []api.PullRequestStatus{api.OPEN},
+			Limit:    10,
+		})
+		assert.ErrorIs(t, err, domain.ErrUserNotFound)
+	})
+
+	t.Run("invalid_cursor", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		ms := NewMockStorage(ctrl)
+
+		_, err := NewUsecases(ms).GetUserReviews(context.Background(), domain.GetUserReviewsRequest{
+			UserID: userID,
+			Cursor: "garbage!",
+			Limit:  10,
+		})
+		assert.ErrorIs(t, err, domain.ErrInvalidCursor)
+	})
+}
@@ -0,0 +1,170 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+
+	"pr-manager-service/internal/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUsecases_UpdateUserStatus(t *testing.T) {
+	const (
+		userID      = "101"
+		colleagueID = "102"
+		authorID    = "200"
+		teamID      = "300"
+		teamName    = "team1"
+
+		openPrID     = "1"
+		reopenedPrID = "2"
+		mergedPrID   = "3"
+	)
+
+	team := domain.Team{
+		ID:           teamID,
+		Name:         teamName,
+		MinReviewers: domain.DefaultMinReviewers,
+		MaxReviewers: domain.DefaultMaxReviewers,
+	}
+
+	mockUnitOfWork := func(ms *MockStorage) {
+		ms.EXPECT().UnitOfWork(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, fn func(s Storage) error) error {
+				return fn(ms)
+			})
+	}
+
+	t.Run("deactivation_reassigns_active_pull_requests", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		ms := NewMockStorage(ctrl)
+		mockUnitOfWork(ms)
+
+		ms.EXPECT().
+			GetUserFull(gomock.Any(), userID).
+			Return(domain.User{ID: userID, IsActive: true}, nil)
+
+		ms.EXPECT().UpdateUserStatus(gomock.Any(), userID, false).Return(nil)
+		ms.EXPECT().UserStatusChangesIncrementBatch(gomock.Any(), []string{userID}).Return(nil)
+		ms.EXPECT().
+			CreateAuditEntries(gomock.Any(), auditEntry(
+				domain.AuditUserChangeStatus,
+				userID,
+				domain.AuditUserState{IsActive: false},
+			)).
+			Return(nil)
+
+		ms.EXPECT().
+			GetPullRequestsByReviewer(gomock.Any(), userID).
+			Return([]domain.PullRequest{
+				{
+					ID:                openPrID,
+					AuthorUserID:      authorID,
+					ReviewersUsersIDs: []string{userID},
+					Status:            domain.StatusOpen,
+					TeamID:            teamID,
+				},
+				{
+					ID:                reopenedPrID,
+					AuthorUserID:      authorID,
+					ReviewersUsersIDs: []string{userID, colleagueID},
+					Status:            domain.StatusReopened,
+					TeamID:            teamID,
+				},
+				{
+					ID:                mergedPrID,
+					AuthorUserID:      authorID,
+					ReviewersUsersIDs: []string{userID},
+					Status:            domain.StatusMerged,
+					TeamID:            teamID,
+				},
+			}, nil)
+
+		ms.EXPECT().
+			GetActiveColleagues(gomock.Any(), userID, teamID).
+			Return([]domain.User{{ID: colleagueID, IsActive: true}}, nil).
+			Times(2)
+
+		ms.EXPECT().GetTeamByID(gomock.Any(), teamID).Return(team, nil).Times(2)
+
+		// NOTE: в REOPENED PR коллега уже ревьювер, а резервных команд нет
+		ms.EXPECT().GetTeamFallbacks(gomock.Any(), teamID).Return([]domain.Team{}, nil)
+
+		ms.EXPECT().
+			UnassignPullRequestReviewer(gomock.Any(), openPrID, userID, domain.AssignmentReasonDeactivation).
+			Return(nil)
+
+		ms.EXPECT().
+			AssignPullRequestReviewers(gomock.Any(), openPrID, []domain.ReviewerAssignment{
+				{UserID: colleagueID, TeamID: teamID, TeamName: teamName},
+			}, domain.AssignmentReasonDeactivation).
+			Return(nil)
+
+		ms.EXPECT().
+			CreateOutboxEvents(gomock.Any(), eventsWithData(domain.ReviewerReassignedEvent{
+				PullRequestID: openPrID,
+				OldReviewerID: userID,
+				NewReviewerID: colleagueID,
+				Reason:        domain.AssignmentReasonDeactivation,
+			})).
+			Return(nil)
+
+		ms.EXPECT().
+			CreateAuditEntries(gomock.Any(), auditEntry(domain.AuditPullRequestReassign, openPrID, domain.AuditPullRequestState{
+				AuthorID:    authorID,
+				Status:      domain.ConvertPullRequestStatusToApi(domain.StatusOpen),
+				ReviewerIDs: []string{colleagueID},
+			})).
+			Return(nil)
+
+		ms.EXPECT().
+			GetUserFull(gomock.Any(), userID).
+			Return(domain.User{ID: userID}, nil)
+
+		u := NewUsecases(ms)
+		user, result, err := u.UpdateUserStatus(context.Background(), userID, false)
+		require.NoError(t, err)
+
+		assert.False(t, user.IsActive)
+		assert.Equal(t, []domain.ReviewerReplacement{
+			{PullRequestID: openPrID, OldReviewerID: userID, NewReviewerID: colleagueID},
+		}, result.Reassigned)
+		assert.Equal(t, []domain.ReviewerReplacement{
+			{PullRequestID: reopenedPrID, OldReviewerID: userID},
+		}, result.NoCandidate)
+	})
+
+	t.Run("activation_keeps_pull_requests", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		ms := NewMockStorage(ctrl)
+		mockUnitOfWork(ms)
+
+		ms.EXPECT().
+			GetUserFull(gomock.Any(), userID).
+			Return(domain.User{ID: userID, IsActive: false}, nil)
+
+		ms.EXPECT().UpdateUserStatus(gomock.Any(), userID, true).Return(nil)
+		ms.EXPECT().UserStatusChangesIncrementBatch(gomock.Any(), []string{userID}).Return(nil)
+		ms.EXPECT().
+			CreateAuditEntries(gomock.Any(), auditEntry(
+				domain.AuditUserChangeStatus,
+				userID,
+				domain.AuditUserState{IsActive: true},
+			)).
+			Return(nil)
+
+		ms.EXPECT().
+			GetUserFull(gomock.Any(), userID).
+			Return(domain.User{ID: userID, IsActive: true}, nil)
+
+		u := NewUsecases(ms)
+		_, result, err := u.UpdateUserStatus(context.Background(), userID, true)
+		require.NoError(t, err)
+
+		assert.Empty(t, result.Reassigned)
+		assert.Empty(t, result.NoCandidate)
+	})
+}
@@ -0,0 +1,146 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"pr-manager-service/internal/domain"
+)
+
+const (
+	// webhookDeliveryBatch - сколько доставок берётся в работу за один проход
+	webhookDeliveryBatch = 50
+	// webhookDeliveryLease - через сколько доставку можно взять снова, если результат попытки не записан
+	webhookDeliveryLease = time.Minute
+)
+
+var errWebhookSenderNotConfigured = errors.New("webhook sender is not configured")
+
+func (u *Usecases) CreateWebhookSubscription(
+	ctx context.Context,
+	request domain.CreateWebhookSubscriptionRequest,
+) (domain.WebhookSubscription, error) {
+	var subscription domain.WebhookSubscription
+
+	if err := u.storage.UnitOfWork(ctx, func(s Storage) error {
+		var err error
+
+		subscription, err = s.CreateWebhookSubscription(ctx, request)
+		if err != nil {
+			return fmt.Errorf("CreateWebhookSubscription: %w", err)
+		}
+
+		if err := u.audit(
+			ctx,
+			s,
+			domain.AuditWebhookCreate,
+			domain.AuditTargetWebhook,
+			strconv.FormatInt(subscription.ID, 10),
+			nil,
+			domain.NewAuditWebhookState(subscription),
+		); err != nil {
+			return fmt.Errorf("audit: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return domain.WebhookSubscription{}, fmt.Errorf("UnitOfWork: %w", err)
+	}
+
+	return subscription, nil
+}
+
+func (u *Usecases) GetWebhookSubscriptions(ctx context.Context) ([]domain.WebhookSubscription, error) {
+	subscriptions, err := u.storage.GetWebhookSubscriptions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("storage.GetWebhookSubscriptions: %w", err)
+	}
+
+	return subscriptions, nil
+}
+
+// DeleteWebhookSubscription удаляет подписку вместе с журналом её доставок.
+func (u *Usecases) DeleteWebhookSubscription(ctx context.Context, request domain.DeleteWebhookSubscriptionRequest) error {
+	if err := u.storage.UnitOfWork(ctx, func(s Storage) error {
+		subscription, err := s.GetWebhookSubscriptionByID(ctx, request.ID)
+		if err != nil {
+			return fmt.Errorf("GetWebhookSubscriptionByID: %w", err)
+		}
+
+		if err := s.DeleteWebhookSubscription(ctx, request.ID); err != nil {
+			return fmt.Errorf("DeleteWebhookSubscription: %w", err)
+		}
+
+		if err := u.audit(
+			ctx,
+			s,
+			domain.AuditWebhookDelete,
+			domain.AuditTargetWebhook,
+			strconv.FormatInt(subscription.ID, 10),
+			domain.NewAuditWebhookState(subscription),
+			nil,
+		); err != nil {
+			return fmt.Errorf("audit: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return fmt.Errorf("UnitOfWork: %w", err)
+	}
+
+	return nil
+}
+
+// GetWebhookDeliveries возвращает журнал доставок подписки от новых к старым.
+func (u *Usecases) GetWebhookDeliveries(
+	ctx context.Context,
+	request domain.GetWebhookDeliveriesRequest,
+) ([]domain.WebhookDelivery, error) {
+	if _, err := u.storage.GetWebhookSubscriptionByID(ctx, request.SubscriptionID); err != nil {
+		return nil, fmt.Errorf("storage.GetWebhookSubscriptionByID: %w", err)
+	}
+
+	deliveries, err := u.storage.GetWebhookDeliveries(ctx, request)
+	if err != nil {
+		return nil, fmt.Errorf("storage.GetWebhookDeliveries: %w", err)
+	}
+
+	return deliveries, nil
+}
+
+// DeliverWebhooks выполняет очередную попытку для доставок, которым пора её выполнить, и возвращает их
+// с записанным результатом. Доставки берутся в работу с блокировкой, поэтому несколько реплик сервиса
+// не отправят одну доставку одновременно; отправка идёт вне транзакции.
+func (u *Usecases) DeliverWebhooks(ctx context.Context) ([]domain.WebhookDelivery, error) {
+	if u.webhookSender == nil {
+		return nil, errWebhookSenderNotConfigured
+	}
+
+	deliveries, err := u.storage.ClaimWebhookDeliveries(
+		ctx,
+		webhookDeliveryBatch,
+		u.clock.Now().Add(webhookDeliveryLease),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("storage.ClaimWebhookDeliveries: %w", err)
+	}
+
+	for i := range deliveries {
+		// NOTE: взятые, но не отправленные доставки вернутся в очередь после webhookDeliveryLease
+		if err := ctx.Err(); err != nil {
+			return deliveries[:i], err
+		}
+
+		statusCode, sendErr := u.webhookSender.Send(ctx, deliveries[i])
+		deliveries[i].RecordAttempt(statusCode, sendErr, u.clock.Now())
+
+		if err := u.storage.UpdateWebhookDelivery(ctx, deliveries[i]); err != nil {
+			return deliveries[:i+1], fmt.Errorf("storage.UpdateWebhookDelivery: %w", err)
+		}
+	}
+
+	return deliveries, nil
+}
@@ -0,0 +1,85 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"pr-manager-service/internal/clock"
+	"pr-manager-service/internal/domain"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUsecases_DeliverWebhooks(t *testing.T) {
+	now := time.Date(2025, time.November, 12, 12, 0, 0, 0, time.UTC)
+
+	delivered := domain.WebhookDelivery{ID: 1, SubscriptionID: 10, Status: domain.WebhookDeliveryPending}
+	failed := domain.WebhookDelivery{ID: 2, SubscriptionID: 11, Status: domain.WebhookDeliveryPending, Attempts: 2}
+
+	t.Run("records_attempts", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		ms := NewMockStorage(ctrl)
+		sender := NewMockWebhookSender(ctrl)
+
+		ms.EXPECT().
+			ClaimWebhookDeliveries(gomock.Any(), webhookDeliveryBatch, now.Add(webhookDeliveryLease)).
+			Return([]domain.WebhookDelivery{delivered, failed}, nil)
+
+		sender.EXPECT().Send(gomock.Any(), delivered).Return(http.StatusOK, nil)
+		sender.EXPECT().Send(gomock.Any(), failed).Return(http.StatusBadGateway, errors.New("unexpected status 502"))
+
+		expectDelivered := delivered
+		expectDelivered.Status = domain.WebhookDeliveryDelivered
+		expectDelivered.Attempts = 1
+		expectDelivered.LastStatusCode = http.StatusOK
+		expectDelivered.DeliveredAt = &now
+
+		expectFailed := failed
+		expectFailed.Attempts = 3
+		expectFailed.LastStatusCode = http.StatusBadGateway
+		expectFailed.LastError = "unexpected status 502"
+		expectFailed.NextAttemptAt = lo.ToPtr(now.Add(40 * time.Second))
+
+		ms.EXPECT().UpdateWebhookDelivery(gomock.Any(), expectDelivered).Return(nil)
+		ms.EXPECT().UpdateWebhookDelivery(gomock.Any(), expectFailed).Return(nil)
+
+		u := NewUsecases(ms, WithClock(clock.NewFake(now)), WithWebhookSender(sender))
+		deliveries, err := u.DeliverWebhooks(context.Background())
+		require.NoError(t, err)
+
+		assert.Equal(t, []domain.WebhookDelivery{expectDelivered, expectFailed}, deliveries)
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		ms := NewMockStorage(ctrl)
+		sender := NewMockWebhookSender(ctrl)
+
+		ms.EXPECT().
+			ClaimWebhookDeliveries(gomock.Any(), webhookDeliveryBatch, now.Add(webhookDeliveryLease)).
+			Return([]domain.WebhookDelivery{delivered}, nil)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		u := NewUsecases(ms, WithClock(clock.NewFake(now)), WithWebhookSender(sender))
+		deliveries, err := u.DeliverWebhooks(ctx)
+		require.ErrorIs(t, err, context.Canceled)
+		assert.Empty(t, deliveries)
+	})
+
+	t.Run("sender_not_configured", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		ms := NewMockStorage(ctrl)
+
+		u := NewUsecases(ms)
+		_, err := u.DeliverWebhooks(context.Background())
+		require.ErrorIs(t, err, errWebhookSenderNotConfigured)
+	})
+}
@@ -0,0 +1,62 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"pr-manager-service/internal/domain"
+)
+
+const (
+	SignatureHeader = "X-Webhook-Signature"
+	EventHeader     = "X-Webhook-Event"
+	DeliveryHeader  = "X-Webhook-Delivery"
+	// IdempotencyKeyHeader совпадает с DeliveryHeader: повторная доставка события приходит с тем же ключом
+	IdempotencyKeyHeader = "Idempotency-Key"
+
+	// maxDrainedBody - сколько байт ответа читается, чтобы соединение можно было переиспользовать
+	maxDrainedBody = 64 << 10
+)
+
+// Sender отправляет доставки вебхуков POST-запросом с подписью HMAC-SHA256 в заголовке SignatureHeader.
+type Sender struct {
+	client *http.Client
+}
+
+func NewSender(timeout time.Duration) *Sender {
+	return &Sender{
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+// Send отправляет тело события получателю. Ответ не из диапазона 2xx возвращается как ошибка вместе с кодом.
+func (s *Sender) Send(ctx context.Context, delivery domain.WebhookDelivery) (int, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, fmt.Errorf("http.NewRequestWithContext: %w", err)
+	}
+
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set(SignatureHeader, domain.SignWebhookPayload(delivery.Secret, delivery.Payload))
+	request.Header.Set(EventHeader, string(delivery.EventType))
+	request.Header.Set(DeliveryHeader, delivery.EventID)
+	request.Header.Set(IdempotencyKeyHeader, delivery.EventID)
+
+	response, err := s.client.Do(request)
+	if err != nil {
+		return 0, fmt.Errorf("client.Do: %w", err)
+	}
+	defer response.Body.Close()
+
+	_, _ = io.Copy(io.Discard, io.LimitReader(response.Body, maxDrainedBody))
+
+	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
+		return response.StatusCode, fmt.Errorf("unexpected status code %d", response.StatusCode)
+	}
+
+	return response.StatusCode, nil
+}
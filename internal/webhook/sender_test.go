@@ -0,0 +1,81 @@
+package webhook
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"pr-manager-service/internal/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSender_Send(t *testing.T) {
+	const secret = "0123456789abcdef"
+
+	payload := []byte(`{"id":"event-1","type":"pull_request.merged"}`)
+
+	t.Run("signed", func(t *testing.T) {
+		var received *http.Request
+		var body []byte
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			received = r
+			body, _ = io.ReadAll(r.Body)
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		statusCode, err := NewSender(time.Second).Send(context.Background(), domain.WebhookDelivery{
+			EventID:   "event-1",
+			EventType: domain.EventPullRequestMerged,
+			Payload:   payload,
+			URL:       server.URL,
+			Secret:    secret,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNoContent, statusCode)
+
+		require.NotNil(t, received)
+		assert.Equal(t, http.MethodPost, received.Method)
+		assert.Equal(t, payload, body)
+		assert.Equal(t, "application/json", received.Header.Get("Content-Type"))
+		assert.Equal(t, domain.SignWebhookPayload(secret, payload), received.Header.Get(SignatureHeader))
+		assert.Equal(t, "pull_request.merged", received.Header.Get(EventHeader))
+		assert.Equal(t, "event-1", received.Header.Get(DeliveryHeader))
+		assert.Equal(t, "event-1", received.Header.Get(IdempotencyKeyHeader))
+	})
+
+	t.Run("error_status", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		statusCode, err := NewSender(time.Second).Send(context.Background(), domain.WebhookDelivery{
+			Payload: payload,
+			URL:     server.URL,
+			Secret:  secret,
+		})
+		require.Error(t, err)
+		assert.Equal(t, http.StatusServiceUnavailable, statusCode)
+	})
+
+	t.Run("unreachable", func(t *testing.T) {
+		server := httptest.NewServer(http.NotFoundHandler())
+		url := server.URL
+		server.Close()
+
+		statusCode, err := NewSender(time.Second).Send(context.Background(), domain.WebhookDelivery{
+			Payload: payload,
+			URL:     url,
+			Secret:  secret,
+		})
+		require.Error(t, err)
+		assert.Zero(t, statusCode)
+	})
+}
@@ -0,0 +1,6 @@
+alter table teams
+	add column reviewer_strategy varchar(32)
+	, add column reviewer_cursor varchar(36);
+
+alter table users_stats
+	add column last_assigned_at timestamp;
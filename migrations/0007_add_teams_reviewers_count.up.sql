@@ -0,0 +1,6 @@
+alter table teams
+	add column min_reviewers smallint not null default 1
+	, add column max_reviewers smallint not null default 2;
+
+alter table pull_requests
+	add column needs_more_reviewers bool not null default false;
@@ -0,0 +1,22 @@
+create table team_fallbacks (
+	team_id varchar(36) not null
+	, fallback_team_id varchar(36) not null
+	, priority smallint not null
+	, primary key (team_id, fallback_team_id)
+);
+
+create table pull_request_reviewer_pools (
+	pull_request_id varchar(36) not null
+	, user_id varchar(36) not null
+	, team_id varchar(36) not null
+	, is_fallback bool not null default false
+	, primary key (pull_request_id, user_id)
+);
+
+-- NOTE: уже назначенные ревьюверы считаются выбранными из своей команды
+insert into pull_request_reviewer_pools (pull_request_id, user_id, team_id, is_fallback)
+select pr.id, u.id, u.team_id, false
+from pull_requests pr
+	cross join unnest(pr.reviewers_ids) as reviewer_id
+	join users u on u.id = reviewer_id
+on conflict do nothing;
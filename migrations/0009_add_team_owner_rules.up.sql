@@ -0,0 +1,10 @@
+create table team_owner_rules (
+	team_id varchar(36) not null
+	, position smallint not null
+	, pattern varchar(255) not null
+	, owners varchar(51)[] not null
+	, primary key (team_id, position)
+);
+
+alter table pull_request_reviewer_pools
+	add column is_owner bool not null default false;
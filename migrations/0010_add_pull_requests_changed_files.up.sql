@@ -0,0 +1,2 @@
+alter table pull_requests
+	add column changed_files text[] not null default '{}';
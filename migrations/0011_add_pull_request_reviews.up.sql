@@ -0,0 +1,13 @@
+alter table teams
+	add column required_approvals smallint not null default 0;
+
+create table pull_request_reviews (
+	id bigserial primary key
+	, pull_request_id varchar(36) not null
+	, user_id varchar(36) not null
+	, verdict varchar(32) not null
+	, comment text not null default ''
+	, submitted_at timestamp not null default now()
+);
+
+create index idx_pull_request_reviews_pull_request_id on pull_request_reviews (pull_request_id, user_id, id);
@@ -0,0 +1,75 @@
+create table pull_request_reviewers (
+	id bigserial primary key
+	, pull_request_id varchar(36) not null
+	, user_id varchar(36) not null
+	, team_id varchar(36) not null
+	, is_fallback bool not null default false
+	, is_owner bool not null default false
+	, reason varchar(32) not null
+	-- NOTE: null - назначение выполнено сервисом
+	, assigned_by varchar(36)
+	, assigned_at timestamp not null default now()
+	, unassigned_at timestamp
+	, unassign_reason varchar(32)
+);
+
+-- NOTE: пользователь может быть назначен ревьювером PR повторно, но не дважды одновременно
+create unique index idx_pull_request_reviewers_active on pull_request_reviewers (pull_request_id, user_id)
+	where unassigned_at is null;
+
+create index idx_pull_request_reviewers_user_id on pull_request_reviewers (user_id, pull_request_id)
+	where unassigned_at is null;
+
+-- NOTE: история назначений до миграции не сохранялась, переносятся только текущие ревьюверы
+insert into pull_request_reviewers (pull_request_id, user_id, team_id, is_fallback, is_owner, reason, assigned_at)
+select
+	pr.id
+	, r.reviewer_id
+	, coalesce(p.team_id, u.team_id)
+	, coalesce(p.is_fallback, false)
+	, coalesce(p.is_owner, false)
+	, 'initial'
+	, coalesce(pr.created_at, now())
+from pull_requests pr
+	cross join unnest(pr.reviewers_ids) with ordinality as r(reviewer_id, position)
+	join users u on u.id = r.reviewer_id
+	left join pull_request_reviewer_pools p on p.pull_request_id = pr.id and p.user_id = r.reviewer_id
+order by pr.id, r.position;
+
+drop table pull_request_reviewer_pools;
+
+drop index idx_pull_requests_reviewers_ids_gin;
+
+alter table pull_requests
+	drop column reviewers_ids;
+
+-- NOTE: число назначений и время последнего назначения считаются по pull_request_reviewers.
+-- Назначения до миграции, которых нет среди перенесённых текущих ревьюверов (например, снятые при
+-- переназначении), сохраняются смещениями legacy_*, чтобы статистика и стратегии не изменились
+alter table pull_requests
+	add column legacy_assignments_count bigint not null default 0;
+
+update pull_requests pr
+set legacy_assignments_count = greatest(
+	s.assignments_count - (select count(*) from pull_request_reviewers r where r.pull_request_id = pr.id)
+	, 0
+)
+from pull_requests_stats s
+where s.pull_request_id = pr.id;
+
+alter table users_stats
+	add column legacy_assignments_count bigint not null default 0
+	, add column legacy_last_assigned_at timestamp;
+
+update users_stats us
+set legacy_assignments_count = greatest(
+		us.assignments_count - (select count(*) from pull_request_reviewers r where r.user_id = us.user_id)
+		, 0
+	)
+	, legacy_last_assigned_at = us.last_assigned_at;
+
+drop table pull_requests_stats;
+
+alter table users_stats
+	drop column assignments_count
+	, drop column last_assigned_at;
@@ -0,0 +1,3 @@
+-- NOTE: пользователь, удалённый из команды, остаётся в базе без команды, чтобы сохранить его PR и историю назначений
+alter table users
+	alter column team_id drop not null;
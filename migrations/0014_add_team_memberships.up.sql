@@ -0,0 +1,28 @@
+create table team_memberships (
+	team_id varchar(36) not null
+	, user_id varchar(36) not null
+	, role varchar(16) not null default 'member'
+	, joined_at timestamp not null default now()
+	, primary key (team_id, user_id)
+);
+
+create index idx_team_memberships_user_id on team_memberships (user_id);
+
+insert into team_memberships (team_id, user_id)
+select team_id, id
+from users
+where team_id is not null;
+
+-- NOTE: команда, для которой создан PR; для существующих PR - команда автора на момент миграции
+alter table pull_requests
+	add column team_id varchar(36) null;
+
+update pull_requests pr
+set team_id = u.team_id
+from users u
+where u.id = pr.author_id;
+
+drop index idx_users_team_id_active;
+
+alter table users
+	drop column team_id;
@@ -0,0 +1,11 @@
+create table user_unavailability (
+	id bigserial primary key
+	, user_id varchar(36) not null
+	, starts_at timestamptz not null
+	, ends_at timestamptz not null
+	, reason varchar(200)
+	, created_at timestamptz not null default now()
+	, check (ends_at > starts_at)
+);
+
+create index idx_user_unavailability_user_id on user_unavailability (user_id, ends_at);
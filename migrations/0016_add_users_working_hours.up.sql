@@ -0,0 +1,13 @@
+alter table users
+	add column timezone varchar(64) not null default 'UTC'
+	, add column work_start_minute smallint
+	, add column work_end_minute smallint
+	, add constraint users_working_hours_check check (
+		(work_start_minute is null) = (work_end_minute is null)
+		and work_start_minute between 0 and 1439
+		and work_end_minute between 0 and 1439
+		and work_start_minute <> work_end_minute
+	);
+
+alter table teams
+	add column prefer_working_hours boolean not null default false;
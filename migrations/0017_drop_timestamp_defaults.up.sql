@@ -0,0 +1,18 @@
+-- NOTE: метки времени задаёт сервис, чтобы все они брались из одних часов
+alter table pull_requests
+	alter column created_at drop default;
+
+alter table users_stats
+	alter column updated_at drop default;
+
+alter table pull_request_reviews
+	alter column submitted_at drop default;
+
+alter table pull_request_reviewers
+	alter column assigned_at drop default;
+
+alter table team_memberships
+	alter column joined_at drop default;
+
+alter table user_unavailability
+	alter column created_at drop default;
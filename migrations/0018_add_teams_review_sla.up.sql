@@ -0,0 +1,5 @@
+alter table teams
+	add column review_sla_hours smallint not null default 0;
+
+create index idx_pull_request_reviewers_assigned_at on pull_request_reviewers (assigned_at)
+	where unassigned_at is null;
@@ -0,0 +1,4 @@
+-- NOTE: время, когда воркер эскалации не смог ни переназначить просроченное ревью, ни передать его лиду;
+-- такие назначения воркер больше не обрабатывает
+alter table pull_request_reviewers
+	add column escalated_at timestamp;
@@ -0,0 +1,30 @@
+create table webhook_subscriptions (
+	id bigserial primary key
+	, url varchar(2048) not null
+	-- NOTE: пустой список - подписка на все события
+	, event_types varchar(64)[] not null default '{}'
+	, secret varchar(256) not null
+	, created_at timestamp not null
+);
+
+create table webhook_deliveries (
+	id bigserial primary key
+	, subscription_id bigint not null
+	, event_id varchar(36) not null
+	, event_type varchar(64) not null
+	, payload jsonb not null
+	, status varchar(16) not null
+	, attempts int not null default 0
+	-- NOTE: null - доставка завершена; для взятой в работу доставки - время, после которого её можно взять снова
+	, next_attempt_at timestamp
+	, last_status_code int
+	, last_error text
+	, created_at timestamp not null
+	, delivered_at timestamp
+	, unique (subscription_id, event_id)
+);
+
+create index idx_webhook_deliveries_pending on webhook_deliveries (next_attempt_at, id)
+	where status = 'pending';
+
+create index idx_webhook_deliveries_subscription_id on webhook_deliveries (subscription_id, id);
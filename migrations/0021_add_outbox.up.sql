@@ -0,0 +1,13 @@
+create table outbox (
+	id bigserial primary key
+	-- NOTE: ключ идемпотентности события, получатели отбрасывают по нему повторные доставки
+	, event_id varchar(36) not null unique
+	, event_type varchar(64) not null
+	, payload jsonb not null
+	, occurred_at timestamp not null
+	-- NOTE: null - событие ещё не передано получателям
+	, dispatched_at timestamp
+);
+
+create index idx_outbox_pending on outbox (id)
+	where dispatched_at is null;
@@ -0,0 +1,32 @@
+alter table outbox
+	add column pull_request_id varchar(36)
+	-- NOTE: пользователи, которых касается событие; по ним фильтруется поток событий
+	, add column user_ids varchar(36)[] not null default '{}'
+	-- NOTE: позиция события в порядке передачи релеем, заполняется вместе с dispatched_at
+	, add column dispatch_seq bigint;
+
+update outbox
+set pull_request_id = payload -> 'data' ->> 'pull_request_id'
+	, user_ids = array_remove(
+		array[
+			payload -> 'data' ->> 'author_id'
+			, payload -> 'data' ->> 'old_reviewer_id'
+			, payload -> 'data' ->> 'new_reviewer_id'
+		]
+		|| array(
+			select jsonb_array_elements_text(payload -> 'data' -> 'reviewer_ids')
+			where jsonb_typeof(payload -> 'data' -> 'reviewer_ids') = 'array'
+		)
+		, null
+	);
+
+update outbox o
+set dispatch_seq = n.seq
+from (
+	select id, row_number() over (order by dispatched_at, id) as seq
+	from outbox
+	where dispatched_at is not null
+) n
+where o.id = n.id;
+
+create unique index idx_outbox_dispatch_seq on outbox (dispatch_seq);
@@ -0,0 +1,16 @@
+create table audit_log (
+	id bigserial primary key
+	-- NOTE: null - операцию выполнил сервис (например, воркер эскалации)
+	, actor varchar(255)
+	, action varchar(64) not null
+	, target_type varchar(32) not null
+	, target_id varchar(255) not null
+	, before jsonb
+	, after jsonb
+	, request_id varchar(255)
+	, created_at timestamp not null
+);
+
+create index idx_audit_log_target on audit_log (target_type, target_id, id);
+create index idx_audit_log_actor on audit_log (actor, id);
+create index idx_audit_log_created_at on audit_log (created_at);
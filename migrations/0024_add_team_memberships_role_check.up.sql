@@ -0,0 +1,4 @@
+-- NOTE: роль в команде определяет права участника: maintainer мержит PR команды,
+-- lead ещё и переназначает ревьюверов и меняет активность участников команды
+alter table team_memberships
+	add constraint team_memberships_role_check check (role in ('member', 'maintainer', 'lead'));
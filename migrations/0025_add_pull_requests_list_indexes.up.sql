@@ -0,0 +1,19 @@
+-- NOTE: список PR листается по ключу (created_at, id), поэтому время создания обязательно;
+-- сервис всегда его записывает, пустым оно могло остаться только у PR до миграций
+update pull_requests
+set created_at = coalesce(merged_at, now())
+where created_at is null;
+
+alter table pull_requests
+	alter column created_at set not null;
+
+create index idx_pull_requests_created_at on pull_requests (created_at, id);
+
+create index idx_pull_requests_status_created_at on pull_requests (status, created_at, id);
+
+create index idx_pull_requests_author_id_created_at on pull_requests (author_id, created_at, id);
+
+create index idx_pull_requests_team_id_created_at on pull_requests (team_id, created_at, id);
+
+create index idx_pull_requests_merged_at on pull_requests (merged_at)
+	where merged_at is not null;
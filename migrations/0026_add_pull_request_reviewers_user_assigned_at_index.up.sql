@@ -0,0 +1,3 @@
+-- NOTE: PR ревьювера листаются по ключу (assigned_at, pull_request_id) среди текущих назначений
+create index idx_pull_request_reviewers_user_id_assigned_at on pull_request_reviewers (user_id, assigned_at, pull_request_id)
+	where unassigned_at is null;
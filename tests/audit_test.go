@@ -0,0 +1,272 @@
+//go:build integration
+
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"pr-manager-service/internal/domain"
+	"pr-manager-service/internal/generated/api"
+	"pr-manager-service/internal/http_server"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// withRequestID передаёт идентификатор запроса.
+func withRequestID(requestID string) api.RequestEditorFn {
+	return func(_ context.Context, req *http.Request) error {
+		req.Header.Set(http_server.RequestIDHeader, requestID)
+		return nil
+	}
+}
+
+func auditActions(entries []api.AuditEntry) []api.AuditAction {
+	return lo.Map(entries, func(entry api.AuditEntry, _ int) api.AuditAction { return entry.Action })
+}
+
+func TestAuditLog(t *testing.T) {
+	ctx := context.Background()
+
+	cleanupDB(ctx, t)
+	defer cleanupDB(ctx, t)
+
+	const (
+		teamName = "test name 1"
+
+		userID1 = "100"
+		userID2 = "101"
+		userID3 = "102"
+		userID4 = "103"
+
+		prID   = "100"
+		prName = "prname 1"
+	)
+
+	teamAddResp, err := client.PostTeamAddWithResponse(ctx, api.Team{
+		TeamName: teamName,
+		Members: []api.TeamMember{
+			{UserId: userID1, Username: "user1", IsActive: true},
+			{UserId: userID2, Username: "user2", IsActive: true},
+			{UserId: userID3, Username: "user3", IsActive: true},
+			{UserId: userID4, Username: "user4", IsActive: true},
+		},
+	}, withRequestID("req-team"))
+	require.NoError(t, err)
+	require.Equal(t, 201, teamAddResp.StatusCode())
+	assert.Equal(t, "req-team", teamAddResp.HTTPResponse.Header.Get(http_server.RequestIDHeader))
+
+	createResp, err := client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
+		AuthorId:        userID1,
+		PullRequestId:   prID,
+		PullRequestName: prName,
+	}, withToken(userToken(t, userID1, time.Hour)), withRequestID("req-create"))
+	require.NoError(t, err)
+	require.Equal(t, 201, createResp.StatusCode())
+	require.Len(t, createResp.JSON201.Pr.AssignedReviewers, 2)
+
+	oldReviewerID := createResp.JSON201.Pr.AssignedReviewers[0]
+	keptReviewerID := createResp.JSON201.Pr.AssignedReviewers[1]
+
+	// NOTE: повторное создание откатывается и не оставляет записи в журнале
+	duplicateResp, err := client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
+		AuthorId:        userID1,
+		PullRequestId:   prID,
+		PullRequestName: prName,
+	}, withToken(userToken(t, userID1, time.Hour)), withRequestID("req-duplicate"))
+	require.NoError(t, err)
+	require.Equal(t, 409, duplicateResp.StatusCode())
+
+	reassignResp, err := client.PostPullRequestReassignWithResponse(ctx, api.PostPullRequestReassignJSONRequestBody{
+		PullRequestId: prID,
+		OldUserId:     oldReviewerID,
+	}, withToken(userToken(t, userID1, time.Hour)), withRequestID("req-reassign"))
+	require.NoError(t, err)
+	require.Equal(t, 200, reassignResp.StatusCode())
+
+	newReviewerID := reassignResp.JSON200.ReplacedBy
+
+	mergeResp, err := client.PostPullRequestMergeWithResponse(ctx, api.PostPullRequestMergeJSONRequestBody{
+		PullRequestId: prID,
+	}, withToken(userToken(t, userID1, time.Hour)), withRequestID("req-merge"))
+	require.NoError(t, err)
+	require.Equal(t, 200, mergeResp.StatusCode())
+
+	// NOTE: без X-Request-ID идентификатор запроса генерируется
+	statusResp, err := client.PostUsersSetIsActiveWithResponse(ctx, api.PostUsersSetIsActiveJSONRequestBody{
+		UserId:   oldReviewerID,
+		IsActive: false,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 200, statusResp.StatusCode())
+	generatedRequestID := statusResp.HTTPResponse.Header.Get(http_server.RequestIDHeader)
+	require.NotEmpty(t, generatedRequestID)
+
+	t.Run("by_target", func(t *testing.T) {
+		resp, err := client.GetAuditWithResponse(ctx, &api.GetAuditParams{
+			TargetType: lo.ToPtr(api.AuditTargetTypePullRequest),
+			TargetId:   lo.ToPtr(prID),
+		})
+		require.NoError(t, err)
+		require.Equal(t, 200, resp.StatusCode())
+
+		entries := resp.JSON200.Entries
+		require.Equal(t, []api.AuditAction{
+			api.AuditActionPullRequestMerge,
+			api.AuditActionPullRequestReassign,
+			api.AuditActionPullRequestCreate,
+		}, auditActions(entries))
+		assert.Nil(t, resp.JSON200.NextCursor)
+
+		reassign := entries[1]
+		assert.Equal(t, lo.ToPtr(userID1), reassign.Actor)
+		assert.Equal(t, lo.ToPtr("req-reassign"), reassign.RequestId)
+
+		var before, after struct {
+			Status      string   `json:"status"`
+			ReviewerIDs []string `json:"reviewer_ids"`
+		}
+		require.NoError(t, remarshal(reassign.Before, &before))
+		require.NoError(t, remarshal(reassign.After, &after))
+		assert.Equal(t, []string{oldReviewerID, keptReviewerID}, before.ReviewerIDs)
+		assert.Equal(t, []string{newReviewerID, keptReviewerID}, after.ReviewerIDs)
+
+		create := entries[2]
+		assert.Nil(t, create.Before)
+		require.NoError(t, remarshal(create.After, &after))
+		assert.Equal(t, string(api.OPEN), after.Status)
+
+		require.NoError(t, remarshal(entries[0].After, &after))
+		assert.Equal(t, string(api.MERGED), after.Status)
+	})
+
+	t.Run("by_actor", func(t *testing.T) {
+		resp, err := client.GetAuditWithResponse(ctx, &api.GetAuditParams{
+			Actor: lo.ToPtr(domain.AdminActor),
+		})
+		require.NoError(t, err)
+		require.Equal(t, 200, resp.StatusCode())
+
+		require.Equal(t, []api.AuditAction{api.AuditActionUserChangeStatus, api.AuditActionTeamCreate}, auditActions(resp.JSON200.Entries))
+		entry := resp.JSON200.Entries[1]
+		assert.Equal(t, api.AuditTargetTypeTeam, entry.TargetType)
+		assert.Equal(t, teamName, entry.TargetId)
+		assert.Equal(t, lo.ToPtr("req-team"), entry.RequestId)
+	})
+
+	t.Run("generated_request_id", func(t *testing.T) {
+		resp, err := client.GetAuditWithResponse(ctx, &api.GetAuditParams{
+			TargetType: lo.ToPtr(api.AuditTargetTypeUser),
+			TargetId:   lo.ToPtr(oldReviewerID),
+		})
+		require.NoError(t, err)
+		require.Equal(t, 200, resp.StatusCode())
+
+		require.Len(t, resp.JSON200.Entries, 1)
+		entry := resp.JSON200.Entries[0]
+		assert.Equal(t, api.AuditActionUserChangeStatus, entry.Action)
+		assert.Equal(t, lo.ToPtr(generatedRequestID), entry.RequestId)
+	})
+
+	t.Run("pagination", func(t *testing.T) {
+		var (
+			actions []api.AuditAction
+			cursor  *int64
+		)
+
+		for range 10 {
+			resp, err := client.GetAuditWithResponse(ctx, &api.GetAuditParams{
+				Cursor: cursor,
+				Limit:  lo.ToPtr(2),
+			})
+			require.NoError(t, err)
+			require.Equal(t, 200, resp.StatusCode())
+
+			actions = append(actions, auditActions(resp.JSON200.Entries)...)
+			cursor = resp.JSON200.NextCursor
+			if cursor == nil {
+				break
+			}
+		}
+
+		assert.Equal(t, []api.AuditAction{
+			api.AuditActionUserChangeStatus,
+			api.AuditActionPullRequestMerge,
+			api.AuditActionPullRequestReassign,
+			api.AuditActionPullRequestCreate,
+			api.AuditActionTeamCreate,
+		}, actions)
+	})
+
+	t.Run("time_range", func(t *testing.T) {
+		resp, err := client.GetAuditWithResponse(ctx, &api.GetAuditParams{
+			From: lo.ToPtr(time.Now().Add(time.Hour)),
+		})
+		require.NoError(t, err)
+		require.Equal(t, 200, resp.StatusCode())
+		assert.Empty(t, resp.JSON200.Entries)
+
+		resp, err = client.GetAuditWithResponse(ctx, &api.GetAuditParams{
+			From: lo.ToPtr(time.Now().Add(-time.Hour)),
+			To:   lo.ToPtr(time.Now().Add(time.Hour)),
+		})
+		require.NoError(t, err)
+		require.Equal(t, 200, resp.StatusCode())
+		assert.Len(t, resp.JSON200.Entries, 5)
+	})
+
+	t.Run("team_settings", func(t *testing.T) {
+		settingsResp, err := client.PostTeamSetSettingsWithResponse(ctx, api.TeamSettings{
+			TeamName:          teamName,
+			MinReviewers:      1,
+			MaxReviewers:      2,
+			RequiredApprovals: lo.ToPtr(1),
+		})
+		require.NoError(t, err)
+		require.Equal(t, 200, settingsResp.StatusCode())
+
+		resp, err := client.GetAuditWithResponse(ctx, &api.GetAuditParams{
+			TargetType: lo.ToPtr(api.AuditTargetTypeTeam),
+			TargetId:   lo.ToPtr(teamName),
+		})
+		require.NoError(t, err)
+		require.Equal(t, 200, resp.StatusCode())
+
+		entries := resp.JSON200.Entries
+		require.Equal(t, []api.AuditAction{
+			api.AuditActionTeamUpdateSettings,
+			api.AuditActionTeamCreate,
+		}, auditActions(entries))
+
+		var before, after struct {
+			RequiredApprovals int `json:"required_approvals"`
+		}
+		require.NoError(t, remarshal(entries[0].Before, &before))
+		require.NoError(t, remarshal(entries[0].After, &after))
+		assert.Equal(t, 0, before.RequiredApprovals)
+		assert.Equal(t, 1, after.RequiredApprovals)
+	})
+
+	t.Run("invalid_limit", func(t *testing.T) {
+		resp, err := client.GetAuditWithResponse(ctx, &api.GetAuditParams{
+			Limit: lo.ToPtr(1000),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 400, resp.StatusCode())
+	})
+}
+
+// remarshal переводит состояние из записи аудита в dst.
+func remarshal(state any, dst any) error {
+	b, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, dst)
+}
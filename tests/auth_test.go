@@ -0,0 +1,110 @@
+//go:build integration
+
+package tests
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"pr-manager-service/internal/auth"
+	"pr-manager-service/internal/generated/api"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// withToken подменяет bearer-токен запроса.
+func withToken(token string) api.RequestEditorFn {
+	return func(_ context.Context, req *http.Request) error {
+		if token == "" {
+			req.Header.Del("Authorization")
+			return nil
+		}
+
+		req.Header.Set("Authorization", "Bearer "+token)
+		return nil
+	}
+}
+
+// userToken возвращает JWT пользователя userID, который действует ttl.
+func userToken(t *testing.T, userID string, ttl time.Duration) string {
+	t.Helper()
+
+	token, err := auth.SignJWT([]byte(testCfg.AuthJWTSecret), auth.Claims{
+		Subject:   userID,
+		ExpiresAt: time.Now().Add(ttl).Unix(),
+	})
+	require.NoError(t, err)
+
+	return token
+}
+
+func TestAuthentication(t *testing.T) {
+	ctx := context.Background()
+
+	cleanupDB(ctx, t)
+	defer cleanupDB(ctx, t)
+
+	const (
+		teamName = "test name 1"
+
+		userID1 = "100"
+		userID2 = "101"
+	)
+
+	team := api.Team{
+		TeamName: teamName,
+		Members: []api.TeamMember{
+			{UserId: userID1, Username: "user1", IsActive: true},
+			{UserId: userID2, Username: "user2", IsActive: true},
+		},
+	}
+
+	t.Run("missing_token", func(t *testing.T) {
+		resp, err := client.GetTeamGetWithResponse(ctx, &api.GetTeamGetParams{TeamName: teamName}, withToken(""))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusUnauthorized, resp.StatusCode())
+		assert.Equal(t, api.UNAUTHORIZED, resp.JSON401.Error.Code)
+	})
+
+	t.Run("invalid_token", func(t *testing.T) {
+		resp, err := client.GetTeamGetWithResponse(ctx, &api.GetTeamGetParams{TeamName: teamName}, withToken("garbage"))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusUnauthorized, resp.StatusCode())
+	})
+
+	t.Run("expired_token", func(t *testing.T) {
+		resp, err := client.GetTeamGetWithResponse(
+			ctx,
+			&api.GetTeamGetParams{TeamName: teamName},
+			withToken(userToken(t, userID1, -time.Minute)),
+		)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusUnauthorized, resp.StatusCode())
+	})
+
+	t.Run("user_token_on_admin_operation", func(t *testing.T) {
+		resp, err := client.PostTeamAddWithResponse(ctx, team, withToken(userToken(t, userID1, time.Hour)))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusForbidden, resp.StatusCode())
+		assert.Equal(t, api.FORBIDDEN, resp.JSON403.Error.Code)
+	})
+
+	t.Run("admin_token", func(t *testing.T) {
+		resp, err := client.PostTeamAddWithResponse(ctx, team)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusCreated, resp.StatusCode())
+	})
+
+	t.Run("user_token", func(t *testing.T) {
+		resp, err := client.GetTeamGetWithResponse(
+			ctx,
+			&api.GetTeamGetParams{TeamName: teamName},
+			withToken(userToken(t, userID2, time.Hour)),
+		)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode())
+	})
+}
@@ -0,0 +1,111 @@
+//go:build integration
+
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"pr-manager-service/internal/clock"
+	"pr-manager-service/internal/domain"
+	"pr-manager-service/internal/generated/api"
+	"pr-manager-service/internal/storage"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEscalateOverdueReviews(t *testing.T) {
+	ctx := context.Background()
+
+	cleanupDB(ctx, t)
+	defer cleanupDB(ctx, t)
+
+	const (
+		teamName = "test name 1"
+
+		userID1 = "100"
+		userID2 = "101"
+		userID3 = "102"
+
+		prID   = "100"
+		prName = "prname 1"
+	)
+
+	teamAddResp, err := client.PostTeamAdd(ctx, api.Team{
+		TeamName: teamName,
+		Members: []api.TeamMember{
+			{UserId: userID1, Username: "user1", IsActive: true},
+			{UserId: userID2, Username: "user2", IsActive: true},
+			{UserId: userID3, Username: "user3", IsActive: true},
+		},
+		MaxReviewers:   lo.ToPtr(1),
+		ReviewSlaHours: lo.ToPtr(1),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 201, teamAddResp.StatusCode)
+
+	createResp, err := client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
+		AuthorId:        userID1,
+		PullRequestId:   prID,
+		PullRequestName: prName,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 201, createResp.StatusCode())
+	require.Len(t, createResp.JSON201.Pr.AssignedReviewers, 1)
+
+	oldReviewerID := createResp.JSON201.Pr.AssignedReviewers[0]
+	newReviewerID := lo.Ternary(oldReviewerID == userID2, userID3, userID2)
+
+	// NOTE: сдвигаем назначение в прошлое, чтобы SLA гарантированно истёк
+	_, err = testDB.Exec(ctx, `
+		update pull_request_reviewers
+		set assigned_at = assigned_at - interval '30 days'
+		where pull_request_id = $1
+	`, prID)
+	require.NoError(t, err)
+
+	t.Run("locked_by_other_replica", func(t *testing.T) {
+		// NOTE: другая реплика держит блокировку назначения, пока не завершит транзакцию
+		require.NoError(t, testDB.BeginFunc(ctx, func(tx pgx.Tx) error {
+			locked, err := storage.NewStorage(tx, clock.New()).LockReviewAssignment(ctx, prID, oldReviewerID)
+			require.NoError(t, err)
+			require.True(t, locked)
+
+			result, err := testUsecases.EscalateOverdueReviews(ctx)
+			require.NoError(t, err)
+			assert.Empty(t, result.Reassigned)
+			assert.Empty(t, result.Escalated)
+			assert.Empty(t, result.Unresolved)
+
+			return nil
+		}))
+	})
+
+	t.Run("reassigned", func(t *testing.T) {
+		result, err := testUsecases.EscalateOverdueReviews(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, []domain.ReviewerReplacement{
+			{PullRequestID: prID, OldReviewerID: oldReviewerID, NewReviewerID: newReviewerID},
+		}, result.Reassigned)
+
+		pr, err := testStorage.GetPullRequestByID(ctx, prID)
+		require.NoError(t, err)
+		assert.Equal(t, []string{newReviewerID}, pr.ReviewersUsersIDs)
+
+		var reason string
+		require.NoError(t, testDB.QueryRow(ctx, `
+			select reason
+			from pull_request_reviewers
+			where pull_request_id = $1 and unassigned_at is null
+		`, prID).Scan(&reason))
+		assert.Equal(t, string(domain.AssignmentReasonEscalation), reason)
+
+		// NOTE: у нового ревьювера отсчёт SLA начался заново
+		result, err = testUsecases.EscalateOverdueReviews(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, result.Reassigned)
+	})
+}
@@ -0,0 +1,284 @@
+//go:build integration
+
+package tests
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"pr-manager-service/internal/domain"
+	"pr-manager-service/internal/generated/api"
+	"pr-manager-service/internal/http_server"
+
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const streamTimeout = 5 * time.Second
+
+// sseMessage - SSE-событие или комментарий (heartbeat) из потока.
+type sseMessage struct {
+	ID      string
+	Event   string
+	Data    string
+	Comment string
+}
+
+// readSSE читает поток в канал, пока тело ответа не закроется.
+func readSSE(body io.Reader) <-chan sseMessage {
+	messages := make(chan sseMessage)
+
+	go func() {
+		defer close(messages)
+
+		scanner := bufio.NewScanner(body)
+		message := sseMessage{}
+
+		for scanner.Scan() {
+			line := scanner.Text()
+
+			switch {
+			case line == "":
+				if message != (sseMessage{}) {
+					messages <- message
+				}
+				message = sseMessage{}
+			case strings.HasPrefix(line, ":"):
+				message.Comment = strings.TrimSpace(strings.TrimPrefix(line, ":"))
+			case strings.HasPrefix(line, "id:"):
+				message.ID = strings.TrimSpace(strings.TrimPrefix(line, "id:"))
+			case strings.HasPrefix(line, "event:"):
+				message.Event = strings.TrimSpace(strings.TrimPrefix(line, "event:"))
+			case strings.HasPrefix(line, "data:"):
+				message.Data = strings.TrimSpace(strings.TrimPrefix(line, "data:"))
+			}
+		}
+	}()
+
+	return messages
+}
+
+// nextEvent возвращает следующее SSE-событие, пропуская heartbeat.
+func nextEvent(t *testing.T, messages <-chan sseMessage) (sseMessage, domain.Event) {
+	t.Helper()
+
+	timeout := time.After(streamTimeout)
+	for {
+		select {
+		case message, ok := <-messages:
+			require.True(t, ok, "stream closed")
+			if message.Comment != "" {
+				continue
+			}
+
+			var event domain.Event
+			require.NoError(t, json.Unmarshal([]byte(message.Data), &event))
+
+			return message, event
+		case <-timeout:
+			require.FailNow(t, "no event in stream")
+		}
+	}
+}
+
+func openEventStream(t *testing.T, ctx context.Context, params api.GetEventsStreamParams) <-chan sseMessage {
+	t.Helper()
+
+	resp, err := client.GetEventsStream(ctx, &params)
+	require.NoError(t, err)
+	t.Cleanup(func() { resp.Body.Close() })
+
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	return readSSE(resp.Body)
+}
+
+func TestEventStream(t *testing.T) {
+	ctx := context.Background()
+
+	cleanupDB(ctx, t)
+	defer cleanupDB(ctx, t)
+
+	const (
+		teamName1 = "test name 1"
+		teamName2 = "test name 2"
+
+		userID1 = "100"
+		userID2 = "101"
+		userID3 = "102"
+		userID4 = "103"
+
+		prID1 = "100"
+		prID2 = "101"
+	)
+
+	for _, team := range []api.Team{
+		{
+			TeamName: teamName1,
+			Members: []api.TeamMember{
+				{UserId: userID1, Username: "user1", IsActive: true},
+				{UserId: userID2, Username: "user2", IsActive: true},
+			},
+		},
+		{
+			TeamName: teamName2,
+			Members: []api.TeamMember{
+				{UserId: userID3, Username: "user3", IsActive: true},
+				{UserId: userID4, Username: "user4", IsActive: true},
+			},
+		},
+	} {
+		resp, err := client.PostTeamAdd(ctx, team)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+	}
+
+	streamCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	userStream := openEventStream(t, streamCtx, api.GetEventsStreamParams{UserId: lo.ToPtr(userID2)})
+	teamStream := openEventStream(t, streamCtx, api.GetEventsStreamParams{TeamName: lo.ToPtr(teamName2)})
+
+	for _, pr := range []api.PostPullRequestCreateJSONRequestBody{
+		{AuthorId: userID1, PullRequestId: prID1, PullRequestName: "pr 1"},
+		{AuthorId: userID3, PullRequestId: prID2, PullRequestName: "pr 2"},
+	} {
+		resp, err := client.PostPullRequestCreateWithResponse(ctx, pr)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusCreated, resp.StatusCode())
+	}
+
+	mergeResp, err := client.PostPullRequestMergeWithResponse(ctx, api.PostPullRequestMergeJSONRequestBody{
+		PullRequestId: prID1,
+	})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, mergeResp.StatusCode())
+
+	// NOTE: в поток попадают только события, которые релей outbox уже передал получателям
+	_, err = testUsecases.RelayOutboxEvents(ctx)
+	require.NoError(t, err)
+
+	var lastUserEventID string
+
+	t.Run("user_filter", func(t *testing.T) {
+		message, event := nextEvent(t, userStream)
+		assert.Equal(t, string(domain.EventReviewersAssigned), message.Event)
+		assert.Equal(t, domain.ReviewersAssignedEvent{
+			PullRequestID: prID1,
+			ReviewerIDs:   []string{userID2},
+			Reason:        domain.AssignmentReasonInitial,
+		}, event.Data)
+
+		message, event = nextEvent(t, userStream)
+		assert.Equal(t, string(domain.EventPullRequestMerged), message.Event)
+		assert.Equal(t, domain.PullRequestMergedEvent{
+			PullRequestID: prID1,
+			AuthorID:      userID1,
+			ReviewerIDs:   []string{userID2},
+		}, event.Data)
+
+		lastUserEventID = message.ID
+	})
+
+	t.Run("team_filter", func(t *testing.T) {
+		message, event := nextEvent(t, teamStream)
+		assert.Equal(t, string(domain.EventReviewersAssigned), message.Event)
+		assert.Equal(t, prID2, event.Data.PullRequest())
+	})
+
+	t.Run("heartbeat", func(t *testing.T) {
+		timeout := time.After(streamTimeout)
+		for {
+			select {
+			case message, ok := <-teamStream:
+				require.True(t, ok, "stream closed")
+				if message.Comment == "heartbeat" {
+					return
+				}
+			case <-timeout:
+				require.FailNow(t, "no heartbeat in stream")
+			}
+		}
+	})
+
+	t.Run("resume", func(t *testing.T) {
+		require.NotEmpty(t, lastUserEventID)
+
+		lastEventID, err := strconv.ParseInt(lastUserEventID, 10, 64)
+		require.NoError(t, err)
+
+		// NOTE: с начала журнала поток повторяет события, пропущенные до подключения
+		stream := openEventStream(t, streamCtx, api.GetEventsStreamParams{
+			UserId:      lo.ToPtr(userID2),
+			LastEventID: lo.ToPtr(int64(0)),
+		})
+		_, event := nextEvent(t, stream)
+		assert.Equal(t, domain.EventReviewersAssigned, event.Type)
+
+		createResp, err := client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
+			AuthorId:        userID1,
+			PullRequestId:   "102",
+			PullRequestName: "pr 3",
+		})
+		require.NoError(t, err)
+		require.Equal(t, http.StatusCreated, createResp.StatusCode())
+
+		_, err = testUsecases.RelayOutboxEvents(ctx)
+		require.NoError(t, err)
+
+		// NOTE: после Last-Event-ID - только новые события
+		stream = openEventStream(t, streamCtx, api.GetEventsStreamParams{
+			UserId:      lo.ToPtr(userID2),
+			LastEventID: lo.ToPtr(lastEventID),
+		})
+		message, event := nextEvent(t, stream)
+		assert.Equal(t, domain.EventReviewersAssigned, event.Type)
+		assert.Equal(t, "102", event.Data.PullRequest())
+
+		seq, err := strconv.ParseInt(message.ID, 10, 64)
+		require.NoError(t, err)
+		assert.Greater(t, seq, lastEventID)
+	})
+
+	t.Run("not_found", func(t *testing.T) {
+		resp, err := client.GetEventsStreamWithResponse(ctx, &api.GetEventsStreamParams{TeamName: lo.ToPtr("unknown")})
+		require.NoError(t, err)
+		require.Equal(t, http.StatusNotFound, resp.StatusCode())
+	})
+
+	t.Run("close_streams", func(t *testing.T) {
+		httpServer := http_server.NewHttpServer(testUsecases)
+
+		router := gin.New()
+		api.RegisterHandlers(router, httpServer)
+
+		server := httptest.NewServer(router)
+		defer server.Close()
+
+		resp, err := http.Get(server.URL + "/events/stream")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		messages := readSSE(resp.Body)
+		httpServer.CloseStreams()
+
+		select {
+		case _, ok := <-messages:
+			assert.False(t, ok)
+		case <-time.After(streamTimeout):
+			require.FailNow(t, "stream was not closed")
+		}
+	})
+}
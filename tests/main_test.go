@@ -13,7 +13,9 @@ import (
 
 	"pr-manager-service/internal/app"
 	"pr-manager-service/internal/generated/api"
+	"pr-manager-service/internal/outbox"
 	"pr-manager-service/internal/storage"
+	"pr-manager-service/internal/usecases"
 
 	"github.com/jackc/pgx/v4/pgxpool"
 	"github.com/joho/godotenv"
@@ -25,6 +27,12 @@ var (
 	testDB      *pgxpool.Pool
 	client      *api.ClientWithResponses
 	testStorage *storage.Storage
+	// testUsecases - для сценариев без http-эндпоинта, например воркера эскалации
+	testUsecases *usecases.Usecases
+	// testEvents - события, которые релей outbox передал получателям
+	testEvents = outbox.NewMemorySink()
+	// testCfg - конфигурация сервиса, например ключ для JWT пользователей
+	testCfg *app.Config
 )
 
 func TestMain(m *testing.M) {
@@ -35,17 +43,19 @@ func TestMain(m *testing.M) {
 	ctx, cancel := context.WithCancel(context.Background())
 
 	cfg := app.InitConfig()
+	testCfg = cfg
 
 	pgContainer := initPostgresContainer(ctx, cfg)
 	slog.Debug("postgres container initiallized")
 
-	application, err := app.NewApp(ctx)
+	application, err := app.NewApp(ctx, app.WithEventSinks(testEvents))
 	if err != nil {
 		log.Fatalf("failed to NewApp: %s", err)
 	}
 
 	testDB = application.PostgresConn
 	testStorage = application.Storage
+	testUsecases = application.Usecases
 
 	serverErr := make(chan error, 1)
 
@@ -88,7 +98,8 @@ func TestMain(m *testing.M) {
 
 	slog.Debug("http server started")
 
-	c, err := api.NewClientWithResponses("http://" + cfg.Addr())
+	// NOTE: по умолчанию тесты обращаются к API с токеном администратора
+	c, err := api.NewClientWithResponses("http://"+cfg.Addr(), api.WithRequestEditorFn(withToken(cfg.AuthAdminToken)))
 	if err != nil {
 		log.Fatalf("failed to NewClient: %s", err.Error())
 	}
@@ -162,10 +173,14 @@ func initPostgresContainer(ctx context.Context, cfg *app.Config) testcontainers.
 
 func cleanupDB(ctx context.Context, t *testing.T) {
 	_, err := testDB.Exec(ctx, `
-        truncate table users, teams, pull_requests, users_stats, pull_requests_stats
+        truncate table users, teams, pull_requests, users_stats,
+            team_fallbacks, pull_request_reviewers, team_owner_rules, pull_request_reviews, team_memberships,
+            user_unavailability, webhook_subscriptions, webhook_deliveries, outbox, audit_log
         restart identity cascade;
     `)
 	if err != nil {
 		t.Fatalf("truncate failed: %v", err)
 	}
+
+	testEvents.Reset()
 }
@@ -0,0 +1,128 @@
+//go:build integration
+
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"pr-manager-service/internal/clock"
+	"pr-manager-service/internal/domain"
+	"pr-manager-service/internal/generated/api"
+	"pr-manager-service/internal/storage"
+	"pr-manager-service/internal/usecases"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// failingSink - получатель событий, который всегда возвращает ошибку.
+type failingSink struct{}
+
+func (failingSink) Publish(context.Context, []domain.Event) error {
+	return errors.New("sink unavailable")
+}
+
+func eventTypes(events []domain.Event) []domain.EventType {
+	return lo.Map(events, func(event domain.Event, _ int) domain.EventType { return event.Type })
+}
+
+func TestOutbox(t *testing.T) {
+	ctx := context.Background()
+
+	cleanupDB(ctx, t)
+	defer cleanupDB(ctx, t)
+
+	const (
+		teamName = "test name 1"
+
+		userID1 = "100"
+		userID2 = "101"
+
+		prID   = "100"
+		prName = "prname 1"
+	)
+
+	teamAddResp, err := client.PostTeamAdd(ctx, api.Team{
+		TeamName: teamName,
+		Members: []api.TeamMember{
+			{UserId: userID1, Username: "user1", IsActive: true},
+			{UserId: userID2, Username: "user2", IsActive: true},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 201, teamAddResp.StatusCode)
+
+	createResp, err := client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
+		AuthorId:        userID1,
+		PullRequestId:   prID,
+		PullRequestName: prName,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 201, createResp.StatusCode())
+
+	mergeResp, err := client.PostPullRequestMergeWithResponse(ctx, api.PostPullRequestMergeJSONRequestBody{
+		PullRequestId: prID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 200, mergeResp.StatusCode())
+
+	// NOTE: до прохода релея получатели событий не видят
+	assert.Empty(t, testEvents.Events())
+
+	t.Run("sink_error", func(t *testing.T) {
+		u := usecases.NewUsecases(testStorage, usecases.WithEventSinks(failingSink{}))
+
+		_, err := u.RelayOutboxEvents(ctx)
+		require.Error(t, err)
+
+		var pending int
+		require.NoError(t, testDB.QueryRow(ctx, `select count(*) from outbox where dispatched_at is null`).Scan(&pending))
+		assert.Equal(t, 2, pending)
+	})
+
+	t.Run("relayed_in_order", func(t *testing.T) {
+		relayed, err := testUsecases.RelayOutboxEvents(ctx)
+		require.NoError(t, err)
+
+		expectTypes := []domain.EventType{domain.EventReviewersAssigned, domain.EventPullRequestMerged}
+		assert.Equal(t, expectTypes, eventTypes(relayed))
+		assert.Equal(t, relayed, testEvents.Events())
+
+		assert.Equal(t, domain.PullRequestMergedEvent{
+			PullRequestID: prID,
+			AuthorID:      userID1,
+			ReviewerIDs:   []string{userID2},
+		}, relayed[1].Data)
+
+		// NOTE: переданные события повторно не передаются
+		relayed, err = testUsecases.RelayOutboxEvents(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, relayed)
+		assert.Len(t, testEvents.Events(), 2)
+	})
+
+	t.Run("rolled_back", func(t *testing.T) {
+		event := domain.Event{
+			ID:         "00000000-0000-0000-0000-000000000001",
+			Type:       domain.EventPullRequestMerged,
+			OccurredAt: time.Now().UTC(),
+			Data:       domain.PullRequestMergedEvent{PullRequestID: prID, AuthorID: userID1},
+		}
+
+		errRollback := errors.New("rollback")
+		err := testDB.BeginFunc(ctx, func(tx pgx.Tx) error {
+			require.NoError(t, storage.NewStorage(tx, clock.New()).CreateOutboxEvents(ctx, []domain.Event{event}))
+			return errRollback
+		})
+		require.ErrorIs(t, err, errRollback)
+
+		relayed, err := testUsecases.RelayOutboxEvents(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, relayed)
+	})
+}
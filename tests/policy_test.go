@@ -0,0 +1,178 @@
+//go:build integration
+
+package tests
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"pr-manager-service/internal/generated/api"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoleBasedAuthorization(t *testing.T) {
+	ctx := context.Background()
+
+	cleanupDB(ctx, t)
+	defer cleanupDB(ctx, t)
+
+	const (
+		teamName = "test name 1"
+		prID     = "pr-1"
+
+		authorID     = "100"
+		memberID     = "101"
+		maintainerID = "102"
+		leadID       = "103"
+		colleagueID  = "104"
+	)
+
+	teamResp, err := client.PostTeamAddWithResponse(ctx, api.Team{
+		TeamName: teamName,
+		Members: []api.TeamMember{
+			{UserId: authorID, Username: "author", IsActive: true},
+			{UserId: memberID, Username: "member", IsActive: true},
+			{UserId: maintainerID, Username: "maintainer", IsActive: true, Role: lo.ToPtr(api.Maintainer)},
+			{UserId: leadID, Username: "lead", IsActive: true, Role: lo.ToPtr(api.Lead)},
+			{UserId: colleagueID, Username: "colleague", IsActive: true},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, teamResp.StatusCode())
+
+	createResp, err := client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
+		AuthorId:        authorID,
+		PullRequestId:   prID,
+		PullRequestName: "pr",
+	})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, createResp.StatusCode())
+
+	as := func(userID string) api.RequestEditorFn {
+		return withToken(userToken(t, userID, time.Hour))
+	}
+
+	t.Run("reassign_by_member", func(t *testing.T) {
+		resp, err := client.PostPullRequestReassignWithResponse(ctx, api.PostPullRequestReassignJSONRequestBody{
+			PullRequestId: prID,
+			OldUserId:     createResp.JSON201.Pr.AssignedReviewers[0],
+		}, as(memberID))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusForbidden, resp.StatusCode())
+		assert.Equal(t, api.FORBIDDEN, resp.JSON403.Error.Code)
+	})
+
+	t.Run("reassign_by_lead", func(t *testing.T) {
+		resp, err := client.PostPullRequestReassignWithResponse(ctx, api.PostPullRequestReassignJSONRequestBody{
+			PullRequestId: prID,
+			OldUserId:     createResp.JSON201.Pr.AssignedReviewers[0],
+		}, as(leadID))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode())
+	})
+
+	t.Run("status_change_by_member", func(t *testing.T) {
+		resp, err := client.PostUsersSetIsActiveWithResponse(ctx, api.PostUsersSetIsActiveJSONRequestBody{
+			UserId:   colleagueID,
+			IsActive: false,
+		}, as(memberID))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusForbidden, resp.StatusCode())
+	})
+
+	t.Run("status_change_by_self", func(t *testing.T) {
+		resp, err := client.PostUsersSetIsActiveWithResponse(ctx, api.PostUsersSetIsActiveJSONRequestBody{
+			UserId:   colleagueID,
+			IsActive: false,
+		}, as(colleagueID))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode())
+	})
+
+	t.Run("status_change_by_lead", func(t *testing.T) {
+		resp, err := client.PostUsersSetIsActiveWithResponse(ctx, api.PostUsersSetIsActiveJSONRequestBody{
+			UserId:   colleagueID,
+			IsActive: true,
+		}, as(leadID))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode())
+		assert.True(t, resp.JSON200.User.IsActive)
+	})
+
+	t.Run("merge_by_member", func(t *testing.T) {
+		resp, err := client.PostPullRequestMergeWithResponse(ctx, api.PostPullRequestMergeJSONRequestBody{
+			PullRequestId: prID,
+		}, as(memberID))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusForbidden, resp.StatusCode())
+	})
+
+	t.Run("close_by_member", func(t *testing.T) {
+		resp, err := client.PostPullRequestCloseWithResponse(ctx, api.PostPullRequestCloseJSONRequestBody{
+			PullRequestId: prID,
+		}, as(memberID))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusForbidden, resp.StatusCode())
+	})
+
+	t.Run("review_for_another_user", func(t *testing.T) {
+		resp, err := client.PostPullRequestReviewWithResponse(ctx, api.PostPullRequestReviewJSONRequestBody{
+			PullRequestId: prID,
+			UserId:        createResp.JSON201.Pr.AssignedReviewers[1],
+			Verdict:       api.APPROVE,
+		}, as(memberID))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusForbidden, resp.StatusCode())
+	})
+
+	t.Run("create_for_another_author", func(t *testing.T) {
+		resp, err := client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
+			AuthorId:        authorID,
+			PullRequestId:   "pr-2",
+			PullRequestName: "pr",
+		}, as(memberID))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusForbidden, resp.StatusCode())
+	})
+
+	t.Run("working_hours_for_another_user", func(t *testing.T) {
+		resp, err := client.PostUsersSetWorkingHoursWithResponse(ctx, api.SetWorkingHoursRequest{
+			UserId:   colleagueID,
+			Timezone: "UTC",
+		}, as(memberID))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusForbidden, resp.StatusCode())
+	})
+
+	t.Run("unavailability_for_another_user", func(t *testing.T) {
+		resp, err := client.PostUsersAvailabilityWithResponse(ctx, api.CreateUnavailabilityRequest{
+			UserId:   colleagueID,
+			StartsAt: time.Now(),
+			EndsAt:   time.Now().Add(time.Hour),
+		}, as(memberID))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusForbidden, resp.StatusCode())
+	})
+
+	t.Run("unknown_user", func(t *testing.T) {
+		resp, err := client.PostPullRequestMergeWithResponse(ctx, api.PostPullRequestMergeJSONRequestBody{
+			PullRequestId: prID,
+		}, as("unknown"))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusForbidden, resp.StatusCode())
+	})
+
+	t.Run("merge_by_maintainer", func(t *testing.T) {
+		resp, err := client.PostPullRequestMergeWithResponse(ctx, api.PostPullRequestMergeJSONRequestBody{
+			PullRequestId: prID,
+		}, as(maintainerID))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode())
+		assert.Equal(t, api.MERGED, resp.JSON200.Pr.Status)
+	})
+}
@@ -0,0 +1,243 @@
+//go:build integration
+
+package tests
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"pr-manager-service/internal/generated/api"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func pullRequestIDs(pullRequests []api.PullRequest) []string {
+	return lo.Map(pullRequests, func(pr api.PullRequest, _ int) string {
+		return pr.PullRequestId
+	})
+}
+
+// listAllPullRequests листает список PR страницами по limit и возвращает id PR по страницам.
+func listAllPullRequests(t *testing.T, ctx context.Context, params api.GetPullRequestListParams) [][]string {
+	t.Helper()
+
+	var pages [][]string
+
+	for {
+		resp, err := client.GetPullRequestListWithResponse(ctx, &params)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode())
+
+		pages = append(pages, pullRequestIDs(resp.JSON200.PullRequests))
+
+		if resp.JSON200.NextCursor == nil {
+			return pages
+		}
+
+		params.Cursor = resp.JSON200.NextCursor
+	}
+}
+
+func TestListPullRequests(t *testing.T) {
+	ctx := context.Background()
+
+	cleanupDB(ctx, t)
+	defer cleanupDB(ctx, t)
+
+	const (
+		teamName = "test name 1"
+
+		userID1 = "100"
+		userID2 = "101"
+		userID3 = "102"
+	)
+
+	teamResp, err := client.PostTeamAddWithResponse(ctx, api.Team{
+		TeamName: teamName,
+		Members: []api.TeamMember{
+			{UserId: userID1, Username: "user1", IsActive: true},
+			{UserId: userID2, Username: "user2", IsActive: true},
+			{UserId: userID3, Username: "user3", IsActive: true},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, teamResp.StatusCode())
+
+	createPullRequest := func(prID, authorID string) {
+		resp, err := client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
+			AuthorId:        authorID,
+			PullRequestId:   prID,
+			PullRequestName: "pr " + prID,
+		})
+		require.NoError(t, err)
+		require.Equal(t, http.StatusCreated, resp.StatusCode())
+	}
+
+	for i := 1; i <= 7; i++ {
+		createPullRequest(fmt.Sprintf("pr-%02d", i), lo.Ternary(i%2 == 1, userID1, userID2))
+	}
+
+	// NOTE: у PR одинаковое время создания, порядок внутри группы задаёт id
+	older := time.Now().Add(-48 * time.Hour).Truncate(time.Second)
+	newer := time.Now().Add(-24 * time.Hour).Truncate(time.Second)
+
+	_, err = testDB.Exec(ctx, `update pull_requests set created_at = $1 where id = any($2)`,
+		older, []string{"pr-01", "pr-02", "pr-03"})
+	require.NoError(t, err)
+
+	_, err = testDB.Exec(ctx, `update pull_requests set created_at = $1 where id = any($2)`,
+		newer, []string{"pr-04", "pr-05", "pr-06", "pr-07"})
+	require.NoError(t, err)
+
+	t.Run("pages_are_ordered_and_disjoint", func(t *testing.T) {
+		pages := listAllPullRequests(t, ctx, api.GetPullRequestListParams{Limit: lo.ToPtr(3)})
+
+		assert.Equal(t, [][]string{
+			{"pr-07", "pr-06", "pr-05"},
+			{"pr-04", "pr-03", "pr-02"},
+			{"pr-01"},
+		}, pages)
+	})
+
+	t.Run("filters", func(t *testing.T) {
+		for _, prID := range []string{"pr-02", "pr-05"} {
+			resp, err := client.PostPullRequestMergeWithResponse(ctx, api.PostPullRequestMergeJSONRequestBody{
+				PullRequestId: prID,
+			})
+			require.NoError(t, err)
+			require.Equal(t, http.StatusOK, resp.StatusCode())
+		}
+
+		getPR, err := client.GetPullRequestListWithResponse(ctx, &api.GetPullRequestListParams{})
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, getPR.StatusCode())
+
+		pr1, ok := lo.Find(getPR.JSON200.PullRequests, func(pr api.PullRequest) bool {
+			return pr.PullRequestId == "pr-01"
+		})
+		require.True(t, ok)
+		require.NotEmpty(t, pr1.AssignedReviewers)
+
+		reviewerID := pr1.AssignedReviewers[0]
+		reviewed := lo.FilterMap(getPR.JSON200.PullRequests, func(pr api.PullRequest, _ int) (string, bool) {
+			return pr.PullRequestId, lo.Contains(pr.AssignedReviewers, reviewerID)
+		})
+
+		tests := []struct {
+			name     string
+			params   api.GetPullRequestListParams
+			expected []string
+		}{
+			{
+				name:     "status",
+				params:   api.GetPullRequestListParams{Status: &[]api.PullRequestStatus{api.MERGED}},
+				expected: []string{"pr-05", "pr-02"},
+			},
+			{
+				name:     "several_statuses",
+				params:   api.GetPullRequestListParams{Status: &[]api.PullRequestStatus{api.MERGED, api.OPEN}},
+				expected: []string{"pr-07", "pr-06", "pr-05", "pr-04", "pr-03", "pr-02", "pr-01"},
+			},
+			{
+				name:     "author",
+				params:   api.GetPullRequestListParams{AuthorId: lo.ToPtr(userID2)},
+				expected: []string{"pr-06", "pr-04", "pr-02"},
+			},
+			{
+				name: "author_and_status",
+				params: api.GetPullRequestListParams{
+					AuthorId: lo.ToPtr(userID1),
+					Status:   &[]api.PullRequestStatus{api.MERGED},
+				},
+				expected: []string{"pr-05"},
+			},
+			{
+				name:     "reviewer",
+				params:   api.GetPullRequestListParams{ReviewerId: lo.ToPtr(reviewerID)},
+				expected: reviewed,
+			},
+			{
+				name:     "team",
+				params:   api.GetPullRequestListParams{TeamName: lo.ToPtr(teamName), Limit: lo.ToPtr(2)},
+				expected: []string{"pr-07", "pr-06"},
+			},
+			{
+				name: "created_range",
+				params: api.GetPullRequestListParams{
+					CreatedFrom: lo.ToPtr(older),
+					CreatedTo:   lo.ToPtr(newer),
+				},
+				expected: []string{"pr-03", "pr-02", "pr-01"},
+			},
+			{
+				name:     "merged_from",
+				params:   api.GetPullRequestListParams{MergedFrom: lo.ToPtr(time.Now().Add(-time.Hour))},
+				expected: []string{"pr-05", "pr-02"},
+			},
+			{
+				name:     "merged_to",
+				params:   api.GetPullRequestListParams{MergedTo: lo.ToPtr(time.Now().Add(-time.Hour))},
+				expected: []string{},
+			},
+		}
+
+		for _, tc := range tests {
+			t.Run(tc.name, func(t *testing.T) {
+				resp, err := client.GetPullRequestListWithResponse(ctx, &tc.params)
+				require.NoError(t, err)
+				require.Equal(t, http.StatusOK, resp.StatusCode())
+
+				assert.Equal(t, tc.expected, pullRequestIDs(resp.JSON200.PullRequests))
+			})
+		}
+	})
+
+	t.Run("filtered_pages", func(t *testing.T) {
+		pages := listAllPullRequests(t, ctx, api.GetPullRequestListParams{
+			AuthorId: lo.ToPtr(userID1),
+			Limit:    lo.ToPtr(2),
+		})
+
+		assert.Equal(t, [][]string{{"pr-07", "pr-05"}, {"pr-03", "pr-01"}}, pages)
+	})
+
+	t.Run("new_pull_requests_do_not_shift_pages", func(t *testing.T) {
+		firstResp, err := client.GetPullRequestListWithResponse(ctx, &api.GetPullRequestListParams{Limit: lo.ToPtr(3)})
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, firstResp.StatusCode())
+		require.NotNil(t, firstResp.JSON200.NextCursor)
+
+		createPullRequest("pr-08", userID1)
+
+		secondResp, err := client.GetPullRequestListWithResponse(ctx, &api.GetPullRequestListParams{
+			Limit:  lo.ToPtr(3),
+			Cursor: firstResp.JSON200.NextCursor,
+		})
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, secondResp.StatusCode())
+
+		assert.Equal(t, []string{"pr-04", "pr-03", "pr-02"}, pullRequestIDs(secondResp.JSON200.PullRequests))
+	})
+
+	t.Run("unknown_team", func(t *testing.T) {
+		resp, err := client.GetPullRequestListWithResponse(ctx, &api.GetPullRequestListParams{
+			TeamName: lo.ToPtr("unknown team"),
+		})
+		require.NoError(t, err)
+		require.Equal(t, http.StatusNotFound, resp.StatusCode())
+	})
+
+	t.Run("invalid_cursor", func(t *testing.T) {
+		resp, err := client.GetPullRequestListWithResponse(ctx, &api.GetPullRequestListParams{
+			Cursor: lo.ToPtr("garbage!"),
+		})
+		require.NoError(t, err)
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode())
+		assert.Equal(t, api.VALIDATIONERR, resp.JSON400.Error.Code)
+	})
+}
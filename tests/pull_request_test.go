@@ -153,4 +153,577 @@ func TestPullRequestCreate(t *testing.T) {
 
 		assert.Equal(t, 2, usersWithOneAssignment)
 	})
+
+	t.Run("team_reviewers_count", func(t *testing.T) {
+		cleanupDB(ctx, t)
+		defer cleanupDB(ctx, t)
+
+		const (
+			teamName1 = "test name 1"
+
+			userID1 = "100"
+			userID2 = "101"
+			userID3 = "102"
+
+			prID1   = "100"
+			prID2   = "101"
+			prName1 = "prname 1"
+			prName2 = "prname 2"
+		)
+
+		apiTeam1 := api.Team{
+			TeamName: teamName1,
+			Members: []api.TeamMember{
+				{UserId: userID1, Username: "user1", IsActive: true},
+				{UserId: userID2, Username: "user2", IsActive: true},
+				{UserId: userID3, Username: "user3", IsActive: true},
+			},
+			MinReviewers: lo.ToPtr(1),
+			MaxReviewers: lo.ToPtr(1),
+		}
+
+		teamAddResp, err := client.PostTeamAdd(ctx, apiTeam1)
+		require.NoError(t, err)
+		require.Equal(t, 201, teamAddResp.StatusCode)
+
+		// NOTE: команде нужен один ревьювер
+		createResp1, err := client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
+			AuthorId:        userID1,
+			PullRequestId:   prID1,
+			PullRequestName: prName1,
+		})
+		require.NoError(t, err)
+		require.Equal(t, 201, createResp1.StatusCode())
+		assert.Len(t, createResp1.JSON201.Pr.AssignedReviewers, 1)
+		assert.False(t, createResp1.JSON201.Pr.NeedsMoreReviewers)
+
+		// NOTE: в команде меньше активных участников, чем минимум
+		settingsResp, err := client.PostTeamSetSettingsWithResponse(ctx, api.TeamSettings{
+			TeamName:     teamName1,
+			MinReviewers: 3,
+			MaxReviewers: 3,
+		})
+		require.NoError(t, err)
+		require.Equal(t, 200, settingsResp.StatusCode())
+
+		createResp2, err := client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
+			AuthorId:        userID1,
+			PullRequestId:   prID2,
+			PullRequestName: prName2,
+		})
+		require.NoError(t, err)
+		require.Equal(t, 201, createResp2.StatusCode())
+		assert.ElementsMatch(t, []string{userID2, userID3}, createResp2.JSON201.Pr.AssignedReviewers)
+		assert.True(t, createResp2.JSON201.Pr.NeedsMoreReviewers)
+
+		domainPullRequest, err := testStorage.GetPullRequestByID(ctx, prID2)
+		require.NoError(t, err)
+		assert.True(t, domainPullRequest.NeedsMoreReviewers)
+	})
+	t.Run("fallback_team", func(t *testing.T) {
+		cleanupDB(ctx, t)
+		defer cleanupDB(ctx, t)
+
+		const (
+			teamName1 = "test name 1"
+			teamName2 = "test name 2"
+
+			userID1 = "100"
+			userID2 = "101"
+			userID3 = "102"
+
+			prID   = "100"
+			prName = "prname 1"
+		)
+
+		// NOTE: в команде автора нет активных кандидатов
+		teamAddResp, err := client.PostTeamAdd(ctx, api.Team{
+			TeamName: teamName1,
+			Members: []api.TeamMember{
+				{UserId: userID1, Username: "user1", IsActive: true},
+				{UserId: userID2, Username: "user2", IsActive: false},
+			},
+		})
+		require.NoError(t, err)
+		require.Equal(t, 201, teamAddResp.StatusCode)
+
+		teamAddResp, err = client.PostTeamAdd(ctx, api.Team{
+			TeamName: teamName2,
+			Members: []api.TeamMember{
+				{UserId: userID3, Username: "user3", IsActive: true},
+			},
+		})
+		require.NoError(t, err)
+		require.Equal(t, 201, teamAddResp.StatusCode)
+
+		fallbacksResp, err := client.PostTeamSetFallbacksWithResponse(ctx, api.TeamFallbacks{
+			TeamName:          teamName1,
+			FallbackTeamNames: []string{teamName2},
+		})
+		require.NoError(t, err)
+		require.Equal(t, 200, fallbacksResp.StatusCode())
+		assert.Equal(t, []string{teamName2}, fallbacksResp.JSON200.Fallbacks.FallbackTeamNames)
+
+		createResp, err := client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
+			AuthorId:        userID1,
+			PullRequestId:   prID,
+			PullRequestName: prName,
+		})
+		require.NoError(t, err)
+		require.Equal(t, 201, createResp.StatusCode())
+
+		createdPrApi := createResp.JSON201.Pr
+		assert.Equal(t, []string{userID3}, createdPrApi.AssignedReviewers)
+		assert.False(t, createdPrApi.NeedsMoreReviewers)
+		require.Len(t, createdPrApi.ReviewerPools, 1)
+		assert.NotNil(t, createdPrApi.ReviewerPools[0].AssignedAt)
+		assert.Equal(t, api.ReviewerPool{
+			UserId: userID3, TeamName: teamName2, IsFallback: true, IsOverdue: lo.ToPtr(false),
+		}, withoutAssignedAt(createdPrApi.ReviewerPools[0]))
+
+		domainPullRequest, err := testStorage.GetPullRequestByID(ctx, prID)
+		require.NoError(t, err)
+		require.Contains(t, domainPullRequest.ReviewerPools, userID3)
+		pool := domainPullRequest.ReviewerPools[userID3]
+		assert.False(t, pool.AssignedAt.IsZero())
+		assert.Equal(t, teamName2, pool.TeamName)
+		assert.True(t, pool.IsFallback)
+	})
+	t.Run("code_owners", func(t *testing.T) {
+		cleanupDB(ctx, t)
+		defer cleanupDB(ctx, t)
+
+		const (
+			teamName1 = "test name 1"
+			teamName2 = "test name 2"
+
+			userID1 = "100"
+			userID2 = "101"
+			userID3 = "102"
+			userID4 = "103"
+
+			prID   = "100"
+			prName = "prname 1"
+		)
+
+		teamAddResp, err := client.PostTeamAdd(ctx, api.Team{
+			TeamName: teamName1,
+			Members: []api.TeamMember{
+				{UserId: userID1, Username: "user1", IsActive: true},
+				{UserId: userID2, Username: "user2", IsActive: true},
+				{UserId: userID3, Username: "user3", IsActive: true},
+			},
+			MaxReviewers: lo.ToPtr(2),
+		})
+		require.NoError(t, err)
+		require.Equal(t, 201, teamAddResp.StatusCode)
+
+		teamAddResp, err = client.PostTeamAdd(ctx, api.Team{
+			TeamName: teamName2,
+			Members: []api.TeamMember{
+				{UserId: userID4, Username: "user4", IsActive: true},
+			},
+		})
+		require.NoError(t, err)
+		require.Equal(t, 201, teamAddResp.StatusCode)
+
+		ownersResp, err := client.PostTeamOwnersWithResponse(ctx, api.TeamOwners{
+			TeamName: teamName1,
+			Rules: []api.OwnerRule{
+				{Pattern: "*", Owners: []string{userID2}},
+				{Pattern: "*.sql", Owners: []string{"@" + teamName2}},
+			},
+		})
+		require.NoError(t, err)
+		require.Equal(t, 200, ownersResp.StatusCode())
+
+		// NOTE: владелец sql-файлов - другая команда, её участник назначается первым
+		createResp, err := client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
+			AuthorId:        userID1,
+			PullRequestId:   prID,
+			PullRequestName: prName,
+			ChangedFiles:    &[]string{"migrations/0001_init.up.sql"},
+		})
+		require.NoError(t, err)
+		require.Equal(t, 201, createResp.StatusCode())
+
+		createdPrApi := createResp.JSON201.Pr
+		require.Len(t, createdPrApi.AssignedReviewers, 2)
+		assert.Equal(t, userID4, createdPrApi.AssignedReviewers[0])
+		assert.Contains(t, []string{userID2, userID3}, createdPrApi.AssignedReviewers[1])
+		assert.Equal(t, api.ReviewerPool{
+			UserId:    userID4,
+			TeamName:  teamName2,
+			IsOwner:   true,
+			IsOverdue: lo.ToPtr(false),
+		}, withoutAssignedAt(createdPrApi.ReviewerPools[0]))
+		assert.False(t, createdPrApi.ReviewerPools[1].IsOwner)
+	})
+}
+
+func TestPullRequestLifecycle(t *testing.T) {
+	ctx := context.Background()
+
+	cleanupDB(ctx, t)
+	defer cleanupDB(ctx, t)
+
+	const (
+		teamName = "test name 1"
+
+		userID1 = "100"
+		userID2 = "101"
+		userID3 = "102"
+
+		prID   = "100"
+		prName = "prname 1"
+	)
+
+	teamAddResp, err := client.PostTeamAdd(ctx, api.Team{
+		TeamName: teamName,
+		Members: []api.TeamMember{
+			{UserId: userID1, Username: "user1", IsActive: true},
+			{UserId: userID2, Username: "user2", IsActive: true},
+			{UserId: userID3, Username: "user3", IsActive: true},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 201, teamAddResp.StatusCode)
+
+	// NOTE: черновик создаётся без ревьюверов
+	createResp, err := client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
+		AuthorId:        userID1,
+		PullRequestId:   prID,
+		PullRequestName: prName,
+		Draft:           lo.ToPtr(true),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 201, createResp.StatusCode())
+	assert.Equal(t, string(api.DRAFT), createResp.JSON201.Pr.Status)
+	assert.Empty(t, createResp.JSON201.Pr.AssignedReviewers)
+
+	// NOTE: черновик нельзя смержить
+	mergeResp, err := client.PostPullRequestMergeWithResponse(ctx, api.PostPullRequestMergeJSONRequestBody{
+		PullRequestId: prID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 409, mergeResp.StatusCode())
+	assert.Equal(t, api.INVALIDTRANSITION, mergeResp.JSON409.Error.Code)
+
+	readyResp, err := client.PostPullRequestMarkReadyWithResponse(ctx, api.PostPullRequestMarkReadyJSONRequestBody{
+		PullRequestId: prID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 200, readyResp.StatusCode())
+	assert.Equal(t, string(api.OPEN), readyResp.JSON200.Pr.Status)
+	assert.ElementsMatch(t, []string{userID2, userID3}, readyResp.JSON200.Pr.AssignedReviewers)
+
+	// NOTE: повторный markReady запрещён
+	readyResp, err = client.PostPullRequestMarkReadyWithResponse(ctx, api.PostPullRequestMarkReadyJSONRequestBody{
+		PullRequestId: prID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 409, readyResp.StatusCode())
+
+	closeResp, err := client.PostPullRequestCloseWithResponse(ctx, api.PostPullRequestCloseJSONRequestBody{
+		PullRequestId: prID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 200, closeResp.StatusCode())
+	assert.Equal(t, string(api.CLOSED), closeResp.JSON200.Pr.Status)
+
+	// NOTE: в закрытом PR нельзя переназначать ревьюверов
+	reassignResp, err := client.PostPullRequestReassignWithResponse(ctx, api.PostPullRequestReassignJSONRequestBody{
+		PullRequestId: prID,
+		OldUserId:     userID2,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 409, reassignResp.StatusCode())
+	assert.Equal(t, api.PRNOTACTIVE, reassignResp.JSON409.Error.Code)
+
+	reopenResp, err := client.PostPullRequestReopenWithResponse(ctx, api.PostPullRequestReopenJSONRequestBody{
+		PullRequestId: prID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 200, reopenResp.StatusCode())
+	assert.Equal(t, string(api.REOPENED), reopenResp.JSON200.Pr.Status)
+	assert.ElementsMatch(t, []string{userID2, userID3}, reopenResp.JSON200.Pr.AssignedReviewers)
+
+	mergeResp, err = client.PostPullRequestMergeWithResponse(ctx, api.PostPullRequestMergeJSONRequestBody{
+		PullRequestId: prID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 200, mergeResp.StatusCode())
+	assert.Equal(t, string(api.MERGED), mergeResp.JSON200.Pr.Status)
+
+	// NOTE: статистика назначений учитывает ревьюверов, назначенных при markReady
+	statsResp, err := client.GetStatsGetWithResponse(ctx)
+	require.NoError(t, err)
+	require.Len(t, statsResp.JSON200.PullRequestsStats, 1)
+	assert.Equal(t, 2, statsResp.JSON200.PullRequestsStats[0].AssignmentsCount)
+}
+
+func TestPullRequestReviews(t *testing.T) {
+	ctx := context.Background()
+
+	cleanupDB(ctx, t)
+	defer cleanupDB(ctx, t)
+
+	const (
+		teamName = "test name 1"
+
+		userID1 = "100"
+		userID2 = "101"
+		userID3 = "102"
+
+		prID   = "100"
+		prName = "prname 1"
+	)
+
+	teamAddResp, err := client.PostTeamAdd(ctx, api.Team{
+		TeamName: teamName,
+		Members: []api.TeamMember{
+			{UserId: userID1, Username: "user1", IsActive: true},
+			{UserId: userID2, Username: "user2", IsActive: true},
+			{UserId: userID3, Username: "user3", IsActive: true},
+		},
+		RequiredApprovals: lo.ToPtr(2),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 201, teamAddResp.StatusCode)
+
+	createResp, err := client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
+		AuthorId:        userID1,
+		PullRequestId:   prID,
+		PullRequestName: prName,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 201, createResp.StatusCode())
+	require.ElementsMatch(t, []string{userID2, userID3}, createResp.JSON201.Pr.AssignedReviewers)
+
+	review := func(userID string, verdict api.ReviewVerdict) *api.PostPullRequestReviewResponse {
+		resp, err := client.PostPullRequestReviewWithResponse(ctx, api.PostPullRequestReviewJSONRequestBody{
+			PullRequestId: prID,
+			UserId:        userID,
+			Verdict:       verdict,
+		})
+		require.NoError(t, err)
+
+		return resp
+	}
+
+	merge := func() *api.PostPullRequestMergeResponse {
+		resp, err := client.PostPullRequestMergeWithResponse(ctx, api.PostPullRequestMergeJSONRequestBody{
+			PullRequestId: prID,
+		})
+		require.NoError(t, err)
+
+		return resp
+	}
+
+	// NOTE: автор не назначен ревьювером
+	reviewResp := review(userID1, api.APPROVE)
+	require.Equal(t, 409, reviewResp.StatusCode())
+	assert.Equal(t, api.NOTASSIGNED, reviewResp.JSON409.Error.Code)
+
+	reviewResp = review(userID2, api.APPROVE)
+	require.Equal(t, 200, reviewResp.StatusCode())
+	assert.Equal(t, api.APPROVE, reviewResp.JSON200.Review.Verdict)
+	assert.Equal(t, 1, reviewResp.JSON200.Approvals)
+
+	reviewResp = review(userID3, api.REQUESTCHANGES)
+	require.Equal(t, 200, reviewResp.StatusCode())
+	assert.Equal(t, 1, reviewResp.JSON200.Approvals)
+
+	mergeResp := merge()
+	require.Equal(t, 409, mergeResp.StatusCode())
+	assert.Equal(t, api.NOTENOUGHAPPROVALS, mergeResp.JSON409.Error.Code)
+
+	// NOTE: комментарий не отменяет предыдущее решение
+	reviewResp = review(userID2, api.COMMENT)
+	require.Equal(t, 200, reviewResp.StatusCode())
+	assert.Equal(t, 1, reviewResp.JSON200.Approvals)
+
+	reviewResp = review(userID3, api.APPROVE)
+	require.Equal(t, 200, reviewResp.StatusCode())
+	assert.Equal(t, 2, reviewResp.JSON200.Approvals)
+
+	mergeResp = merge()
+	require.Equal(t, 200, mergeResp.StatusCode())
+	assert.Equal(t, string(api.MERGED), mergeResp.JSON200.Pr.Status)
+
+	reviewResp = review(userID2, api.APPROVE)
+	require.Equal(t, 409, reviewResp.StatusCode())
+	assert.Equal(t, api.PRMERGED, reviewResp.JSON409.Error.Code)
+}
+
+func TestPullRequestReassignHistory(t *testing.T) {
+	ctx := context.Background()
+
+	cleanupDB(ctx, t)
+	defer cleanupDB(ctx, t)
+
+	const (
+		teamName = "test name 1"
+
+		userID1 = "100"
+		userID2 = "101"
+		userID3 = "102"
+		userID4 = "103"
+
+		prID   = "100"
+		prName = "prname 1"
+	)
+
+	teamAddResp, err := client.PostTeamAdd(ctx, api.Team{
+		TeamName: teamName,
+		Members: []api.TeamMember{
+			{UserId: userID1, Username: "user1", IsActive: true},
+			{UserId: userID2, Username: "user2", IsActive: true},
+			{UserId: userID3, Username: "user3", IsActive: true},
+			{UserId: userID4, Username: "user4", IsActive: true},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 201, teamAddResp.StatusCode)
+
+	createResp, err := client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
+		AuthorId:        userID1,
+		PullRequestId:   prID,
+		PullRequestName: prName,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 201, createResp.StatusCode())
+	require.Len(t, createResp.JSON201.Pr.AssignedReviewers, 2)
+
+	oldReviewerID := createResp.JSON201.Pr.AssignedReviewers[0]
+	keptReviewerID := createResp.JSON201.Pr.AssignedReviewers[1]
+
+	reassignResp, err := client.PostPullRequestReassignWithResponse(ctx, api.PostPullRequestReassignJSONRequestBody{
+		PullRequestId: prID,
+		OldUserId:     oldReviewerID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 200, reassignResp.StatusCode())
+
+	newReviewerID := reassignResp.JSON200.ReplacedBy
+	assert.Equal(t, []string{keptReviewerID, newReviewerID}, reassignResp.JSON200.Pr.AssignedReviewers)
+
+	// NOTE: снятый ревьювер остаётся в истории назначений
+	rows, err := testDB.Query(ctx, `
+		select user_id, reason, coalesce(unassign_reason, ''), unassigned_at is not null
+		from pull_request_reviewers
+		where pull_request_id = $1
+		order by id
+	`, prID)
+	require.NoError(t, err)
+	defer rows.Close()
+
+	type historyRow struct {
+		userID         string
+		reason         string
+		unassignReason string
+		unassigned     bool
+	}
+
+	history := []historyRow{}
+	for rows.Next() {
+		row := historyRow{}
+		require.NoError(t, rows.Scan(&row.userID, &row.reason, &row.unassignReason, &row.unassigned))
+		history = append(history, row)
+	}
+	require.NoError(t, rows.Err())
+
+	assert.Equal(t, []historyRow{
+		{userID: oldReviewerID, reason: "initial", unassignReason: "reassign", unassigned: true},
+		{userID: keptReviewerID, reason: "initial"},
+		{userID: newReviewerID, reason: "reassign"},
+	}, history)
+
+	// NOTE: статистика учитывает все назначения, включая снятые
+	statsResp, err := client.GetStatsGetWithResponse(ctx)
+	require.NoError(t, err)
+	require.Len(t, statsResp.JSON200.PullRequestsStats, 1)
+	assert.Equal(t, 3, statsResp.JSON200.PullRequestsStats[0].AssignmentsCount)
+
+	getReviewResp, err := client.GetUsersGetReviewWithResponse(ctx, &api.GetUsersGetReviewParams{
+		UserId: oldReviewerID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 200, getReviewResp.StatusCode())
+	assert.Empty(t, getReviewResp.JSON200.PullRequests)
+}
+
+// withoutAssignedAt обнуляет время назначения, которое зависит от момента запуска теста.
+func withoutAssignedAt(pool api.ReviewerPool) api.ReviewerPool {
+	pool.AssignedAt = nil
+	return pool
+}
+
+func TestPullRequestOverdue(t *testing.T) {
+	ctx := context.Background()
+
+	cleanupDB(ctx, t)
+	defer cleanupDB(ctx, t)
+
+	const (
+		teamName = "test name 1"
+
+		userID1 = "100"
+		userID2 = "101"
+
+		prID   = "100"
+		prName = "prname 1"
+	)
+
+	teamAddResp, err := client.PostTeamAdd(ctx, api.Team{
+		TeamName: teamName,
+		Members: []api.TeamMember{
+			{UserId: userID1, Username: "user1", IsActive: true},
+			{UserId: userID2, Username: "user2", IsActive: true},
+		},
+		ReviewSlaHours: lo.ToPtr(1),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 201, teamAddResp.StatusCode)
+
+	createResp, err := client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
+		AuthorId:        userID1,
+		PullRequestId:   prID,
+		PullRequestName: prName,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 201, createResp.StatusCode())
+	assert.False(t, createResp.JSON201.Pr.IsOverdue)
+
+	params := &api.GetPullRequestOverdueParams{TeamName: lo.ToPtr(teamName)}
+
+	overdueResp, err := client.GetPullRequestOverdueWithResponse(ctx, params)
+	require.NoError(t, err)
+	require.Equal(t, 200, overdueResp.StatusCode())
+	assert.Empty(t, overdueResp.JSON200.PullRequests)
+
+	// NOTE: сдвигаем назначение в прошлое, чтобы SLA гарантированно истёк
+	_, err = testDB.Exec(ctx, `
+		update pull_request_reviewers
+		set assigned_at = assigned_at - interval '30 days'
+		where pull_request_id = $1
+	`, prID)
+	require.NoError(t, err)
+
+	overdueResp, err = client.GetPullRequestOverdueWithResponse(ctx, params)
+	require.NoError(t, err)
+	require.Equal(t, 200, overdueResp.StatusCode())
+	require.Len(t, overdueResp.JSON200.PullRequests, 1)
+
+	overduePr := overdueResp.JSON200.PullRequests[0]
+	assert.Equal(t, prID, overduePr.PullRequestId)
+	assert.True(t, overduePr.IsOverdue)
+	require.Len(t, overduePr.ReviewerPools, 1)
+	assert.Equal(t, lo.ToPtr(true), overduePr.ReviewerPools[0].IsOverdue)
+
+	unknownResp, err := client.GetPullRequestOverdueWithResponse(ctx, &api.GetPullRequestOverdueParams{
+		TeamName: lo.ToPtr("unknown"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 404, unknownResp.StatusCode())
 }
@@ -11,6 +11,7 @@ import (
 	"pr-manager-service/internal/domain"
 	"pr-manager-service/internal/generated/api"
 
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -60,7 +61,7 @@ func TestGetTeam(t *testing.T) {
 		require.NotNil(t, teamAddResp.JSON201)
 		require.NotNil(t, teamAddResp.JSON201.Team)
 		require.Nil(t, teamAddResp.JSON400)
-		assert.Equal(t, teamAddResp.JSON201.Team, apiTeam)
+		assert.Equal(t, teamAddResp.JSON201.Team, createdTeam(apiTeam))
 
 		getTeamResp, err := client.GetTeamGetWithResponse(ctx, &api.GetTeamGetParams{
 			TeamName: teamName,
@@ -69,7 +70,7 @@ func TestGetTeam(t *testing.T) {
 		require.Equal(t, 200, getTeamResp.StatusCode())
 		require.NotNil(t, getTeamResp.JSON200)
 		require.Nil(t, getTeamResp.JSON404)
-		assert.Equal(t, *getTeamResp.JSON200, apiTeam)
+		assert.Equal(t, *getTeamResp.JSON200, createdTeam(apiTeam))
 	})
 
 	t.Run("not_found", func(t *testing.T) {
@@ -114,7 +115,7 @@ func TestGetTeam(t *testing.T) {
 		require.NotNil(t, teamAddResp.JSON201)
 		require.NotNil(t, teamAddResp.JSON201.Team)
 		require.Nil(t, teamAddResp.JSON400)
-		assert.Equal(t, teamAddResp.JSON201.Team, apiTeam)
+		assert.Equal(t, teamAddResp.JSON201.Team, createdTeam(apiTeam))
 
 		expectErr := api.ErrorResponse{
 			Error: struct {
@@ -137,6 +138,25 @@ func TestGetTeam(t *testing.T) {
 	})
 }
 
+// createdTeam возвращает apiTeam в том виде, в каком сервис отдаёт созданную команду:
+// с настройками по умолчанию, ролью member и командами участников.
+func createdTeam(apiTeam api.Team) api.Team {
+	team := apiTeam
+	team.MinReviewers = lo.CoalesceOrEmpty(apiTeam.MinReviewers, lo.ToPtr(domain.DefaultMinReviewers))
+	team.MaxReviewers = lo.CoalesceOrEmpty(apiTeam.MaxReviewers, lo.ToPtr(domain.DefaultMaxReviewers))
+	team.RequiredApprovals = lo.CoalesceOrEmpty(apiTeam.RequiredApprovals, lo.ToPtr(0))
+	team.PreferWorkingHours = lo.CoalesceOrEmpty(apiTeam.PreferWorkingHours, lo.ToPtr(false))
+	team.ReviewSlaHours = lo.CoalesceOrEmpty(apiTeam.ReviewSlaHours, lo.ToPtr(0))
+
+	team.Members = lo.Map(apiTeam.Members, func(member api.TeamMember, _ int) api.TeamMember {
+		member.Role = lo.CoalesceOrEmpty(member.Role, lo.ToPtr(api.Member))
+		member.Teams = lo.ToPtr([]string{apiTeam.TeamName})
+		return member
+	})
+
+	return team
+}
+
 func TestCreateTeam(t *testing.T) {
 	ctx := context.Background()
 
@@ -182,7 +202,7 @@ func TestCreateTeam(t *testing.T) {
 		require.NotNil(t, teamAddResp.JSON201)
 		require.NotNil(t, teamAddResp.JSON201.Team)
 		require.Nil(t, teamAddResp.JSON400)
-		assert.Equal(t, teamAddResp.JSON201.Team, apiTeam)
+		assert.Equal(t, teamAddResp.JSON201.Team, createdTeam(apiTeam))
 
 		// NOTE: проверка, что в БД сохранилась правильная команда
 		domainTeamFromDB, domainUsersFromDB, err := testStorage.GetTeamFullByName(ctx, teamName)
@@ -195,19 +215,22 @@ func TestCreateTeam(t *testing.T) {
 				ID:       userID1,
 				Name:     username1,
 				IsActive: true,
-				TeamID:   domainTeamFromDB.ID,
+				Timezone: domain.DefaultTimezone,
+				Teams:    []domain.TeamMembership{memberOf(domainTeamFromDB, domain.TeamRoleMember)},
 			},
 			{
 				ID:       userID2,
 				Name:     username2,
 				IsActive: true,
-				TeamID:   domainTeamFromDB.ID,
+				Timezone: domain.DefaultTimezone,
+				Teams:    []domain.TeamMembership{memberOf(domainTeamFromDB, domain.TeamRoleMember)},
 			},
 			{
 				ID:       userID3,
 				Name:     username3,
 				IsActive: false,
-				TeamID:   domainTeamFromDB.ID,
+				Timezone: domain.DefaultTimezone,
+				Teams:    []domain.TeamMembership{memberOf(domainTeamFromDB, domain.TeamRoleMember)},
 			},
 		}
 
@@ -297,7 +320,13 @@ func TestCreateTeam(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, 201, teamAddResp2.StatusCode())
 		require.NotNil(t, teamAddResp2.JSON201)
-		assert.Equal(t, apiTeam2, teamAddResp2.JSON201.Team)
+
+		// NOTE: участники остаются и в первой команде, поэтому в ответе у них обе команды
+		expectTeam2 := createdTeam(apiTeam2)
+		for i := range expectTeam2.Members {
+			expectTeam2.Members[i].Teams = lo.ToPtr([]string{teamName1, teamName2})
+		}
+		assert.Equal(t, expectTeam2, teamAddResp2.JSON201.Team)
 
 		// NOTE: проверка состояния в БД
 		teamDomainFromDB1, usersDomainFromDB1, err := testStorage.GetTeamFullByName(ctx, teamName1)
@@ -308,30 +337,40 @@ func TestCreateTeam(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, teamDomainFromDB2.Name, teamName2)
 
+		// NOTE: с поддержкой нескольких команд пользователи второй команды остаются и в первой,
+		// их данные обновляются (см. «Изменения контракта» в Readme)
 		expectDomainUsers1 := []domain.User{
-			{
-				ID:       userID2,
-				Name:     username2,
-				IsActive: true,
-				TeamID:   teamDomainFromDB1.ID,
-			},
-		}
-
-		expectDomainUsers2 := []domain.User{
 			{
 				ID:       userID1,
 				Name:     username1,
 				IsActive: false,
-				TeamID:   teamDomainFromDB2.ID,
+				Timezone: domain.DefaultTimezone,
+				Teams: []domain.TeamMembership{
+					memberOf(teamDomainFromDB1, domain.TeamRoleMember),
+					memberOf(teamDomainFromDB2, domain.TeamRoleMember),
+				},
+			},
+			{
+				ID:       userID2,
+				Name:     username2,
+				IsActive: true,
+				Timezone: domain.DefaultTimezone,
+				Teams:    []domain.TeamMembership{memberOf(teamDomainFromDB1, domain.TeamRoleMember)},
 			},
 			{
 				ID:       userID3,
 				Name:     username4,
 				IsActive: true,
-				TeamID:   teamDomainFromDB2.ID,
+				Timezone: domain.DefaultTimezone,
+				Teams: []domain.TeamMembership{
+					memberOf(teamDomainFromDB1, domain.TeamRoleMember),
+					memberOf(teamDomainFromDB2, domain.TeamRoleMember),
+				},
 			},
 		}
 
+		expectDomainUsers2 := []domain.User{expectDomainUsers1[0], expectDomainUsers1[2]}
+
 		sortDomainUsers(usersDomainFromDB1)
 		sortDomainUsers(usersDomainFromDB2)
 		sortDomainUsers(expectDomainUsers1)
@@ -353,9 +392,12 @@ func TestCreateTeam(t *testing.T) {
 			return userStats[i].UserId > userStats[j].UserId
 		})
 
+		// NOTE: смена активности через team/add учитывается как обычная смена статуса (см. «Изменения контракта»)
+		expectStatusChanges := map[string]int{userID1: 1, userID2: 0, userID3: 1}
+
 		for _, userStat := range userStats {
 			assert.Equal(t, 0, userStat.AssignmentsCount)
-			assert.Equal(t, 0, userStat.StatusChangesCount)
+			assert.Equal(t, expectStatusChanges[userStat.UserId], userStat.StatusChangesCount)
 
 			isInTeam1 := slices.ContainsFunc(expectDomainUsers1, func(u domain.User) bool {
 				return u.ID == userStat.UserId
@@ -369,6 +411,82 @@ func TestCreateTeam(t *testing.T) {
 		}
 	})
 
+	t.Run("create_second,_deactivate_reviewer", func(t *testing.T) {
+		cleanupDB(ctx, t)
+		defer cleanupDB(ctx, t)
+
+		const (
+			teamName1 = "test name 1"
+			teamName2 = "test name 2"
+			authorID  = "100"
+			userID1   = "101"
+			userID2   = "102"
+			userID3   = "200"
+			prID      = "pr-1"
+		)
+
+		member := func(userID string, isActive bool) api.TeamMember {
+			return api.TeamMember{UserId: userID, Username: "user" + userID, IsActive: isActive}
+		}
+
+		teamAddResp1, err := client.PostTeamAddWithResponse(ctx, api.Team{
+			TeamName:     teamName1,
+			Members:      []api.TeamMember{member(authorID, true), member(userID1, true)},
+			MaxReviewers: lo.ToPtr(1),
+		})
+		require.NoError(t, err)
+		require.Equal(t, 201, teamAddResp1.StatusCode())
+
+		createResp, err := client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
+			AuthorId:        authorID,
+			PullRequestId:   prID,
+			PullRequestName: "prname",
+		})
+		require.NoError(t, err)
+		require.Equal(t, 201, createResp.StatusCode())
+		require.Equal(t, []string{userID1}, createResp.JSON201.Pr.AssignedReviewers)
+
+		addResp, err := client.PostTeamAddMembersWithResponse(ctx, api.AddTeamMembersRequest{
+			TeamName: teamName1,
+			Members:  []api.TeamMember{member(userID2, true)},
+		})
+		require.NoError(t, err)
+		require.Equal(t, 200, addResp.StatusCode())
+
+		// NOTE: деактивация через team/add выполняется как POST /users/setIsActive
+		teamAddResp2, err := client.PostTeamAddWithResponse(ctx, api.Team{
+			TeamName: teamName2,
+			Members:  []api.TeamMember{member(userID1, false), member(userID3, true)},
+		})
+		require.NoError(t, err)
+		require.Equal(t, 201, teamAddResp2.StatusCode())
+
+		pr, err := testStorage.GetPullRequestByID(ctx, prID)
+		require.NoError(t, err)
+		assert.Equal(t, []string{userID2}, pr.ReviewersUsersIDs)
+
+		user, err := testStorage.GetUserFull(ctx, userID1)
+		require.NoError(t, err)
+		assert.False(t, user.IsActive)
+		assert.Len(t, user.Teams, 2)
+
+		statsResp, err := client.GetStatsGetWithResponse(ctx)
+		require.NoError(t, err)
+		userStats, ok := lo.Find(statsResp.JSON200.UserStats, func(stats api.UserStats) bool {
+			return stats.UserId == userID1
+		})
+		require.True(t, ok)
+		assert.Equal(t, 1, userStats.StatusChangesCount)
+
+		auditResp, err := client.GetAuditWithResponse(ctx, &api.GetAuditParams{
+			TargetType: lo.ToPtr(api.AuditTargetTypeUser),
+			TargetId:   lo.ToPtr(userID1),
+		})
+		require.NoError(t, err)
+		require.Len(t, auditResp.JSON200.Entries, 1)
+		assert.Equal(t, api.AuditActionUserChangeStatus, auditResp.JSON200.Entries[0].Action)
+	})
+
 	t.Run("create_second,_already_exists", func(t *testing.T) {
 		cleanupDB(ctx, t)
 		defer cleanupDB(ctx, t)
@@ -451,19 +569,22 @@ func TestCreateTeam(t *testing.T) {
 				ID:       userID1,
 				Name:     username1,
 				IsActive: true,
-				TeamID:   teamDomainFromDB1.ID,
+				Timezone: domain.DefaultTimezone,
+				Teams:    []domain.TeamMembership{memberOf(teamDomainFromDB1, domain.TeamRoleMember)},
 			},
 			{
 				ID:       userID2,
 				Name:     username2,
 				IsActive: true,
-				TeamID:   teamDomainFromDB1.ID,
+				Timezone: domain.DefaultTimezone,
+				Teams:    []domain.TeamMembership{memberOf(teamDomainFromDB1, domain.TeamRoleMember)},
 			},
 			{
 				ID:       userID3,
 				Name:     username3,
 				IsActive: false,
-				TeamID:   teamDomainFromDB1.ID,
+				Timezone: domain.DefaultTimezone,
+				Teams:    []domain.TeamMembership{memberOf(teamDomainFromDB1, domain.TeamRoleMember)},
 			},
 		}
 
@@ -492,8 +613,859 @@ func TestCreateTeam(t *testing.T) {
 	})
 }
 
+func TestSetTeamSettings(t *testing.T) {
+	ctx := context.Background()
+
+	const (
+		teamName  = "test name"
+		userID1   = "100"
+		userID2   = "101"
+		username1 = "user1"
+		username2 = "user2"
+	)
+
+	apiTeam := api.Team{
+		TeamName: teamName,
+		Members: []api.TeamMember{
+			{
+				UserId:   userID1,
+				Username: username1,
+				IsActive: true,
+			},
+			{
+				UserId:   userID2,
+				Username: username2,
+				IsActive: true,
+			},
+		},
+	}
+
+	t.Run("updated", func(t *testing.T) {
+		cleanupDB(ctx, t)
+		defer cleanupDB(ctx, t)
+
+		teamAddResp, err := client.PostTeamAddWithResponse(ctx, apiTeam)
+		require.NoError(t, err)
+		require.Equal(t, 201, teamAddResp.StatusCode())
+
+		settings := api.TeamSettings{
+			TeamName:           teamName,
+			ReviewerStrategy:   lo.ToPtr(api.LeastLoaded),
+			MinReviewers:       3,
+			MaxReviewers:       3,
+			RequiredApprovals:  lo.ToPtr(2),
+			PreferWorkingHours: lo.ToPtr(true),
+			ReviewSlaHours:     lo.ToPtr(48),
+		}
+
+		settingsResp, err := client.PostTeamSetSettingsWithResponse(ctx, settings)
+		require.NoError(t, err)
+		require.Equal(t, 200, settingsResp.StatusCode())
+		require.NotNil(t, settingsResp.JSON200)
+		assert.Equal(t, settings, settingsResp.JSON200.Settings)
+
+		// NOTE: проверка, что настройки сохранились
+		team, err := testStorage.GetTeamByName(ctx, teamName)
+		require.NoError(t, err)
+		assert.Equal(t, domain.ReviewerStrategyLeastLoaded, team.ReviewerStrategy)
+		assert.Equal(t, 3, team.MinReviewers)
+		assert.Equal(t, 3, team.MaxReviewers)
+		assert.Equal(t, 2, team.RequiredApprovals)
+		assert.True(t, team.PreferWorkingHours)
+		assert.Equal(t, 48, team.ReviewSLAHours)
+	})
+
+	t.Run("partial_update", func(t *testing.T) {
+		cleanupDB(ctx, t)
+		defer cleanupDB(ctx, t)
+
+		teamAddResp, err := client.PostTeamAddWithResponse(ctx, apiTeam)
+		require.NoError(t, err)
+		require.Equal(t, 201, teamAddResp.StatusCode())
+
+		settingsResp, err := client.PostTeamSetSettingsWithResponse(ctx, api.TeamSettings{
+			TeamName:           teamName,
+			ReviewerStrategy:   lo.ToPtr(api.LeastLoaded),
+			MinReviewers:       1,
+			MaxReviewers:       2,
+			RequiredApprovals:  lo.ToPtr(2),
+			PreferWorkingHours: lo.ToPtr(true),
+			ReviewSlaHours:     lo.ToPtr(48),
+		})
+		require.NoError(t, err)
+		require.Equal(t, 200, settingsResp.StatusCode())
+
+		// NOTE: непереданные настройки не сбрасываются
+		settingsResp, err = client.PostTeamSetSettingsWithResponse(ctx, api.TeamSettings{
+			TeamName:     teamName,
+			MinReviewers: 2,
+			MaxReviewers: 3,
+		})
+		require.NoError(t, err)
+		require.Equal(t, 200, settingsResp.StatusCode())
+		assert.Equal(t, api.TeamSettings{
+			TeamName:           teamName,
+			ReviewerStrategy:   lo.ToPtr(api.LeastLoaded),
+			MinReviewers:       2,
+			MaxReviewers:       3,
+			RequiredApprovals:  lo.ToPtr(2),
+			PreferWorkingHours: lo.ToPtr(true),
+			ReviewSlaHours:     lo.ToPtr(48),
+		}, settingsResp.JSON200.Settings)
+
+		team, err := testStorage.GetTeamByName(ctx, teamName)
+		require.NoError(t, err)
+		assert.Equal(t, 2, team.RequiredApprovals)
+		assert.True(t, team.PreferWorkingHours)
+		assert.Equal(t, 48, team.ReviewSLAHours)
+	})
+
+	t.Run("approvals_exceed_max_reviewers", func(t *testing.T) {
+		cleanupDB(ctx, t)
+		defer cleanupDB(ctx, t)
+
+		teamAddResp, err := client.PostTeamAddWithResponse(ctx, apiTeam)
+		require.NoError(t, err)
+		require.Equal(t, 201, teamAddResp.StatusCode())
+
+		settingsResp, err := client.PostTeamSetSettingsWithResponse(ctx, api.TeamSettings{
+			TeamName:          teamName,
+			MinReviewers:      1,
+			MaxReviewers:      1,
+			RequiredApprovals: lo.ToPtr(2),
+		})
+		require.NoError(t, err)
+		require.Equal(t, 400, settingsResp.StatusCode())
+		assert.Equal(t, api.VALIDATIONERR, settingsResp.JSON400.Error.Code)
+
+		settingsResp, err = client.PostTeamSetSettingsWithResponse(ctx, api.TeamSettings{
+			TeamName:          teamName,
+			MinReviewers:      1,
+			MaxReviewers:      2,
+			RequiredApprovals: lo.ToPtr(2),
+		})
+		require.NoError(t, err)
+		require.Equal(t, 200, settingsResp.StatusCode())
+
+		// NOTE: уменьшение max_reviewers ниже сохранённого required_approvals
+		settingsResp, err = client.PostTeamSetSettingsWithResponse(ctx, api.TeamSettings{
+			TeamName:     teamName,
+			MinReviewers: 1,
+			MaxReviewers: 1,
+		})
+		require.NoError(t, err)
+		require.Equal(t, 400, settingsResp.StatusCode())
+		assert.Equal(t, api.VALIDATIONERR, settingsResp.JSON400.Error.Code)
+	})
+
+	t.Run("min_greater_than_max", func(t *testing.T) {
+		cleanupDB(ctx, t)
+		defer cleanupDB(ctx, t)
+
+		teamAddResp, err := client.PostTeamAddWithResponse(ctx, apiTeam)
+		require.NoError(t, err)
+		require.Equal(t, 201, teamAddResp.StatusCode())
+
+		settingsResp, err := client.PostTeamSetSettingsWithResponse(ctx, api.TeamSettings{
+			TeamName:     teamName,
+			MinReviewers: 3,
+			MaxReviewers: 1,
+		})
+		require.NoError(t, err)
+		require.Equal(t, 400, settingsResp.StatusCode())
+		require.NotNil(t, settingsResp.JSON400)
+		assert.Equal(t, api.VALIDATIONERR, settingsResp.JSON400.Error.Code)
+	})
+
+	t.Run("team_not_found", func(t *testing.T) {
+		cleanupDB(ctx, t)
+		defer cleanupDB(ctx, t)
+
+		settingsResp, err := client.PostTeamSetSettingsWithResponse(ctx, api.TeamSettings{
+			TeamName:     teamName,
+			MinReviewers: 1,
+			MaxReviewers: 1,
+		})
+		require.NoError(t, err)
+		require.Equal(t, 404, settingsResp.StatusCode())
+		require.NotNil(t, settingsResp.JSON404)
+		assert.Equal(t, api.NOTFOUND, settingsResp.JSON404.Error.Code)
+	})
+}
+
 func sortDomainUsers(u []domain.User) {
 	sort.Slice(u, func(i, j int) bool {
 		return u[i].ID > u[j].ID
 	})
 }
+
+func memberOf(team domain.Team, role domain.TeamRole) domain.TeamMembership {
+	return domain.TeamMembership{
+		TeamID:   team.ID,
+		TeamName: team.Name,
+		Role:     role,
+	}
+}
+
+func TestSetTeamFallbacks(t *testing.T) {
+	ctx := context.Background()
+
+	const (
+		teamName1 = "test name 1"
+		teamName2 = "test name 2"
+		userID1   = "100"
+		userID2   = "101"
+	)
+
+	addTeams := func(t *testing.T) {
+		teamAddResp, err := client.PostTeamAddWithResponse(ctx, api.Team{
+			TeamName: teamName1,
+			Members:  []api.TeamMember{{UserId: userID1, Username: "user1", IsActive: true}},
+		})
+		require.NoError(t, err)
+		require.Equal(t, 201, teamAddResp.StatusCode())
+
+		teamAddResp, err = client.PostTeamAddWithResponse(ctx, api.Team{
+			TeamName: teamName2,
+			Members:  []api.TeamMember{{UserId: userID2, Username: "user2", IsActive: true}},
+		})
+		require.NoError(t, err)
+		require.Equal(t, 201, teamAddResp.StatusCode())
+	}
+
+	t.Run("updated", func(t *testing.T) {
+		cleanupDB(ctx, t)
+		defer cleanupDB(ctx, t)
+
+		addTeams(t)
+
+		fallbacksResp, err := client.PostTeamSetFallbacksWithResponse(ctx, api.TeamFallbacks{
+			TeamName:          teamName1,
+			FallbackTeamNames: []string{teamName2},
+		})
+		require.NoError(t, err)
+		require.Equal(t, 200, fallbacksResp.StatusCode())
+
+		// NOTE: резервные команды видны в /team/get
+		teamGetResp, err := client.GetTeamGetWithResponse(ctx, &api.GetTeamGetParams{TeamName: teamName1})
+		require.NoError(t, err)
+		require.Equal(t, 200, teamGetResp.StatusCode())
+		assert.Equal(t, lo.ToPtr([]string{teamName2}), teamGetResp.JSON200.FallbackTeams)
+
+		// NOTE: пустой список удаляет резервные команды
+		fallbacksResp, err = client.PostTeamSetFallbacksWithResponse(ctx, api.TeamFallbacks{
+			TeamName:          teamName1,
+			FallbackTeamNames: []string{},
+		})
+		require.NoError(t, err)
+		require.Equal(t, 200, fallbacksResp.StatusCode())
+
+		teamGetResp, err = client.GetTeamGetWithResponse(ctx, &api.GetTeamGetParams{TeamName: teamName1})
+		require.NoError(t, err)
+		require.Equal(t, 200, teamGetResp.StatusCode())
+		assert.Nil(t, teamGetResp.JSON200.FallbackTeams)
+	})
+
+	t.Run("self_fallback", func(t *testing.T) {
+		cleanupDB(ctx, t)
+		defer cleanupDB(ctx, t)
+
+		addTeams(t)
+
+		fallbacksResp, err := client.PostTeamSetFallbacksWithResponse(ctx, api.TeamFallbacks{
+			TeamName:          teamName1,
+			FallbackTeamNames: []string{teamName1},
+		})
+		require.NoError(t, err)
+		require.Equal(t, 400, fallbacksResp.StatusCode())
+		require.NotNil(t, fallbacksResp.JSON400)
+		assert.Equal(t, api.VALIDATIONERR, fallbacksResp.JSON400.Error.Code)
+	})
+
+	t.Run("fallback_team_not_found", func(t *testing.T) {
+		cleanupDB(ctx, t)
+		defer cleanupDB(ctx, t)
+
+		addTeams(t)
+
+		fallbacksResp, err := client.PostTeamSetFallbacksWithResponse(ctx, api.TeamFallbacks{
+			TeamName:          teamName1,
+			FallbackTeamNames: []string{"unknown team"},
+		})
+		require.NoError(t, err)
+		require.Equal(t, 404, fallbacksResp.StatusCode())
+		require.NotNil(t, fallbacksResp.JSON404)
+		assert.Equal(t, api.NOTFOUND, fallbacksResp.JSON404.Error.Code)
+	})
+}
+
+func TestTeamOwners(t *testing.T) {
+	ctx := context.Background()
+
+	const (
+		teamName = "test name"
+		userID1  = "100"
+		userID2  = "101"
+	)
+
+	addTeam := func(t *testing.T) {
+		teamAddResp, err := client.PostTeamAddWithResponse(ctx, api.Team{
+			TeamName: teamName,
+			Members: []api.TeamMember{
+				{UserId: userID1, Username: "user1", IsActive: true},
+				{UserId: userID2, Username: "user2", IsActive: true},
+			},
+		})
+		require.NoError(t, err)
+		require.Equal(t, 201, teamAddResp.StatusCode())
+	}
+
+	t.Run("set_and_get", func(t *testing.T) {
+		cleanupDB(ctx, t)
+		defer cleanupDB(ctx, t)
+
+		addTeam(t)
+
+		owners := api.TeamOwners{
+			TeamName: teamName,
+			Rules: []api.OwnerRule{
+				{Pattern: "*", Owners: []string{"@" + teamName}},
+				{Pattern: "/internal/storage/", Owners: []string{userID1, userID2}},
+			},
+		}
+
+		setResp, err := client.PostTeamOwnersWithResponse(ctx, owners)
+		require.NoError(t, err)
+		require.Equal(t, 200, setResp.StatusCode())
+		assert.Equal(t, owners, setResp.JSON200.Owners)
+
+		getResp, err := client.GetTeamOwnersWithResponse(ctx, &api.GetTeamOwnersParams{TeamName: teamName})
+		require.NoError(t, err)
+		require.Equal(t, 200, getResp.StatusCode())
+		assert.Equal(t, owners, getResp.JSON200.Owners)
+	})
+
+	t.Run("invalid_pattern", func(t *testing.T) {
+		cleanupDB(ctx, t)
+		defer cleanupDB(ctx, t)
+
+		addTeam(t)
+
+		setResp, err := client.PostTeamOwnersWithResponse(ctx, api.TeamOwners{
+			TeamName: teamName,
+			Rules:    []api.OwnerRule{{Pattern: "!*.go", Owners: []string{userID1}}},
+		})
+		require.NoError(t, err)
+		require.Equal(t, 400, setResp.StatusCode())
+		require.NotNil(t, setResp.JSON400)
+		assert.Equal(t, api.VALIDATIONERR, setResp.JSON400.Error.Code)
+	})
+
+	t.Run("owner_not_found", func(t *testing.T) {
+		cleanupDB(ctx, t)
+		defer cleanupDB(ctx, t)
+
+		addTeam(t)
+
+		setResp, err := client.PostTeamOwnersWithResponse(ctx, api.TeamOwners{
+			TeamName: teamName,
+			Rules:    []api.OwnerRule{{Pattern: "*", Owners: []string{"unknown"}}},
+		})
+		require.NoError(t, err)
+		require.Equal(t, 404, setResp.StatusCode())
+		require.NotNil(t, setResp.JSON404)
+		assert.Equal(t, api.NOTFOUND, setResp.JSON404.Error.Code)
+	})
+}
+
+func TestDeactivateTeamUsers(t *testing.T) {
+	ctx := context.Background()
+
+	cleanupDB(ctx, t)
+	defer cleanupDB(ctx, t)
+
+	const (
+		teamName = "test name 1"
+
+		authorID = "100"
+		userID1  = "101"
+		userID2  = "102"
+		userID3  = "103"
+
+		prID = "100"
+	)
+
+	teamAddResp, err := client.PostTeamAdd(ctx, api.Team{
+		TeamName: teamName,
+		Members: []api.TeamMember{
+			{UserId: authorID, Username: "author", IsActive: true},
+			{UserId: userID1, Username: "user1", IsActive: true},
+			{UserId: userID2, Username: "user2", IsActive: true},
+			{UserId: userID3, Username: "user3", IsActive: true},
+		},
+		MaxReviewers: lo.ToPtr(2),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 201, teamAddResp.StatusCode)
+
+	createResp, err := client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
+		AuthorId:        authorID,
+		PullRequestId:   prID,
+		PullRequestName: "prname",
+	})
+	require.NoError(t, err)
+	require.Equal(t, 201, createResp.StatusCode())
+
+	reviewers := createResp.JSON201.Pr.AssignedReviewers
+	require.Len(t, reviewers, 2)
+
+	remainingID, _ := lo.Find([]string{userID1, userID2, userID3}, func(userID string) bool {
+		return !lo.Contains(reviewers, userID)
+	})
+
+	t.Run("not_in_team", func(t *testing.T) {
+		resp, err := client.PostTeamDeactivateUsersWithResponse(ctx, api.DeactivateUsersRequest{
+			TeamName: teamName,
+			UserIds:  []string{userID1, "999"},
+		})
+		require.NoError(t, err)
+		require.Equal(t, 400, resp.StatusCode())
+		assert.Equal(t, api.NOTINTEAM, resp.JSON400.Error.Code)
+	})
+
+	t.Run("team_not_found", func(t *testing.T) {
+		resp, err := client.PostTeamDeactivateUsersWithResponse(ctx, api.DeactivateUsersRequest{
+			TeamName: "unknown team",
+			UserIds:  []string{userID1, userID2},
+		})
+		require.NoError(t, err)
+		require.Equal(t, 404, resp.StatusCode())
+	})
+
+	t.Run("success", func(t *testing.T) {
+		resp, err := client.PostTeamDeactivateUsersWithResponse(ctx, api.DeactivateUsersRequest{
+			TeamName: teamName,
+			UserIds:  reviewers,
+		})
+		require.NoError(t, err)
+		require.Equal(t, 200, resp.StatusCode())
+
+		// NOTE: из активных остались автор и один участник, поэтому замена есть только для первого ревьювера
+		assert.Equal(t, 2, resp.JSON200.DeactivatedUsersCount)
+		assert.Equal(t, []api.ReviewerReplacement{
+			{PullRequestId: prID, OldUserId: reviewers[0], ReplacedBy: remainingID},
+		}, resp.JSON200.ReassignedPullRequests)
+		assert.Equal(t, []api.UnreplacedReviewer{
+			{PullRequestId: prID, UserId: reviewers[1]},
+		}, resp.JSON200.NoCandidatePullRequests)
+
+		getTeamResp, err := client.GetTeamGetWithResponse(ctx, &api.GetTeamGetParams{TeamName: teamName})
+		require.NoError(t, err)
+		for _, member := range getTeamResp.JSON200.Members {
+			assert.Equal(t, !lo.Contains(reviewers, member.UserId), member.IsActive, member.UserId)
+		}
+
+		statsResp, err := client.GetStatsGetWithResponse(ctx)
+		require.NoError(t, err)
+		for _, stats := range statsResp.JSON200.UserStats {
+			assert.Equal(t, lo.Ternary(lo.Contains(reviewers, stats.UserId), 1, 0), stats.StatusChangesCount, stats.UserId)
+		}
+	})
+
+	t.Run("already_inactive", func(t *testing.T) {
+		resp, err := client.PostTeamDeactivateUsersWithResponse(ctx, api.DeactivateUsersRequest{
+			TeamName: teamName,
+			UserIds:  reviewers,
+		})
+		require.NoError(t, err)
+		require.Equal(t, 200, resp.StatusCode())
+
+		// NOTE: повторный запрос снова пытается заменить оставшегося ревьювера
+		assert.Equal(t, 0, resp.JSON200.DeactivatedUsersCount)
+		assert.Empty(t, resp.JSON200.ReassignedPullRequests)
+		assert.Equal(t, []api.UnreplacedReviewer{
+			{PullRequestId: prID, UserId: reviewers[1]},
+		}, resp.JSON200.NoCandidatePullRequests)
+	})
+}
+
+func TestDeactivateTeamUsersInSeveralTeams(t *testing.T) {
+	ctx := context.Background()
+
+	cleanupDB(ctx, t)
+	defer cleanupDB(ctx, t)
+
+	const (
+		teamName1 = "team 1"
+		teamName2 = "team 2"
+
+		authorID1 = "100"
+		sharedID  = "101"
+		userID1   = "102"
+		userID2   = "103"
+
+		authorID2 = "200"
+		userID3   = "201"
+
+		prID1 = "pr-1"
+		prID2 = "pr-2"
+	)
+
+	addTeam := func(teamName string, maxReviewers int, userIDs ...string) {
+		resp, err := client.PostTeamAddWithResponse(ctx, api.Team{
+			TeamName: teamName,
+			Members: lo.Map(userIDs, func(userID string, _ int) api.TeamMember {
+				return api.TeamMember{UserId: userID, Username: "user" + userID, IsActive: true}
+			}),
+			MaxReviewers: lo.ToPtr(maxReviewers),
+		})
+		require.NoError(t, err)
+		require.Equal(t, 201, resp.StatusCode())
+	}
+
+	addMember := func(teamName, userID string) {
+		resp, err := client.PostTeamAddMembersWithResponse(ctx, api.AddTeamMembersRequest{
+			TeamName: teamName,
+			Members:  []api.TeamMember{{UserId: userID, Username: "user" + userID, IsActive: true}},
+		})
+		require.NoError(t, err)
+		require.Equal(t, 200, resp.StatusCode())
+	}
+
+	createPR := func(prID, authorID string) []string {
+		resp, err := client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
+			AuthorId:        authorID,
+			PullRequestId:   prID,
+			PullRequestName: "prname",
+		})
+		require.NoError(t, err)
+		require.Equal(t, 201, resp.StatusCode())
+
+		return resp.JSON201.Pr.AssignedReviewers
+	}
+
+	// NOTE: участники добавляются после создания PR, чтобы ревьюверы были известны заранее
+	addTeam(teamName1, 2, authorID1, sharedID, userID1)
+	addTeam(teamName2, 1, authorID2)
+	addMember(teamName2, sharedID)
+
+	require.ElementsMatch(t, []string{sharedID, userID1}, createPR(prID1, authorID1))
+	require.Equal(t, []string{sharedID}, createPR(prID2, authorID2))
+
+	addMember(teamName1, userID2)
+	addMember(teamName2, userID3)
+
+	resp, err := client.PostTeamDeactivateUsersWithResponse(ctx, api.DeactivateUsersRequest{
+		TeamName: teamName1,
+		UserIds:  []string{sharedID, userID1},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 200, resp.StatusCode())
+
+	// NOTE: ревью второй команды заменяет её участник, а не участник команды из запроса
+	assert.ElementsMatch(t, []api.ReviewerReplacement{
+		{PullRequestId: prID1, OldUserId: sharedID, ReplacedBy: userID2},
+		{PullRequestId: prID2, OldUserId: sharedID, ReplacedBy: userID3},
+	}, resp.JSON200.ReassignedPullRequests)
+	assert.Equal(t, []api.UnreplacedReviewer{
+		{PullRequestId: prID1, UserId: userID1},
+	}, resp.JSON200.NoCandidatePullRequests)
+
+	pr1, err := testStorage.GetPullRequestByID(ctx, prID1)
+	require.NoError(t, err)
+	assert.Equal(t, teamName1, pr1.ReviewerPools[userID2].TeamName)
+
+	pr2, err := testStorage.GetPullRequestByID(ctx, prID2)
+	require.NoError(t, err)
+	assert.Equal(t, []string{userID3}, pr2.ReviewersUsersIDs)
+	assert.Equal(t, teamName2, pr2.ReviewerPools[userID3].TeamName)
+}
+
+func TestTeamMembership(t *testing.T) {
+	ctx := context.Background()
+
+	cleanupDB(ctx, t)
+	defer cleanupDB(ctx, t)
+
+	const (
+		teamName1 = "team 1"
+		teamName2 = "team 2"
+		teamName3 = "team 3"
+
+		authorID = "100"
+		userID1  = "101"
+		userID2  = "102"
+		userID3  = "103"
+		userID4  = "104"
+		userID5  = "105"
+		userID6  = "106"
+		userID7  = "107"
+
+		prID = "100"
+	)
+
+	addTeam := func(teamName string, userIDs ...string) {
+		members := lo.Map(userIDs, func(userID string, _ int) api.TeamMember {
+			return api.TeamMember{UserId: userID, Username: "user" + userID, IsActive: true}
+		})
+
+		resp, err := client.PostTeamAddWithResponse(ctx, api.Team{
+			TeamName:     teamName,
+			Members:      members,
+			MaxReviewers: lo.ToPtr(1),
+		})
+		require.NoError(t, err)
+		require.Equal(t, 201, resp.StatusCode())
+	}
+
+	membersIDs := func(team api.Team) []string {
+		return lo.Map(team.Members, func(member api.TeamMember, _ int) string {
+			return member.UserId
+		})
+	}
+
+	addTeam(teamName1, authorID, userID1, userID2)
+	addTeam(teamName2, userID3, userID4)
+	addTeam(teamName3, userID6, userID7)
+
+	t.Run("add_members", func(t *testing.T) {
+		resp, err := client.PostTeamAddMembersWithResponse(ctx, api.AddTeamMembersRequest{
+			TeamName: teamName1,
+			Members:  []api.TeamMember{{UserId: userID5, Username: "user5", IsActive: true}},
+		})
+		require.NoError(t, err)
+		require.Equal(t, 200, resp.StatusCode())
+		assert.ElementsMatch(t, []string{authorID, userID1, userID2, userID5}, membersIDs(resp.JSON200.Team))
+
+		// NOTE: участник другой команды остаётся и в ней, его имя и активность не меняются
+		resp, err = client.PostTeamAddMembersWithResponse(ctx, api.AddTeamMembersRequest{
+			TeamName: teamName1,
+			Members: []api.TeamMember{{
+				UserId:   userID3,
+				Username: "renamed",
+				IsActive: false,
+				Role:     lo.ToPtr(api.TeamRole("lead")),
+			}},
+		})
+		require.NoError(t, err)
+		require.Equal(t, 200, resp.StatusCode())
+
+		member, ok := lo.Find(resp.JSON200.Team.Members, func(member api.TeamMember) bool {
+			return member.UserId == userID3
+		})
+		require.True(t, ok)
+		assert.Equal(t, api.TeamRole("lead"), lo.FromPtr(member.Role))
+		assert.Equal(t, []string{teamName2, teamName1}, lo.FromPtr(member.Teams))
+		assert.Equal(t, "user"+userID3, member.Username)
+		assert.True(t, member.IsActive)
+
+		resp, err = client.PostTeamAddMembersWithResponse(ctx, api.AddTeamMembersRequest{
+			TeamName: teamName1,
+			Members:  []api.TeamMember{{UserId: userID1, Username: "user1", IsActive: true}},
+		})
+		require.NoError(t, err)
+		require.Equal(t, 409, resp.StatusCode())
+		assert.Equal(t, api.ALREADYINTEAM, resp.JSON409.Error.Code)
+
+		removeResp, err := client.PostTeamRemoveMemberWithResponse(ctx, api.RemoveTeamMemberRequest{
+			TeamName: teamName1,
+			UserId:   userID3,
+		})
+		require.NoError(t, err)
+		require.Equal(t, 200, removeResp.StatusCode())
+		assert.Equal(t, teamName2, removeResp.JSON200.User.TeamName)
+		assert.Equal(t, []api.UserTeam{{TeamName: teamName2, Role: "member"}}, removeResp.JSON200.User.Teams)
+	})
+
+	t.Run("move_member_with_reviews", func(t *testing.T) {
+		createResp, err := client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
+			AuthorId:        authorID,
+			PullRequestId:   prID,
+			PullRequestName: "prname",
+		})
+		require.NoError(t, err)
+		require.Equal(t, 201, createResp.StatusCode())
+		require.Len(t, createResp.JSON201.Pr.AssignedReviewers, 1)
+
+		reviewerID := createResp.JSON201.Pr.AssignedReviewers[0]
+
+		resp, err := client.PostTeamMoveMemberWithResponse(ctx, api.MoveTeamMemberRequest{
+			UserId:          reviewerID,
+			TeamName:        teamName2,
+			ReassignReviews: lo.ToPtr(true),
+		})
+		require.NoError(t, err)
+		require.Equal(t, 200, resp.StatusCode())
+
+		assert.Equal(t, teamName2, resp.JSON200.User.TeamName)
+		assert.Empty(t, resp.JSON200.NoCandidatePullRequests)
+		require.Len(t, resp.JSON200.ReassignedPullRequests, 1)
+
+		replacement := resp.JSON200.ReassignedPullRequests[0]
+		assert.Equal(t, reviewerID, replacement.OldUserId)
+		assert.Contains(t, []string{userID1, userID2, userID5}, replacement.ReplacedBy)
+		assert.NotEqual(t, reviewerID, replacement.ReplacedBy)
+
+		resp, err = client.PostTeamMoveMemberWithResponse(ctx, api.MoveTeamMemberRequest{
+			UserId:   reviewerID,
+			TeamName: teamName2,
+		})
+		require.NoError(t, err)
+		require.Equal(t, 409, resp.StatusCode())
+		assert.Equal(t, api.ALREADYINTEAM, resp.JSON409.Error.Code)
+	})
+
+	t.Run("remove_member", func(t *testing.T) {
+		resp, err := client.PostTeamRemoveMemberWithResponse(ctx, api.RemoveTeamMemberRequest{
+			TeamName: teamName1,
+			UserId:   userID3,
+		})
+		require.NoError(t, err)
+		require.Equal(t, 400, resp.StatusCode())
+		assert.Equal(t, api.NOTINTEAM, resp.JSON400.Error.Code)
+
+		resp, err = client.PostTeamRemoveMemberWithResponse(ctx, api.RemoveTeamMemberRequest{
+			TeamName: teamName1,
+			UserId:   authorID,
+		})
+		require.NoError(t, err)
+		require.Equal(t, 200, resp.StatusCode())
+		assert.Equal(t, "", resp.JSON200.User.TeamName)
+
+		// NOTE: пользователь без команды снова добавляется в команду
+		addResp, err := client.PostTeamAddMembersWithResponse(ctx, api.AddTeamMembersRequest{
+			TeamName: teamName2,
+			Members:  []api.TeamMember{{UserId: authorID, Username: "author", IsActive: true}},
+		})
+		require.NoError(t, err)
+		require.Equal(t, 200, addResp.StatusCode())
+		assert.Contains(t, membersIDs(addResp.JSON200.Team), authorID)
+	})
+
+	t.Run("pull_request_team", func(t *testing.T) {
+		addResp, err := client.PostTeamAddMembersWithResponse(ctx, api.AddTeamMembersRequest{
+			TeamName: teamName1,
+			Members:  []api.TeamMember{{UserId: authorID, Username: "author", IsActive: true}},
+		})
+		require.NoError(t, err)
+		require.Equal(t, 200, addResp.StatusCode())
+
+		const multiTeamPrID = "101"
+
+		createResp, err := client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
+			AuthorId:        authorID,
+			PullRequestId:   multiTeamPrID,
+			PullRequestName: "prname",
+		})
+		require.NoError(t, err)
+		require.Equal(t, 400, createResp.StatusCode())
+		assert.Equal(t, api.AMBIGUOUSTEAM, createResp.JSON400.Error.Code)
+
+		createResp, err = client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
+			AuthorId:        authorID,
+			PullRequestId:   multiTeamPrID,
+			PullRequestName: "prname",
+			TeamName:        lo.ToPtr(teamName1),
+		})
+		require.NoError(t, err)
+		require.Equal(t, 201, createResp.StatusCode())
+		assert.Equal(t, teamName1, lo.FromPtr(createResp.JSON201.Pr.TeamName))
+		require.Len(t, createResp.JSON201.Pr.AssignedReviewers, 1)
+		assert.Contains(t, []string{userID1, userID2, userID5}, createResp.JSON201.Pr.AssignedReviewers[0])
+
+		userResp, err := client.PostUsersSetIsActiveWithResponse(ctx, api.PostUsersSetIsActiveJSONRequestBody{
+			UserId:   authorID,
+			IsActive: true,
+		})
+		require.NoError(t, err)
+		require.Equal(t, 200, userResp.StatusCode())
+		assert.Equal(t, []api.UserTeam{
+			{TeamName: teamName2, Role: "member"},
+			{TeamName: teamName1, Role: "member"},
+		}, userResp.JSON200.User.Teams)
+
+		roleResp, err := client.PostTeamSetMemberRoleWithResponse(ctx, api.SetTeamMemberRoleRequest{
+			TeamName: teamName2,
+			UserId:   authorID,
+			Role:     "lead",
+		})
+		require.NoError(t, err)
+		require.Equal(t, 200, roleResp.StatusCode())
+
+		author, ok := lo.Find(roleResp.JSON200.Team.Members, func(member api.TeamMember) bool {
+			return member.UserId == authorID
+		})
+		require.True(t, ok)
+		assert.Equal(t, api.TeamRole("lead"), lo.FromPtr(author.Role))
+		assert.Equal(t, []string{teamName2, teamName1}, lo.FromPtr(author.Teams))
+	})
+
+	t.Run("rename", func(t *testing.T) {
+		ownersResp, err := client.PostTeamOwnersWithResponse(ctx, api.TeamOwners{
+			TeamName: teamName1,
+			Rules:    []api.OwnerRule{{Pattern: "*.sql", Owners: []string{"@" + teamName2, userID1}}},
+		})
+		require.NoError(t, err)
+		require.Equal(t, 200, ownersResp.StatusCode())
+
+		resp, err := client.PostTeamRenameWithResponse(ctx, api.RenameTeamRequest{
+			TeamName:    teamName2,
+			NewTeamName: teamName1,
+		})
+		require.NoError(t, err)
+		require.Equal(t, 400, resp.StatusCode())
+		assert.Equal(t, api.TEAMEXISTS, resp.JSON400.Error.Code)
+
+		const newTeamName = "team 2 renamed"
+
+		resp, err = client.PostTeamRenameWithResponse(ctx, api.RenameTeamRequest{
+			TeamName:    teamName2,
+			NewTeamName: newTeamName,
+		})
+		require.NoError(t, err)
+		require.Equal(t, 200, resp.StatusCode())
+		assert.Equal(t, newTeamName, resp.JSON200.Team.TeamName)
+
+		getOwnersResp, err := client.GetTeamOwnersWithResponse(ctx, &api.GetTeamOwnersParams{TeamName: teamName1})
+		require.NoError(t, err)
+		require.Equal(t, 200, getOwnersResp.StatusCode())
+		assert.Equal(t, []string{"@" + newTeamName, userID1}, getOwnersResp.JSON200.Owners.Rules[0].Owners)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		fallbacksResp, err := client.PostTeamSetFallbacksWithResponse(ctx, api.TeamFallbacks{
+			TeamName:          teamName1,
+			FallbackTeamNames: []string{teamName3},
+		})
+		require.NoError(t, err)
+		require.Equal(t, 200, fallbacksResp.StatusCode())
+
+		deleteResp, err := client.PostTeamDeleteWithResponse(ctx, api.DeleteTeamRequest{TeamName: teamName3})
+		require.NoError(t, err)
+		require.Equal(t, 409, deleteResp.StatusCode())
+		assert.Equal(t, api.TEAMNOTEMPTY, deleteResp.JSON409.Error.Code)
+
+		for _, userID := range []string{userID6, userID7} {
+			resp, err := client.PostTeamRemoveMemberWithResponse(ctx, api.RemoveTeamMemberRequest{
+				TeamName: teamName3,
+				UserId:   userID,
+			})
+			require.NoError(t, err)
+			require.Equal(t, 200, resp.StatusCode())
+		}
+
+		deleteResp, err = client.PostTeamDeleteWithResponse(ctx, api.DeleteTeamRequest{TeamName: teamName3})
+		require.NoError(t, err)
+		require.Equal(t, 204, deleteResp.StatusCode())
+
+		getResp, err := client.GetTeamGetWithResponse(ctx, &api.GetTeamGetParams{TeamName: teamName3})
+		require.NoError(t, err)
+		assert.Equal(t, 404, getResp.StatusCode())
+
+		// NOTE: удалённая команда убирается из резервных команд
+		getResp, err = client.GetTeamGetWithResponse(ctx, &api.GetTeamGetParams{TeamName: teamName1})
+		require.NoError(t, err)
+		require.Equal(t, 200, getResp.StatusCode())
+		assert.Nil(t, getResp.JSON200.FallbackTeams)
+	})
+}
@@ -0,0 +1,163 @@
+//go:build integration
+
+package tests
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"pr-manager-service/internal/generated/api"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetUserReviews(t *testing.T) {
+	ctx := context.Background()
+
+	cleanupDB(ctx, t)
+	defer cleanupDB(ctx, t)
+
+	const (
+		teamName = "test name 1"
+
+		authorID   = "100"
+		reviewerID = "101"
+	)
+
+	// NOTE: кроме автора в команде один пользователь, он назначается ревьювером всех PR
+	teamResp, err := client.PostTeamAddWithResponse(ctx, api.Team{
+		TeamName: teamName,
+		Members: []api.TeamMember{
+			{UserId: authorID, Username: "author", IsActive: true},
+			{UserId: reviewerID, Username: "reviewer", IsActive: true},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, teamResp.StatusCode())
+
+	for i := 1; i <= 5; i++ {
+		prID := fmt.Sprintf("pr-%02d", i)
+
+		resp, err := client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
+			AuthorId:        authorID,
+			PullRequestId:   prID,
+			PullRequestName: "pr " + prID,
+		})
+		require.NoError(t, err)
+		require.Equal(t, http.StatusCreated, resp.StatusCode())
+		require.Equal(t, []string{reviewerID}, resp.JSON201.Pr.AssignedReviewers)
+	}
+
+	mergeResp, err := client.PostPullRequestMergeWithResponse(ctx, api.PostPullRequestMergeJSONRequestBody{
+		PullRequestId: "pr-05",
+	})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, mergeResp.StatusCode())
+
+	// NOTE: у pr-01..pr-03 одинаковое время назначения, порядок внутри группы задаёт id
+	assignedAt := map[string]time.Time{
+		"pr-05": time.Now().Add(-96 * time.Hour).Truncate(time.Second),
+		"pr-04": time.Now().Add(-72 * time.Hour).Truncate(time.Second),
+		"pr-01": time.Now().Add(-48 * time.Hour).Truncate(time.Second),
+	}
+	assignedAt["pr-02"] = assignedAt["pr-01"]
+	assignedAt["pr-03"] = assignedAt["pr-01"]
+
+	for prID, at := range assignedAt {
+		_, err = testDB.Exec(ctx, `update pull_request_reviewers set assigned_at = $1 where pull_request_id = $2`,
+			at, prID)
+		require.NoError(t, err)
+	}
+
+	getReview := func(params api.GetUsersGetReviewParams) *api.UsersGetReviewResponse {
+		t.Helper()
+
+		params.UserId = reviewerID
+
+		resp, err := client.GetUsersGetReviewWithResponse(ctx, &params)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode())
+
+		return resp.JSON200
+	}
+
+	reviewIDs := func(response *api.UsersGetReviewResponse) []string {
+		return lo.Map(response.PullRequests, func(pr api.PullRequestShort, _ int) string {
+			return pr.PullRequestId
+		})
+	}
+
+	t.Run("open_by_default", func(t *testing.T) {
+		response := getReview(api.GetUsersGetReviewParams{})
+
+		assert.Equal(t, []string{"pr-04", "pr-01", "pr-02", "pr-03"}, reviewIDs(response))
+		assert.Equal(t, 4, response.Total)
+		assert.Equal(t, api.ReviewStatusCounts{OPEN: 4, MERGED: 1}, response.StatusCounts)
+		assert.Nil(t, response.NextCursor)
+
+		assert.NotNil(t, response.PullRequests[0].AssignedAt)
+	})
+
+	t.Run("statuses", func(t *testing.T) {
+		response := getReview(api.GetUsersGetReviewParams{
+			Status: &[]api.PullRequestStatus{api.MERGED, api.OPEN},
+		})
+
+		assert.Equal(t, []string{"pr-05", "pr-04", "pr-01", "pr-02", "pr-03"}, reviewIDs(response))
+		assert.Equal(t, 5, response.Total)
+	})
+
+	t.Run("pages", func(t *testing.T) {
+		first := getReview(api.GetUsersGetReviewParams{Limit: lo.ToPtr(3)})
+		require.NotNil(t, first.NextCursor)
+
+		second := getReview(api.GetUsersGetReviewParams{Limit: lo.ToPtr(3), Cursor: first.NextCursor})
+		assert.Nil(t, second.NextCursor)
+
+		assert.Equal(t, []string{"pr-04", "pr-01", "pr-02"}, reviewIDs(first))
+		assert.Equal(t, []string{"pr-03"}, reviewIDs(second))
+		assert.Equal(t, 4, second.Total)
+	})
+
+	t.Run("unknown_user", func(t *testing.T) {
+		resp, err := client.GetUsersGetReviewWithResponse(ctx, &api.GetUsersGetReviewParams{UserId: "unknown"})
+		require.NoError(t, err)
+		require.Equal(t, http.StatusNotFound, resp.StatusCode())
+	})
+
+	t.Run("invalid_cursor", func(t *testing.T) {
+		resp, err := client.GetUsersGetReviewWithResponse(ctx, &api.GetUsersGetReviewParams{
+			UserId: reviewerID,
+			Cursor: lo.ToPtr("garbage!"),
+		})
+		require.NoError(t, err)
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode())
+		assert.Equal(t, api.VALIDATIONERR, resp.JSON400.Error.Code)
+	})
+
+	t.Run("reopened_by_default", func(t *testing.T) {
+		closeResp, err := client.PostPullRequestCloseWithResponse(ctx, api.PostPullRequestCloseJSONRequestBody{
+			PullRequestId: "pr-04",
+		})
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, closeResp.StatusCode())
+
+		reopenResp, err := client.PostPullRequestReopenWithResponse(ctx, api.PostPullRequestReopenJSONRequestBody{
+			PullRequestId: "pr-04",
+		})
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, reopenResp.StatusCode())
+
+		// NOTE: переоткрытый PR снова ждёт ревью, поэтому попадает в выборку без фильтра status
+		response := getReview(api.GetUsersGetReviewParams{})
+
+		assert.Equal(t, []string{"pr-04", "pr-01", "pr-02", "pr-03"}, reviewIDs(response))
+		assert.Equal(t, 4, response.Total)
+		assert.Equal(t, api.ReviewStatusCounts{OPEN: 3, REOPENED: 1, MERGED: 1}, response.StatusCounts)
+	})
+}
@@ -0,0 +1,312 @@
+//go:build integration
+
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"pr-manager-service/internal/generated/api"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeactivateUserReassignsReviews(t *testing.T) {
+	ctx := context.Background()
+
+	cleanupDB(ctx, t)
+	defer cleanupDB(ctx, t)
+
+	const (
+		teamName = "test name 1"
+
+		userID1 = "100"
+		userID2 = "101"
+		userID3 = "102"
+
+		prID1 = "100"
+		prID2 = "101"
+	)
+
+	teamAddResp, err := client.PostTeamAdd(ctx, api.Team{
+		TeamName: teamName,
+		Members: []api.TeamMember{
+			{UserId: userID1, Username: "user1", IsActive: true},
+			{UserId: userID2, Username: "user2", IsActive: true},
+			{UserId: userID3, Username: "user3", IsActive: true},
+		},
+		MaxReviewers: lo.ToPtr(1),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 201, teamAddResp.StatusCode)
+
+	createPullRequest := func(prID string) api.PullRequest {
+		resp, err := client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
+			AuthorId:        userID1,
+			PullRequestId:   prID,
+			PullRequestName: "prname " + prID,
+		})
+		require.NoError(t, err)
+		require.Equal(t, 201, resp.StatusCode())
+
+		return resp.JSON201.Pr
+	}
+
+	setIsActive := func(userID string, isActive bool) *api.SetIsActiveResponse {
+		resp, err := client.PostUsersSetIsActiveWithResponse(ctx, api.PostUsersSetIsActiveJSONRequestBody{
+			UserId:   userID,
+			IsActive: isActive,
+		})
+		require.NoError(t, err)
+		require.Equal(t, 200, resp.StatusCode())
+
+		return resp.JSON200
+	}
+
+	pr1 := createPullRequest(prID1)
+	require.Len(t, pr1.AssignedReviewers, 1)
+
+	firstReviewerID := pr1.AssignedReviewers[0]
+	secondReviewerID := lo.Ternary(firstReviewerID == userID2, userID3, userID2)
+
+	// NOTE: деактивированный ревьювер заменяется коллегой
+	deactivateResp := setIsActive(firstReviewerID, false)
+	assert.False(t, deactivateResp.User.IsActive)
+	assert.Equal(t, []api.ReviewerReplacement{
+		{PullRequestId: prID1, OldUserId: firstReviewerID, ReplacedBy: secondReviewerID},
+	}, deactivateResp.ReassignedPullRequests)
+	assert.Empty(t, deactivateResp.NoCandidatePullRequests)
+
+	pr2 := createPullRequest(prID2)
+	require.Equal(t, []string{secondReviewerID}, pr2.AssignedReviewers)
+
+	// NOTE: кроме автора активных коллег не осталось, PR остаются с прежним ревьювером
+	deactivateResp = setIsActive(secondReviewerID, false)
+	assert.Empty(t, deactivateResp.ReassignedPullRequests)
+	assert.ElementsMatch(t, []api.UnreplacedReviewer{
+		{PullRequestId: prID1, UserId: secondReviewerID},
+		{PullRequestId: prID2, UserId: secondReviewerID},
+	}, deactivateResp.NoCandidatePullRequests)
+
+	getReviewResp, err := client.GetUsersGetReviewWithResponse(ctx, &api.GetUsersGetReviewParams{
+		UserId: secondReviewerID,
+	})
+	require.NoError(t, err)
+	assert.Len(t, getReviewResp.JSON200.PullRequests, 2)
+
+	// NOTE: повторная активация ревью не меняет
+	activateResp := setIsActive(firstReviewerID, true)
+	assert.Empty(t, activateResp.ReassignedPullRequests)
+	assert.Empty(t, activateResp.NoCandidatePullRequests)
+}
+
+func TestUserAvailability(t *testing.T) {
+	ctx := context.Background()
+
+	cleanupDB(ctx, t)
+	defer cleanupDB(ctx, t)
+
+	const (
+		teamName = "test name 1"
+
+		authorID = "100"
+		userID1  = "101"
+		userID2  = "102"
+	)
+
+	teamAddResp, err := client.PostTeamAdd(ctx, api.Team{
+		TeamName: teamName,
+		Members: []api.TeamMember{
+			{UserId: authorID, Username: "author", IsActive: true},
+			{UserId: userID1, Username: "user1", IsActive: true},
+			{UserId: userID2, Username: "user2", IsActive: true},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 201, teamAddResp.StatusCode)
+
+	timeNow := time.Now().UTC().Truncate(time.Second)
+
+	t.Run("invalid_window", func(t *testing.T) {
+		resp, err := client.PostUsersAvailabilityWithResponse(ctx, api.CreateUnavailabilityRequest{
+			UserId:   userID1,
+			StartsAt: timeNow,
+			EndsAt:   timeNow.Add(-time.Hour),
+		})
+		require.NoError(t, err)
+		require.Equal(t, 400, resp.StatusCode())
+		assert.Equal(t, api.VALIDATIONERR, resp.JSON400.Error.Code)
+
+		resp, err = client.PostUsersAvailabilityWithResponse(ctx, api.CreateUnavailabilityRequest{
+			UserId:   "unknown",
+			StartsAt: timeNow,
+			EndsAt:   timeNow.Add(time.Hour),
+		})
+		require.NoError(t, err)
+		require.Equal(t, 404, resp.StatusCode())
+	})
+
+	t.Run("unavailable_user_is_skipped", func(t *testing.T) {
+		createResp, err := client.PostUsersAvailabilityWithResponse(ctx, api.CreateUnavailabilityRequest{
+			UserId:   userID1,
+			StartsAt: timeNow.Add(-time.Hour),
+			EndsAt:   timeNow.Add(24 * time.Hour),
+			Reason:   lo.ToPtr("vacation"),
+		})
+		require.NoError(t, err)
+		require.Equal(t, 201, createResp.StatusCode())
+
+		window := createResp.JSON201.Window
+		assert.Equal(t, userID1, window.UserId)
+		assert.Equal(t, "vacation", lo.FromPtr(window.Reason))
+
+		// NOTE: прошедшие окна не возвращаются и на доступность не влияют
+		pastResp, err := client.PostUsersAvailabilityWithResponse(ctx, api.CreateUnavailabilityRequest{
+			UserId:   userID2,
+			StartsAt: timeNow.Add(-48 * time.Hour),
+			EndsAt:   timeNow.Add(-24 * time.Hour),
+		})
+		require.NoError(t, err)
+		require.Equal(t, 201, pastResp.StatusCode())
+
+		getResp, err := client.GetUsersAvailabilityWithResponse(ctx, &api.GetUsersAvailabilityParams{UserId: userID1})
+		require.NoError(t, err)
+		require.Equal(t, 200, getResp.StatusCode())
+		assert.False(t, getResp.JSON200.IsAvailable)
+		require.Len(t, getResp.JSON200.Windows, 1)
+		assert.Equal(t, window.Id, getResp.JSON200.Windows[0].Id)
+		assert.True(t, window.StartsAt.Equal(getResp.JSON200.Windows[0].StartsAt))
+
+		getResp, err = client.GetUsersAvailabilityWithResponse(ctx, &api.GetUsersAvailabilityParams{UserId: userID2})
+		require.NoError(t, err)
+		require.Equal(t, 200, getResp.StatusCode())
+		assert.True(t, getResp.JSON200.IsAvailable)
+		assert.Empty(t, getResp.JSON200.Windows)
+
+		prResp, err := client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
+			AuthorId:        authorID,
+			PullRequestId:   "100",
+			PullRequestName: "prname",
+		})
+		require.NoError(t, err)
+		require.Equal(t, 201, prResp.StatusCode())
+		assert.Equal(t, []string{userID2}, prResp.JSON201.Pr.AssignedReviewers)
+
+		deleteResp, err := client.PostUsersAvailabilityDeleteWithResponse(ctx, api.DeleteUnavailabilityRequest{
+			UserId: userID1,
+			Id:     window.Id,
+		})
+		require.NoError(t, err)
+		require.Equal(t, 204, deleteResp.StatusCode())
+
+		deleteResp, err = client.PostUsersAvailabilityDeleteWithResponse(ctx, api.DeleteUnavailabilityRequest{
+			UserId: userID1,
+			Id:     window.Id,
+		})
+		require.NoError(t, err)
+		require.Equal(t, 404, deleteResp.StatusCode())
+
+		prResp, err = client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
+			AuthorId:        authorID,
+			PullRequestId:   "101",
+			PullRequestName: "prname",
+		})
+		require.NoError(t, err)
+		require.Equal(t, 201, prResp.StatusCode())
+		assert.ElementsMatch(t, []string{userID1, userID2}, prResp.JSON201.Pr.AssignedReviewers)
+	})
+}
+
+func TestUserWorkingHours(t *testing.T) {
+	ctx := context.Background()
+
+	cleanupDB(ctx, t)
+	defer cleanupDB(ctx, t)
+
+	const (
+		teamName = "test name 1"
+
+		authorID = "100"
+		userID1  = "101"
+		userID2  = "102"
+	)
+
+	teamAddResp, err := client.PostTeamAdd(ctx, api.Team{
+		TeamName: teamName,
+		Members: []api.TeamMember{
+			{UserId: authorID, Username: "author", IsActive: true},
+			{UserId: userID1, Username: "user1", IsActive: true},
+			{UserId: userID2, Username: "user2", IsActive: true},
+		},
+		MaxReviewers:       lo.ToPtr(1),
+		PreferWorkingHours: lo.ToPtr(true),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 201, teamAddResp.StatusCode)
+
+	t.Run("invalid_request", func(t *testing.T) {
+		resp, err := client.PostUsersSetWorkingHoursWithResponse(ctx, api.SetWorkingHoursRequest{
+			UserId:   userID1,
+			Timezone: "Mars/Olympus",
+		})
+		require.NoError(t, err)
+		require.Equal(t, 400, resp.StatusCode())
+		assert.Equal(t, api.VALIDATIONERR, resp.JSON400.Error.Code)
+
+		resp, err = client.PostUsersSetWorkingHoursWithResponse(ctx, api.SetWorkingHoursRequest{
+			UserId:       userID1,
+			Timezone:     "UTC",
+			WorkingHours: &api.WorkingHours{Start: "09:00", End: "09:00"},
+		})
+		require.NoError(t, err)
+		require.Equal(t, 400, resp.StatusCode())
+
+		resp, err = client.PostUsersSetWorkingHoursWithResponse(ctx, api.SetWorkingHoursRequest{
+			UserId:   "unknown",
+			Timezone: "UTC",
+		})
+		require.NoError(t, err)
+		require.Equal(t, 404, resp.StatusCode())
+	})
+
+	t.Run("off_hours_user_is_not_preferred", func(t *testing.T) {
+		// NOTE: рабочие часы userID1 начинаются через два часа
+		timeNow := time.Now().UTC()
+		workingHours := api.WorkingHours{
+			Start: timeNow.Add(2 * time.Hour).Format("15:04"),
+			End:   timeNow.Add(4 * time.Hour).Format("15:04"),
+		}
+
+		resp, err := client.PostUsersSetWorkingHoursWithResponse(ctx, api.SetWorkingHoursRequest{
+			UserId:       userID1,
+			Timezone:     "UTC",
+			WorkingHours: &workingHours,
+		})
+		require.NoError(t, err)
+		require.Equal(t, 200, resp.StatusCode())
+		assert.Equal(t, "UTC", lo.FromPtr(resp.JSON200.User.Timezone))
+		assert.Equal(t, workingHours, lo.FromPtr(resp.JSON200.User.WorkingHours))
+
+		prResp, err := client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
+			AuthorId:        authorID,
+			PullRequestId:   "100",
+			PullRequestName: "prname",
+		})
+		require.NoError(t, err)
+		require.Equal(t, 201, prResp.StatusCode())
+		assert.Equal(t, []string{userID2}, prResp.JSON201.Pr.AssignedReviewers)
+
+		// NOTE: без рабочих часов пользователь считается доступным в любое время
+		resp, err = client.PostUsersSetWorkingHoursWithResponse(ctx, api.SetWorkingHoursRequest{
+			UserId:   userID1,
+			Timezone: "Europe/Moscow",
+		})
+		require.NoError(t, err)
+		require.Equal(t, 200, resp.StatusCode())
+		assert.Equal(t, "Europe/Moscow", lo.FromPtr(resp.JSON200.User.Timezone))
+		assert.Nil(t, resp.JSON200.User.WorkingHours)
+	})
+}
@@ -0,0 +1,229 @@
+//go:build integration
+
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"pr-manager-service/internal/domain"
+	"pr-manager-service/internal/generated/api"
+	"pr-manager-service/internal/webhook"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const webhookSecret = "0123456789abcdef"
+
+// webhookReceiver - получатель вебхуков, который проверяет подпись и запоминает полученные события.
+type webhookReceiver struct {
+	t      *testing.T
+	status int
+
+	mu     sync.Mutex
+	events []domain.Event
+	raw    []json.RawMessage
+}
+
+func newWebhookReceiver(t *testing.T, status int) (*webhookReceiver, *httptest.Server) {
+	receiver := &webhookReceiver{t: t, status: status}
+	server := httptest.NewServer(receiver)
+	t.Cleanup(server.Close)
+
+	return receiver, server
+}
+
+func (r *webhookReceiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	body, err := io.ReadAll(req.Body)
+	assert.NoError(r.t, err)
+
+	assert.Equal(r.t, domain.SignWebhookPayload(webhookSecret, body), req.Header.Get(webhook.SignatureHeader))
+
+	var event struct {
+		ID   string           `json:"id"`
+		Type domain.EventType `json:"type"`
+		Data json.RawMessage  `json:"data"`
+	}
+	assert.NoError(r.t, json.Unmarshal(body, &event))
+	assert.Equal(r.t, string(event.Type), req.Header.Get(webhook.EventHeader))
+	assert.Equal(r.t, event.ID, req.Header.Get(webhook.DeliveryHeader))
+
+	r.mu.Lock()
+	r.events = append(r.events, domain.Event{ID: event.ID, Type: event.Type})
+	r.raw = append(r.raw, event.Data)
+	r.mu.Unlock()
+
+	w.WriteHeader(r.status)
+}
+
+func (r *webhookReceiver) eventTypes() []domain.EventType {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return lo.Map(r.events, func(event domain.Event, _ int) domain.EventType { return event.Type })
+}
+
+func TestWebhooks(t *testing.T) {
+	ctx := context.Background()
+
+	cleanupDB(ctx, t)
+	defer cleanupDB(ctx, t)
+
+	const (
+		teamName = "test name 1"
+
+		userID1 = "100"
+		userID2 = "101"
+
+		prID   = "100"
+		prName = "prname 1"
+	)
+
+	receiver, okServer := newWebhookReceiver(t, http.StatusOK)
+	_, failingServer := newWebhookReceiver(t, http.StatusInternalServerError)
+
+	subscribe := func(url string, events ...api.WebhookEventType) api.WebhookSubscription {
+		resp, err := client.PostWebhooksSubscribeWithResponse(ctx, api.CreateWebhookSubscriptionRequest{
+			Url:    url,
+			Events: lo.EmptyableToPtr(events),
+			Secret: webhookSecret,
+		})
+		require.NoError(t, err)
+		require.Equal(t, http.StatusCreated, resp.StatusCode())
+
+		return resp.JSON201.Subscription
+	}
+
+	okSubscription := subscribe(okServer.URL)
+	mergedSubscription := subscribe(failingServer.URL, api.WebhookEventType(domain.EventPullRequestMerged))
+
+	t.Run("invalid_subscription", func(t *testing.T) {
+		resp, err := client.PostWebhooksSubscribeWithResponse(ctx, api.CreateWebhookSubscriptionRequest{
+			Url:    okServer.URL,
+			Events: &[]api.WebhookEventType{"unknown"},
+			Secret: webhookSecret,
+		})
+		require.NoError(t, err)
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode())
+	})
+
+	listResp, err := client.GetWebhooksListWithResponse(ctx)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, listResp.StatusCode())
+	assert.Equal(t, []int64{okSubscription.Id, mergedSubscription.Id},
+		lo.Map(listResp.JSON200.Subscriptions, func(s api.WebhookSubscription, _ int) int64 { return s.Id }))
+
+	teamAddResp, err := client.PostTeamAdd(ctx, api.Team{
+		TeamName: teamName,
+		Members: []api.TeamMember{
+			{UserId: userID1, Username: "user1", IsActive: true},
+			{UserId: userID2, Username: "user2", IsActive: true},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, teamAddResp.StatusCode)
+
+	createResp, err := client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
+		AuthorId:        userID1,
+		PullRequestId:   prID,
+		PullRequestName: prName,
+	})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, createResp.StatusCode())
+
+	mergeResp, err := client.PostPullRequestMergeWithResponse(ctx, api.PostPullRequestMergeJSONRequestBody{
+		PullRequestId: prID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, mergeResp.StatusCode())
+
+	// NOTE: доставки вебхуков создаёт релей outbox
+	deliveries, err := testUsecases.DeliverWebhooks(ctx)
+	require.NoError(t, err)
+	require.Empty(t, deliveries)
+
+	_, err = testUsecases.RelayOutboxEvents(ctx)
+	require.NoError(t, err)
+
+	deliveries, err = testUsecases.DeliverWebhooks(ctx)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 3)
+
+	t.Run("delivered", func(t *testing.T) {
+		assert.Equal(t, []domain.EventType{domain.EventReviewersAssigned, domain.EventPullRequestMerged},
+			receiver.eventTypes())
+
+		var assigned domain.ReviewersAssignedEvent
+		require.NoError(t, json.Unmarshal(receiver.raw[0], &assigned))
+		assert.Equal(t, domain.ReviewersAssignedEvent{
+			PullRequestID: prID,
+			ReviewerIDs:   []string{userID2},
+			Reason:        domain.AssignmentReasonInitial,
+		}, assigned)
+
+		resp, err := client.GetWebhooksDeliveriesWithResponse(ctx, &api.GetWebhooksDeliveriesParams{
+			SubscriptionId: okSubscription.Id,
+		})
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode())
+		require.Len(t, resp.JSON200.Deliveries, 2)
+
+		for _, delivery := range resp.JSON200.Deliveries {
+			assert.Equal(t, api.WebhookDeliveryStatus(domain.WebhookDeliveryDelivered), delivery.Status)
+			assert.Equal(t, 1, delivery.Attempts)
+			assert.Equal(t, lo.ToPtr(http.StatusOK), delivery.LastStatusCode)
+			assert.NotNil(t, delivery.DeliveredAt)
+			assert.Nil(t, delivery.NextAttemptAt)
+		}
+	})
+
+	t.Run("retry_scheduled", func(t *testing.T) {
+		resp, err := client.GetWebhooksDeliveriesWithResponse(ctx, &api.GetWebhooksDeliveriesParams{
+			SubscriptionId: mergedSubscription.Id,
+			Status:         lo.ToPtr(api.WebhookDeliveryStatus(domain.WebhookDeliveryPending)),
+		})
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode())
+		require.Len(t, resp.JSON200.Deliveries, 1)
+
+		delivery := resp.JSON200.Deliveries[0]
+		assert.Equal(t, api.WebhookEventType(domain.EventPullRequestMerged), delivery.EventType)
+		assert.Equal(t, 1, delivery.Attempts)
+		assert.Equal(t, lo.ToPtr(http.StatusInternalServerError), delivery.LastStatusCode)
+		assert.NotNil(t, delivery.LastError)
+		require.NotNil(t, delivery.NextAttemptAt)
+		assert.True(t, delivery.NextAttemptAt.After(delivery.CreatedAt))
+
+		// NOTE: время следующей попытки ещё не наступило
+		deliveries, err := testUsecases.DeliverWebhooks(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, deliveries)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		resp, err := client.PostWebhooksDeleteWithResponse(ctx, api.DeleteWebhookSubscriptionRequest{
+			Id: mergedSubscription.Id,
+		})
+		require.NoError(t, err)
+		require.Equal(t, http.StatusNoContent, resp.StatusCode())
+
+		resp, err = client.PostWebhooksDeleteWithResponse(ctx, api.DeleteWebhookSubscriptionRequest{
+			Id: mergedSubscription.Id,
+		})
+		require.NoError(t, err)
+		require.Equal(t, http.StatusNotFound, resp.StatusCode())
+
+		deliveriesResp, err := client.GetWebhooksDeliveriesWithResponse(ctx, &api.GetWebhooksDeliveriesParams{
+			SubscriptionId: mergedSubscription.Id,
+		})
+		require.NoError(t, err)
+		require.Equal(t, http.StatusNotFound, deliveriesResp.StatusCode())
+	})
+}